@@ -33,13 +33,15 @@ resource "openclaw_agent_defaults" "main" {
   timeout_seconds  = 600
   max_concurrent   = 1
 
-  heartbeat_every  = "30m"
-  heartbeat_target = "last"
-
   sandbox_mode  = "non-main"
   sandbox_scope = "agent"
 }
 
+resource "openclaw_heartbeat" "main" {
+  every  = "30m"
+  target = "last"
+}
+
 # ── WhatsApp ─────────────────────────────────────────────────
 
 resource "openclaw_channel_whatsapp" "main" {
@@ -51,6 +51,22 @@ resource "openclaw_gateway" "main" {
   tailscale_mode = "serve"
 }
 
+resource "openclaw_logging" "main" {
+  level  = "info"
+  format = "json"
+
+  subsystem_levels = {
+    "channels.telegram" = "debug"
+  }
+}
+
+resource "openclaw_observability" "main" {
+  metrics_enabled = true
+  metrics_port    = 9464
+  otlp_endpoint   = "http://otel-collector:4318"
+  sampling_ratio  = 0.1
+}
+
 # ── Agent defaults ───────────────────────────────────────────
 
 resource "openclaw_agent_defaults" "shared" {
@@ -61,13 +77,29 @@ resource "openclaw_agent_defaults" "shared" {
   timeout_seconds  = 600
   max_concurrent   = 2
 
-  heartbeat_every  = "30m"
-  heartbeat_target = "last"
-
   sandbox_mode  = "non-main"
   sandbox_scope = "agent"
 }
 
+resource "openclaw_heartbeat" "shared" {
+  every  = "30m"
+  target = "last"
+}
+
+resource "openclaw_sandbox" "runtime" {
+  image         = "ghcr.io/openclaw/sandbox:latest"
+  memory        = "2g"
+  network       = "none"
+  idle_teardown = "30m"
+}
+
+# ── Model aliases ────────────────────────────────────────────
+
+resource "openclaw_model_alias" "fast" {
+  alias = "fast"
+  model = "anthropic/claude-haiku-4-5"
+}
+
 # ── Agents ───────────────────────────────────────────────────
 
 resource "openclaw_agent" "home" {
@@ -77,13 +109,16 @@ resource "openclaw_agent" "home" {
   workspace     = "~/.openclaw/workspace-home"
   model         = "anthropic/claude-opus-4-6"
 
-  identity_name  = "Molty"
-  identity_emoji = "🦞"
-  identity_theme = "helpful space lobster"
-
   mention_patterns = ["@openclaw", "molty"]
 }
 
+resource "openclaw_agent_identity" "home" {
+  agent_id = openclaw_agent.home.agent_id
+  name     = "Molty"
+  emoji    = "🦞"
+  theme    = "helpful space lobster"
+}
+
 resource "openclaw_agent" "work" {
   agent_id  = "work"
   name      = "Work Agent"
@@ -184,6 +219,15 @@ resource "openclaw_messages" "config" {
   inbound_debounce_ms = 2000
 }
 
+# ── Memory ───────────────────────────────────────────────────
+
+resource "openclaw_memory" "config" {
+  enabled         = true
+  embedding_model = "openai/text-embedding-3-small"
+  retention       = "90d"
+  scope           = "agent"
+}
+
 # ── Plugins ──────────────────────────────────────────────────
 
 resource "openclaw_plugin" "voice_call" {
@@ -211,6 +255,15 @@ resource "openclaw_hook" "ingress" {
   default_session_key = "hook:ingress"
 }
 
+resource "openclaw_hook_endpoint" "github" {
+  name        = "github"
+  path        = "github"
+  agent_id    = openclaw_agent.work.agent_id
+  session_key = "hook:github"
+  methods     = ["POST"]
+  template    = "GitHub {{event}}: {{action}}"
+}
+
 # ── Cron ─────────────────────────────────────────────────────
 
 resource "openclaw_cron" "config" {
@@ -228,6 +281,18 @@ resource "openclaw_tools" "config" {
   browser_enabled  = true
 }
 
+resource "openclaw_tool_profile" "readonly" {
+  name  = "readonly"
+  allow = ["read", "web_search"]
+  deny  = ["exec", "write"]
+}
+
+resource "openclaw_browser" "config" {
+  headless        = true
+  allowed_domains = ["github.com"]
+  max_screenshots = 20
+}
+
 # ── Data Sources ─────────────────────────────────────────────
 
 data "openclaw_config" "current" {}
@@ -28,8 +28,12 @@ resource "openclaw_gateway" "main" {
   port        = 18789
   bind        = "loopback"
   reload_mode = "hybrid"
-  auth_mode   = "token"
-  auth_token  = var.gateway_token
+}
+
+resource "openclaw_gateway_auth" "main" {
+  mode             = "token"
+  token_wo         = var.gateway_token
+  token_wo_version = 1
 }
 
 # ── Agent defaults (shared across all agents) ────────────────
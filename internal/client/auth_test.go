@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWSClient_AuthPayload(t *testing.T) {
+	cases := []struct {
+		name string
+		cfg  WSClientConfig
+		want map[string]any
+	}{
+		{name: "token", cfg: WSClientConfig{Token: "secret-token"}, want: map[string]any{"token": "secret-token"}},
+		{name: "password", cfg: WSClientConfig{Password: "hunter2"}, want: map[string]any{"password": "hunter2"}},
+		{name: "none", cfg: WSClientConfig{}, want: nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newFakeGateway(t)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			cfg := tc.cfg
+			cfg.URL = g.URL()
+			c, err := NewWSClient(ctx, cfg)
+			if err != nil {
+				t.Fatalf("NewWSClient: %v", err)
+			}
+			c.Close()
+
+			auth, _ := g.lastConnect()["auth"].(map[string]any)
+			if len(auth) != len(tc.want) {
+				t.Fatalf("auth = %v, want %v", auth, tc.want)
+			}
+			for k, v := range tc.want {
+				if auth[k] != v {
+					t.Fatalf("auth[%q] = %v, want %v", k, auth[k], v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultBackupRetention is how many backups BackupClient keeps per config.
+const DefaultBackupRetention = 10
+
+// backupSuffix separates the config file name from the backup timestamp.
+const backupSuffix = ".tfbackup-"
+
+// BackupClient saves a copy of the config before every write, so a bad
+// apply can be undone by restoring the file. Backups are named
+// <path>.tfbackup-<UTC timestamp>, and only the newest keep are retained.
+// A write is not attempted if its backup cannot be saved.
+type BackupClient struct {
+	Client
+	path string
+	keep int
+
+	mu sync.Mutex // serializes saving and pruning
+}
+
+// NewBackupClient wraps c so the config is saved next to path before each
+// write. keep bounds the number of backups; zero keeps them all.
+func NewBackupClient(c Client, path string, keep int) *BackupClient {
+	return &BackupClient{Client: c, path: path, keep: keep}
+}
+
+// PatchConfig implements Client.
+func (b *BackupClient) PatchConfig(ctx context.Context, patch map[string]any, baseHash string) error {
+	if err := b.backup(ctx); err != nil {
+		return err
+	}
+	return b.Client.PatchConfig(ctx, patch, baseHash)
+}
+
+// ApplyConfig implements Client.
+func (b *BackupClient) ApplyConfig(ctx context.Context, raw string, baseHash string) error {
+	if err := b.backup(ctx); err != nil {
+		return err
+	}
+	return b.Client.ApplyConfig(ctx, raw, baseHash)
+}
+
+// ImportConfig implements Client.
+func (b *BackupClient) ImportConfig(ctx context.Context, raw string, baseHash string) error {
+	if err := b.backup(ctx); err != nil {
+		return err
+	}
+	return b.Client.ImportConfig(ctx, raw, baseHash)
+}
+
+// backup saves the current config and prunes old backups. The config is
+// exported so the backup keeps the secrets needed to restore it.
+func (b *BackupClient) backup(ctx context.Context) error {
+	cfg, err := b.Client.ExportConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("backing up config: %w", err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	base, err := expandPath(b.path)
+	if err != nil {
+		return fmt.Errorf("backing up config: %w", err)
+	}
+	if err := ensureDir(base); err != nil {
+		return fmt.Errorf("backing up config: %w", err)
+	}
+
+	// Backups hold credentials, so only the owner may read them.
+	name := base + backupSuffix + time.Now().UTC().Format("20060102T150405.000Z")
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	for n := 1; errors.Is(err, fs.ErrExist); n++ {
+		f, err = os.OpenFile(fmt.Sprintf("%s-%d", name, n), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	}
+	if err != nil {
+		return fmt.Errorf("backing up config: %w", err)
+	}
+	_, err = f.WriteString(cfg.Raw)
+	if serr := f.Sync(); err == nil {
+		err = serr
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return fmt.Errorf("backing up config: %w", err)
+	}
+
+	return b.prune(base)
+}
+
+// prune removes all but the newest b.keep backups of base.
+func (b *BackupClient) prune(base string) error {
+	if b.keep <= 0 {
+		return nil
+	}
+	backups, err := filepath.Glob(escapeGlob(base) + backupSuffix + "*")
+	if err != nil {
+		return err
+	}
+	if len(backups) <= b.keep {
+		return nil
+	}
+	// Timestamps sort lexically in time order.
+	sort.Strings(backups)
+	for _, old := range backups[:len(backups)-b.keep] {
+		if err := os.Remove(old); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("removing old backup: %w", err)
+		}
+	}
+	return nil
+}
+
+// escapeGlob quotes the pattern characters in a literal path.
+func escapeGlob(s string) string {
+	var out []rune
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', '\\':
+			out = append(out, '\\')
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// Unwrap returns the wrapped client.
+func (b *BackupClient) Unwrap() Client { return b.Client }
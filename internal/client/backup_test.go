@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBackupClient_SavesConfigBeforeWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "openclaw.json")
+	os.WriteFile(path, []byte(`{"gateway":{"port":18789}}`), 0o644)
+	fc, err := NewFileClient(path)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	c := NewBackupClient(fc, path, DefaultBackupRetention)
+	ctx := context.Background()
+
+	if err := c.PatchConfig(ctx, map[string]any{"gateway": map[string]any{"port": 9999}}, ""); err != nil {
+		t.Fatalf("PatchConfig: %v", err)
+	}
+
+	backups, _ := filepath.Glob(path + ".tfbackup-*")
+	if len(backups) != 1 {
+		t.Fatalf("backups = %v, want 1", backups)
+	}
+	data, _ := os.ReadFile(backups[0])
+	if string(data) != `{"gateway":{"port":18789}}` {
+		t.Errorf("backup = %s, want the config before the write", data)
+	}
+	info, _ := os.Stat(backups[0])
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("backup perm = %o, want 600", perm)
+	}
+}
+
+func TestBackupClient_KeepsNewest(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "openclaw.json")
+	m := &memClient{config: map[string]any{}}
+	c := NewBackupClient(m, path, 3)
+	ctx := context.Background()
+
+	for i := range 5 {
+		if err := c.PatchConfig(ctx, map[string]any{"n": i}, ""); err != nil {
+			t.Fatalf("PatchConfig %d: %v", i, err)
+		}
+	}
+
+	backups, _ := filepath.Glob(path + ".tfbackup-*")
+	if len(backups) != 3 {
+		t.Fatalf("backups = %v, want 3", backups)
+	}
+	var contents []string
+	for _, b := range backups {
+		data, _ := os.ReadFile(b)
+		contents = append(contents, string(data))
+	}
+	// Writes in the same millisecond get numbered names, which still sort
+	// after the first.
+	if got := strings.Join(contents, " "); got != `{"n":1} {"n":2} {"n":3}` {
+		t.Errorf("kept backups = %s", got)
+	}
+}
+
+func TestBackupClient_FailedBackupBlocksWrite(t *testing.T) {
+	dir := t.TempDir()
+	// A file where the backup directory should be.
+	blocker := filepath.Join(dir, "backups")
+	os.WriteFile(blocker, nil, 0o644)
+	m := &memClient{config: map[string]any{}}
+	c := NewBackupClient(m, filepath.Join(blocker, "openclaw.json"), 1)
+
+	if err := c.PatchConfig(context.Background(), map[string]any{"a": 1}, ""); err == nil {
+		t.Fatal("PatchConfig succeeded without a backup")
+	}
+	if len(m.patches) != 0 {
+		t.Errorf("patches = %v, want none", m.patches)
+	}
+}
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// DefaultBatchWindow is how long BatchClient waits for further patches
+// before writing a batch.
+const DefaultBatchWindow = 250 * time.Millisecond
+
+// BatchClient coalesces merge patches issued close together, as Terraform
+// does when it applies independent resources in parallel, into a single
+// PatchConfig on the wrapped client. The gateway then reloads once per batch
+// instead of once per resource.
+//
+// Each PatchConfig call still blocks until its batch has been written and
+// returns that write's result, so a failure is reported by every resource
+// whose change it carried, and resources that depend on one another are
+// still written in order.
+type BatchClient struct {
+	Client
+	window time.Duration
+
+	mu  sync.Mutex
+	cur *patchBatch // collecting patches; nil when none is open
+}
+
+type patchBatch struct {
+	ctx      context.Context
+	baseHash string
+	patch    map[string]any
+	after    <-chan struct{} // done channel of the previous batch, if any
+	timer    *time.Timer
+
+	once sync.Once
+	done chan struct{}
+	err  error
+}
+
+// NewBatchClient wraps c so patches arriving within window of the first one
+// in a batch are written together.
+func NewBatchClient(c Client, window time.Duration) *BatchClient {
+	if window <= 0 {
+		window = DefaultBatchWindow
+	}
+	return &BatchClient{Client: c, window: window}
+}
+
+// PatchConfig implements Client. The patch joins the open batch when both
+// were made against the same config hash and can be combined into one merge
+// patch; otherwise the open batch is written first and a new one started.
+func (b *BatchClient) PatchConfig(ctx context.Context, patch map[string]any, baseHash string) error {
+	b.mu.Lock()
+	batch := b.cur
+	if batch != nil && batch.baseHash == baseHash {
+		if merged, ok := composePatches(batch.patch, patch); ok {
+			batch.patch = merged
+			b.mu.Unlock()
+			return batch.wait(ctx)
+		}
+	}
+
+	var after <-chan struct{}
+	if batch != nil {
+		b.closeLocked(batch)
+		after = batch.done
+	}
+	batch = &patchBatch{
+		ctx:      context.WithoutCancel(ctx),
+		baseHash: baseHash,
+		patch:    patch,
+		after:    after,
+		done:     make(chan struct{}),
+	}
+	b.cur = batch
+	batch.timer = time.AfterFunc(b.window, func() {
+		b.mu.Lock()
+		if b.cur == batch {
+			b.cur = nil
+		}
+		b.mu.Unlock()
+		b.send(batch)
+	})
+	b.mu.Unlock()
+	return batch.wait(ctx)
+}
+
+// closeLocked stops batch from taking more patches and writes it now.
+// Caller must hold b.mu.
+func (b *BatchClient) closeLocked(batch *patchBatch) {
+	b.cur = nil
+	batch.timer.Stop()
+	go b.send(batch)
+}
+
+// send writes batch once, after the batch before it.
+func (b *BatchClient) send(batch *patchBatch) {
+	batch.once.Do(func() {
+		if batch.after != nil {
+			<-batch.after
+		}
+		batch.err = b.Client.PatchConfig(batch.ctx, batch.patch, batch.baseHash)
+		close(batch.done)
+	})
+}
+
+func (p *patchBatch) wait(ctx context.Context) error {
+	select {
+	case <-p.done:
+		return p.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flush writes the open batch, if any, and waits for it.
+func (b *BatchClient) flush() {
+	b.mu.Lock()
+	batch := b.cur
+	if batch != nil {
+		b.closeLocked(batch)
+	}
+	b.mu.Unlock()
+	if batch != nil {
+		<-batch.done
+	}
+}
+
+// ApplyConfig implements Client. Pending patches are written first so they
+// cannot land on top of the replacement config.
+func (b *BatchClient) ApplyConfig(ctx context.Context, raw string, baseHash string) error {
+	b.flush()
+	return b.Client.ApplyConfig(ctx, raw, baseHash)
+}
+
+// ImportConfig implements Client. Pending patches are written first, as
+// for ApplyConfig.
+func (b *BatchClient) ImportConfig(ctx context.Context, raw string, baseHash string) error {
+	b.flush()
+	return b.Client.ImportConfig(ctx, raw, baseHash)
+}
+
+// Call implements Client. Pending patches are written first, as the RPC may
+// depend on them.
+func (b *BatchClient) Call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	b.flush()
+	return b.Client.Call(ctx, method, params)
+}
+
+// Close implements Client, writing any pending patches first.
+func (b *BatchClient) Close() error {
+	b.flush()
+	return b.Client.Close()
+}
+
+// composePatches returns a single merge patch combining a and b, refusing
+// when both set the same key to anything but an object. Such a key is
+// usually a list both callers rebuilt from the same config, as for
+// agents.list or bindings: combined, only b's list would be written and a's
+// change lost without an error. Sent apart, b fails on the stale hash
+// instead. Likewise b cannot merge an object into a key that a replaced with
+// a non-object (or removed): applied in turn, b's object would replace the
+// key, but combined it would be merged into the original value.
+func composePatches(a, b map[string]any) (map[string]any, bool) {
+	out := make(map[string]any, len(a)+len(b))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, bv := range b {
+		av, exists := a[k]
+		if !exists {
+			out[k] = bv
+			continue
+		}
+		am, aIsMap := av.(map[string]any)
+		bm, bIsMap := bv.(map[string]any)
+		if !aIsMap || !bIsMap {
+			return nil, false
+		}
+		sub, ok := composePatches(am, bm)
+		if !ok {
+			return nil, false
+		}
+		out[k] = sub
+	}
+	return out, true
+}
+
+// Unwrap returns the wrapped client.
+func (b *BatchClient) Unwrap() Client { return b.Client }
@@ -0,0 +1,232 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memClient is an in-memory Client that records the patches it receives.
+type memClient struct {
+	mu      sync.Mutex
+	config  map[string]any
+	patches []map[string]any
+	hashes  []string
+	err     error
+}
+
+func (m *memClient) GetConfig(context.Context) (*ConfigPayload, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, _ := json.Marshal(m.config)
+	return &ConfigPayload{Raw: string(data), Hash: hashBytes(data)}, nil
+}
+
+func (m *memClient) GetConfigSection(ctx context.Context, keys ...string) (*SectionPayload, error) {
+	cfg, _ := m.GetConfig(ctx)
+	return sectionOf(cfg, keys...)
+}
+
+func (m *memClient) PatchConfig(_ context.Context, patch map[string]any, baseHash string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.patches = append(m.patches, patch)
+	m.hashes = append(m.hashes, baseHash)
+	if m.err != nil {
+		return m.err
+	}
+	m.config = mergePatch(m.config, patch)
+	return nil
+}
+
+func (m *memClient) ApplyConfig(_ context.Context, raw string, _ string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.patches = append(m.patches, map[string]any{"<apply>": raw})
+	m.config = nil
+	return json.Unmarshal([]byte(raw), &m.config)
+}
+
+func (m *memClient) ExportConfig(ctx context.Context) (*ConfigPayload, error) {
+	return m.GetConfig(ctx)
+}
+
+func (m *memClient) ImportConfig(ctx context.Context, raw string, baseHash string) error {
+	return m.ApplyConfig(ctx, raw, baseHash)
+}
+
+func (m *memClient) Health(context.Context) (*HealthPayload, error) {
+	return &HealthPayload{OK: true}, nil
+}
+func (m *memClient) Call(_ context.Context, method string, _ any) (json.RawMessage, error) {
+	return nil, fmt.Errorf("%s not supported", method)
+}
+func (m *memClient) Close() error { return nil }
+
+func TestBatchClient_CoalescesParallelPatches(t *testing.T) {
+	inner := &memClient{config: map[string]any{"keep": true}}
+	b := NewBatchClient(inner, 50*time.Millisecond)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = b.PatchConfig(ctx, map[string]any{"channels": map[string]any{fmt.Sprintf("c%d", i): map[string]any{"enabled": true}}}, "h1")
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("patch %d: %v", i, err)
+		}
+	}
+	if len(inner.patches) != 1 || inner.hashes[0] != "h1" {
+		t.Fatalf("inner patches = %d (hashes %v), want 1 against h1", len(inner.patches), inner.hashes)
+	}
+	if channels := inner.config["channels"].(map[string]any); len(channels) != 10 || inner.config["keep"] != true {
+		t.Errorf("config = %v", inner.config)
+	}
+}
+
+func TestBatchClient_ErrorReachesEveryCaller(t *testing.T) {
+	inner := &memClient{err: errors.New("config.patch failed: CONFLICT")}
+	b := NewBatchClient(inner, 20*time.Millisecond)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i := range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = b.PatchConfig(context.Background(), map[string]any{fmt.Sprint(i): 1}, "h")
+		}()
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err == nil || err.Error() != "config.patch failed: CONFLICT" {
+			t.Errorf("patch %d: got %v", i, err)
+		}
+	}
+}
+
+func TestBatchClient_SplitsBatchesInOrder(t *testing.T) {
+	inner := &memClient{config: map[string]any{"a": map[string]any{"x": 1}}}
+	b := NewBatchClient(inner, time.Hour)
+	ctx := context.Background()
+
+	first := make(chan error, 1)
+	go func() { first <- b.PatchConfig(ctx, map[string]any{"a": nil}, "h1") }()
+	waitForBatch(t, b)
+
+	// Merging into a key the open batch removes cannot be combined, so the
+	// open batch is written first.
+	second := make(chan error, 1)
+	go func() { second <- b.PatchConfig(ctx, map[string]any{"a": map[string]any{"y": 2}}, "h1") }()
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	waitForBatch(t, b)
+	b.flush()
+	if err := <-second; err != nil {
+		t.Fatal(err)
+	}
+
+	want := []map[string]any{{"a": nil}, {"a": map[string]any{"y": 2}}}
+	if !reflect.DeepEqual(inner.patches, want) {
+		t.Fatalf("patches = %v, want %v", inner.patches, want)
+	}
+	if !reflect.DeepEqual(inner.config["a"], map[string]any{"y": 2}) {
+		t.Errorf("config = %v", inner.config)
+	}
+
+	// A patch against another hash never joins the open batch.
+	go func() { first <- b.PatchConfig(ctx, map[string]any{"b": 1}, "h2") }()
+	waitForBatch(t, b)
+	if err := b.ApplyConfig(ctx, `{"c":1}`, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	if got := inner.patches[len(inner.patches)-2:]; !reflect.DeepEqual(got[0], map[string]any{"b": 1}) || got[1]["<apply>"] == nil {
+		t.Errorf("pending patch was not written before apply: %v", got)
+	}
+}
+
+func TestBatchClient_DoesNotCombineListWriters(t *testing.T) {
+	inner := &memClient{config: map[string]any{}}
+	b := NewBatchClient(inner, time.Hour)
+	ctx := context.Background()
+
+	// Two resources rebuild agents.list from the same config. Combined, only
+	// the second list would be written.
+	first := make(chan error, 1)
+	go func() {
+		first <- b.PatchConfig(ctx, map[string]any{"agents": map[string]any{"list": []any{map[string]any{"id": "a"}}}}, "h1")
+	}()
+	waitForBatch(t, b)
+	second := make(chan error, 1)
+	go func() {
+		second <- b.PatchConfig(ctx, map[string]any{"agents": map[string]any{"list": []any{map[string]any{"id": "b"}}}}, "h1")
+	}()
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	waitForBatch(t, b)
+	b.flush()
+	if err := <-second; err != nil {
+		t.Fatal(err)
+	}
+
+	if len(inner.patches) != 2 || inner.hashes[0] != "h1" || inner.hashes[1] != "h1" {
+		t.Fatalf("inner patches = %v (hashes %v), want 2 against h1", inner.patches, inner.hashes)
+	}
+}
+
+// waitForBatch waits until b has an open batch.
+func waitForBatch(t *testing.T, b *BatchClient) {
+	t.Helper()
+	for range 100 {
+		b.mu.Lock()
+		open := b.cur != nil
+		b.mu.Unlock()
+		if open {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("no batch opened")
+}
+
+func TestComposePatches(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b map[string]any
+		want map[string]any
+		ok   bool
+	}{
+		{"disjoint", map[string]any{"a": 1}, map[string]any{"b": 2}, map[string]any{"a": 1, "b": 2}, true},
+		{"nested", map[string]any{"c": map[string]any{"x": 1}}, map[string]any{"c": map[string]any{"y": nil}}, map[string]any{"c": map[string]any{"x": 1, "y": nil}}, true},
+		{"delete after object", map[string]any{"a": map[string]any{"x": 1}}, map[string]any{"a": nil}, nil, false},
+		{"same list", map[string]any{"a": []any{1}}, map[string]any{"a": []any{2}}, nil, false},
+		{"object after delete", map[string]any{"a": nil}, map[string]any{"a": map[string]any{"x": 1}}, nil, false},
+		{"object after scalar", map[string]any{"a": 1}, map[string]any{"a": map[string]any{"x": 1}}, nil, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := composePatches(tc.a, tc.b)
+			if ok != tc.ok || !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("composePatches = %v, %v; want %v, %v", got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// DefaultConfigCacheTTL is how long CachedClient reuses a fetched config.
+const DefaultConfigCacheTTL = 5 * time.Second
+
+// CachedClient shares config reads between resources and data sources.
+// During a plan every resource refreshes by fetching the whole config;
+// with the cache, a plan over 30 resources fetches it once. Concurrent
+// reads wait for a single fetch, and any write through the client drops the
+// cached copy so a resource never sees config older than its own changes.
+type CachedClient struct {
+	Client
+	ttl time.Duration
+
+	mu       sync.Mutex
+	gen      uint64 // bumped by every write
+	cfg      *ConfigPayload
+	fetched  time.Time
+	inflight *configFetch
+}
+
+type configFetch struct {
+	gen  uint64
+	done chan struct{}
+	cfg  *ConfigPayload
+	err  error
+}
+
+// NewCachedClient wraps c so config reads within ttl of each other share one
+// fetch.
+func NewCachedClient(c Client, ttl time.Duration) *CachedClient {
+	return &CachedClient{Client: c, ttl: ttl}
+}
+
+// GetConfig implements Client.
+func (c *CachedClient) GetConfig(ctx context.Context) (*ConfigPayload, error) {
+	c.mu.Lock()
+	if c.cfg != nil && time.Since(c.fetched) < c.ttl {
+		cfg := *c.cfg
+		c.mu.Unlock()
+		return &cfg, nil
+	}
+	if f := c.inflight; f != nil {
+		c.mu.Unlock()
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if f.err != nil {
+			// The fetch may have failed only because its caller gave up.
+			return c.Client.GetConfig(ctx)
+		}
+		cfg := *f.cfg
+		return &cfg, nil
+	}
+
+	f := &configFetch{gen: c.gen, done: make(chan struct{})}
+	c.inflight = f
+	c.mu.Unlock()
+
+	f.cfg, f.err = c.Client.GetConfig(ctx)
+
+	c.mu.Lock()
+	if c.inflight == f {
+		c.inflight = nil
+	}
+	// A write that started meanwhile may not be reflected; don't keep it.
+	if f.err == nil && f.gen == c.gen {
+		c.cfg = f.cfg
+		c.fetched = time.Now()
+	}
+	c.mu.Unlock()
+	close(f.done)
+
+	if f.err != nil {
+		return nil, f.err
+	}
+	cfg := *f.cfg
+	return &cfg, nil
+}
+
+// GetConfigSection implements Client. A section is taken from the cached
+// full config while it is fresh; otherwise only the section is fetched, so
+// a single resource does not pull the whole config.
+func (c *CachedClient) GetConfigSection(ctx context.Context, keys ...string) (*SectionPayload, error) {
+	c.mu.Lock()
+	if c.cfg != nil && time.Since(c.fetched) < c.ttl {
+		cfg := *c.cfg
+		c.mu.Unlock()
+		return sectionOf(&cfg, keys...)
+	}
+	c.mu.Unlock()
+	return c.Client.GetConfigSection(ctx, keys...)
+}
+
+// invalidate drops the cached config and detaches any fetch in progress, so
+// later reads go to the gateway.
+func (c *CachedClient) invalidate() {
+	c.mu.Lock()
+	c.gen++
+	c.cfg = nil
+	c.inflight = nil
+	c.mu.Unlock()
+}
+
+// PatchConfig implements Client.
+func (c *CachedClient) PatchConfig(ctx context.Context, patch map[string]any, baseHash string) error {
+	c.invalidate()
+	defer c.invalidate()
+	return c.Client.PatchConfig(ctx, patch, baseHash)
+}
+
+// Call implements Client. The RPC may change the config, so the cached copy
+// is dropped.
+func (c *CachedClient) Call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	if replayable(method) {
+		// A read-only RPC leaves the cached config current.
+		return c.Client.Call(ctx, method, params)
+	}
+	c.invalidate()
+	defer c.invalidate()
+	return c.Client.Call(ctx, method, params)
+}
+
+// ApplyConfig implements Client.
+func (c *CachedClient) ApplyConfig(ctx context.Context, raw string, baseHash string) error {
+	c.invalidate()
+	defer c.invalidate()
+	return c.Client.ApplyConfig(ctx, raw, baseHash)
+}
+
+// ImportConfig implements Client.
+func (c *CachedClient) ImportConfig(ctx context.Context, raw string, baseHash string) error {
+	c.invalidate()
+	defer c.invalidate()
+	return c.Client.ImportConfig(ctx, raw, baseHash)
+}
+
+// Unwrap returns the wrapped client.
+func (c *CachedClient) Unwrap() Client { return c.Client }
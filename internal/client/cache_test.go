@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingClient counts GetConfig calls, optionally holding each one until
+// release is closed.
+type countingClient struct {
+	memClient
+	gets    atomic.Int32
+	release chan struct{}
+}
+
+func (c *countingClient) GetConfig(ctx context.Context) (*ConfigPayload, error) {
+	c.gets.Add(1)
+	if c.release != nil {
+		<-c.release
+	}
+	return c.memClient.GetConfig(ctx)
+}
+
+func TestCachedClient_SharesReads(t *testing.T) {
+	inner := &countingClient{memClient: memClient{config: map[string]any{"a": 1}}, release: make(chan struct{})}
+	c := NewCachedClient(inner, time.Minute)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 30 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cfg, err := c.GetConfig(ctx)
+			if err != nil || cfg.Raw != `{"a":1}` {
+				t.Errorf("GetConfig = %v, %v", cfg, err)
+			}
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(inner.release)
+	wg.Wait()
+
+	if _, err := c.GetConfig(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := inner.gets.Load(); n != 1 {
+		t.Errorf("inner GetConfig calls = %d, want 1", n)
+	}
+}
+
+func TestCachedClient_WritesInvalidate(t *testing.T) {
+	inner := &countingClient{memClient: memClient{config: map[string]any{"a": 1}}}
+	c := NewCachedClient(inner, time.Minute)
+	ctx := context.Background()
+
+	before, _ := c.GetConfig(ctx)
+	if err := c.PatchConfig(ctx, map[string]any{"b": 2}, before.Hash); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := c.GetConfig(ctx)
+	if after.Raw != `{"a":1,"b":2}` || after.Hash == before.Hash {
+		t.Errorf("read after patch = %s (hash changed: %v)", after.Raw, after.Hash != before.Hash)
+	}
+
+	if err := c.ApplyConfig(ctx, `{"c":3}`, ""); err != nil {
+		t.Fatal(err)
+	}
+	if cfg, _ := c.GetConfig(ctx); cfg.Raw != `{"c":3}` {
+		t.Errorf("read after apply = %s", cfg.Raw)
+	}
+	if n := inner.gets.Load(); n != 3 {
+		t.Errorf("inner GetConfig calls = %d, want 3", n)
+	}
+}
+
+func TestCachedClient_ReadOnlyCallsKeepCache(t *testing.T) {
+	inner := &countingClient{memClient: memClient{config: map[string]any{}}}
+	c := NewCachedClient(inner, time.Minute)
+	ctx := context.Background()
+
+	c.GetConfig(ctx)
+	c.Call(ctx, "sessions.list", nil)
+	c.GetConfig(ctx)
+	if n := inner.gets.Load(); n != 1 {
+		t.Errorf("inner GetConfig calls after a read-only RPC = %d, want 1", n)
+	}
+	c.Call(ctx, "sessions.reset", nil)
+	c.GetConfig(ctx)
+	if n := inner.gets.Load(); n != 2 {
+		t.Errorf("inner GetConfig calls after a write RPC = %d, want 2", n)
+	}
+}
+
+func TestCachedClient_Expires(t *testing.T) {
+	inner := &countingClient{memClient: memClient{config: map[string]any{}}}
+	c := NewCachedClient(inner, 10*time.Millisecond)
+	ctx := context.Background()
+
+	c.GetConfig(ctx)
+	c.GetConfig(ctx)
+	time.Sleep(20 * time.Millisecond)
+	c.GetConfig(ctx)
+	if n := inner.gets.Load(); n != 2 {
+		t.Errorf("inner GetConfig calls = %d, want 2", n)
+	}
+}
+
+func TestCachedClient_SectionOnColdCacheRequestsSection(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"channels":{"telegram":{"enabled":true}}}`)
+	var asked []any
+	g.handle("config.get", func(params map[string]any) (any, any) {
+		asked = append(asked, params["sections"])
+		if params["sections"] == nil {
+			raw := g.getRaw()
+			return map[string]any{"raw": raw, "hash": hashBytes([]byte(raw))}, nil
+		}
+		return map[string]any{
+			"hash":     "h1",
+			"sections": map[string]any{"channels.telegram": map[string]any{"enabled": true}},
+		}, nil
+	})
+	c := NewCachedClient(newReconnectClient(t, g), time.Minute)
+	ctx := context.Background()
+
+	m, _, err := GetNestedSection(ctx, c, "channels", "telegram")
+	if err != nil || m["enabled"] != true {
+		t.Fatalf("cold section = %v, %v", m, err)
+	}
+	if len(asked) != 1 || !reflect.DeepEqual(asked[0], []any{"channels.telegram"}) {
+		t.Errorf("cold cache sections = %v, want [channels.telegram]", asked)
+	}
+
+	// Once the full config is cached, sections are served from it.
+	if _, err := c.GetConfig(ctx); err != nil {
+		t.Fatal(err)
+	}
+	m, _, err = GetNestedSection(ctx, c, "channels", "telegram")
+	if err != nil || m["enabled"] != true {
+		t.Fatalf("warm section = %v, %v", m, err)
+	}
+	if len(asked) != 2 {
+		t.Errorf("config.get calls = %d, want 2", len(asked))
+	}
+}
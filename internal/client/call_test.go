@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestWSClient_Call(t *testing.T) {
+	g := newFakeGateway(t)
+	var got map[string]any
+	g.handle("sessions.list", func(params map[string]any) (any, any) {
+		got = params
+		return map[string]any{"sessions": []any{map[string]any{"key": "main"}}}, nil
+	})
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	payload, err := c.Call(ctx, "sessions.list", map[string]any{"limit": 10})
+	if err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if string(payload) != `{"sessions":[{"key":"main"}]}` {
+		t.Errorf("payload = %s", payload)
+	}
+	if got["limit"] != float64(10) {
+		t.Errorf("params = %v", got)
+	}
+
+	if _, err := c.Call(ctx, "no.such.method", nil); err == nil || !strings.Contains(err.Error(), "METHOD_NOT_FOUND") {
+		t.Errorf("err = %v, want METHOD_NOT_FOUND", err)
+	}
+}
+
+func TestCachedClient_CallInvalidates(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"a":1}`)
+	g.handle("config.reset", func(map[string]any) (any, any) {
+		g.setRaw(`{}`)
+		return map[string]any{"ok": true}, nil
+	})
+	c := NewCachedClient(newReconnectClient(t, g), DefaultConfigCacheTTL)
+	ctx := context.Background()
+
+	if _, err := c.GetConfig(ctx); err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if _, err := c.Call(ctx, "config.reset", nil); err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if cfg.Raw != `{}` {
+		t.Errorf("raw = %s, want the config after the call", cfg.Raw)
+	}
+}
@@ -19,6 +19,15 @@ type ConfigPayload struct {
 	Parsed map[string]any `json:"-"`
 }
 
+// SectionPayload represents part of the config, as returned by
+// GetConfigSection.
+type SectionPayload struct {
+	// Value is the config value at the requested path, or nil if unset.
+	Value any
+	// Hash is the hash of the whole config, for use as baseHash.
+	Hash string
+}
+
 // HealthPayload represents the response from the health RPC.
 type HealthPayload struct {
 	OK             bool   `json:"ok"`
@@ -34,6 +43,11 @@ type Client interface {
 	// GetConfig retrieves the full OpenClaw configuration.
 	GetConfig(ctx context.Context) (*ConfigPayload, error)
 
+	// GetConfigSection retrieves the value at a config path such as
+	// "channels", "telegram", fetching only that part when the backend
+	// can. On large configs this saves transferring and parsing the rest.
+	GetConfigSection(ctx context.Context, keys ...string) (*SectionPayload, error)
+
 	// PatchConfig applies a partial JSON merge-patch to the config.
 	// The baseHash must match the hash from the last GetConfig call
 	// (optimistic concurrency).
@@ -42,64 +56,79 @@ type Client interface {
 	// ApplyConfig replaces the entire config.
 	ApplyConfig(ctx context.Context, raw string, baseHash string) error
 
+	// ExportConfig retrieves the entire config for safekeeping, including
+	// secrets that GetConfig may leave out.
+	ExportConfig(ctx context.Context) (*ConfigPayload, error)
+
+	// ImportConfig replaces the entire config with one from ExportConfig.
+	ImportConfig(ctx context.Context, raw string, baseHash string) error
+
 	// Health returns gateway health info. Only supported over WS.
 	Health(ctx context.Context) (*HealthPayload, error)
 
+	// Call sends an arbitrary gateway RPC and returns its payload, for
+	// methods without first-class support. Only supported over WS.
+	Call(ctx context.Context, method string, params any) (json.RawMessage, error)
+
 	// Close tears down the underlying connection/resources.
 	Close() error
 }
 
 // GetSection is a helper that reads a top-level config section as a typed map.
 func GetSection(ctx context.Context, c Client, key string) (map[string]any, string, error) {
-	cfg, err := c.GetConfig(ctx)
+	sec, err := c.GetConfigSection(ctx, key)
 	if err != nil {
 		return nil, "", fmt.Errorf("reading config: %w", err)
 	}
-
-	parsed, err := parseRawJSON(cfg.Raw)
-	if err != nil {
-		return nil, cfg.Hash, fmt.Errorf("parsing config JSON: %w", err)
-	}
-
-	section, ok := parsed[key]
-	if !ok {
-		return nil, cfg.Hash, nil // section doesn't exist yet
+	if sec.Value == nil {
+		return nil, sec.Hash, nil // section doesn't exist yet
 	}
 
-	m, ok := section.(map[string]any)
+	m, ok := sec.Value.(map[string]any)
 	if !ok {
-		return nil, cfg.Hash, fmt.Errorf("config key %q is not an object", key)
+		return nil, sec.Hash, fmt.Errorf("config key %q is not an object", key)
 	}
 
-	return m, cfg.Hash, nil
+	return m, sec.Hash, nil
 }
 
 // GetNestedSection reads a nested config path like "channels.whatsapp".
 func GetNestedSection(ctx context.Context, c Client, keys ...string) (map[string]any, string, error) {
-	cfg, err := c.GetConfig(ctx)
+	sec, err := c.GetConfigSection(ctx, keys...)
 	if err != nil {
 		return nil, "", fmt.Errorf("reading config: %w", err)
 	}
+	if sec.Value == nil {
+		return nil, sec.Hash, nil
+	}
+
+	m, ok := sec.Value.(map[string]any)
+	if !ok {
+		return nil, sec.Hash, fmt.Errorf("config path %v at index %d is not an object", keys, len(keys)-1)
+	}
+
+	return m, sec.Hash, nil
+}
 
+// sectionOf picks the value at keys out of a full config.
+func sectionOf(cfg *ConfigPayload, keys ...string) (*SectionPayload, error) {
 	parsed, err := parseRawJSON(cfg.Raw)
 	if err != nil {
-		return nil, cfg.Hash, fmt.Errorf("parsing config JSON: %w", err)
+		return nil, fmt.Errorf("parsing config JSON: %w", err)
 	}
 
-	current := parsed
+	var value any = parsed
 	for i, key := range keys {
-		val, ok := current[key]
+		m, ok := value.(map[string]any)
 		if !ok {
-			return nil, cfg.Hash, nil
+			return nil, fmt.Errorf("config path %v at index %d is not an object", keys[:i], i-1)
 		}
-		m, ok := val.(map[string]any)
-		if !ok {
-			return nil, cfg.Hash, fmt.Errorf("config path %v at index %d is not an object", keys[:i+1], i)
+		if value, ok = m[key]; !ok {
+			return &SectionPayload{Hash: cfg.Hash}, nil
 		}
-		current = m
 	}
 
-	return current, cfg.Hash, nil
+	return &SectionPayload{Value: value, Hash: cfg.Hash}, nil
 }
 
 // PatchSection writes a single top-level section via merge-patch.
@@ -125,12 +154,29 @@ func DeleteSection(ctx context.Context, c Client, key string, baseHash string) e
 	return c.PatchConfig(ctx, patch, baseHash)
 }
 
-// parseRawJSON parses a JSON (or JSON5-compatible subset) string into a map.
-// OpenClaw's config.get RPC returns standard JSON even though the file is JSON5.
+// parseRawJSON parses a JSON or JSON5 config string into a map. Strict JSON
+// is tried first; config files edited by hand are usually JSON5.
 func parseRawJSON(raw string) (map[string]any, error) {
 	var result map[string]any
-	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+	err := json.Unmarshal([]byte(raw), &result)
+	if err == nil {
+		return result, nil
+	}
+	converted, err5 := json5ToJSON([]byte(raw))
+	if err5 != nil {
+		// Report the JSON5 error: it is a superset, so its position is the
+		// one that points at the real problem.
+		return nil, fmt.Errorf("parsing config: %w", err5)
+	}
+	result = nil
+	if err := json.Unmarshal(converted, &result); err != nil {
 		return nil, fmt.Errorf("json unmarshal: %w", err)
 	}
 	return result, nil
 }
+
+// ParseConfig parses the raw config returned by GetConfig, which may be
+// JSON or JSON5.
+func ParseConfig(raw string) (map[string]any, error) {
+	return parseRawJSON(raw)
+}
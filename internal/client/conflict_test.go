@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestWSClient_PatchRetriesOnHashConflict(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"a":1}`)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	// Another resource writes between this one's read and its patch.
+	g.setRaw(`{"a":1,"other":true}`)
+
+	if err := c.PatchConfig(ctx, map[string]any{"b": 2}, cfg.Hash); err != nil {
+		t.Fatalf("PatchConfig: %v", err)
+	}
+	if got := g.getRaw(); got != `{"a":1,"b":2,"other":true}` {
+		t.Errorf("raw = %s", got)
+	}
+	if n := g.callCount("config.patch"); n != 2 {
+		t.Errorf("config.patch calls = %d, want 2", n)
+	}
+}
+
+func TestWSClient_PatchConflictRetriesAreBounded(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("config.patch", func(map[string]any) (any, any) {
+		return nil, map[string]any{"code": "CONFLICT", "message": "config changed since last load; baseHash mismatch"}
+	})
+	c := newReconnectClient(t, g)
+
+	err := c.PatchConfig(context.Background(), map[string]any{"b": 2}, "stale")
+	if err == nil || !strings.Contains(err.Error(), "baseHash mismatch") {
+		t.Fatalf("err = %v, want hash mismatch", err)
+	}
+	if n, want := g.callCount("config.patch"), patchConflictRetries+1; n != want {
+		t.Errorf("config.patch calls = %d, want %d", n, want)
+	}
+}
+
+func TestWSClient_PatchDoesNotRetryLists(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"agents":{"list":[{"id":"a"}]}}`)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	// Another resource adds its agent between this one's read and its patch.
+	g.setRaw(`{"agents":{"list":[{"id":"a"},{"id":"b"}]}}`)
+
+	patch := map[string]any{"agents": map[string]any{"list": []any{map[string]any{"id": "a"}, map[string]any{"id": "c"}}}}
+	err = c.PatchConfig(ctx, patch, cfg.Hash)
+	if err == nil || !strings.Contains(err.Error(), "baseHash mismatch") {
+		t.Fatalf("err = %v, want hash mismatch", err)
+	}
+	if got := g.getRaw(); got != `{"agents":{"list":[{"id":"a"},{"id":"b"}]}}` {
+		t.Errorf("raw = %s", got)
+	}
+	if n := g.callCount("config.patch"); n != 1 {
+		t.Errorf("config.patch calls = %d, want 1", n)
+	}
+}
+
+func TestWSClient_PatchDoesNotRetryOtherErrors(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("config.patch", func(map[string]any) (any, any) {
+		return nil, map[string]any{"code": "INVALID", "message": "channels.telegram.dmPolicy: invalid value"}
+	})
+	c := newReconnectClient(t, g)
+
+	if err := c.PatchConfig(context.Background(), map[string]any{"b": 2}, "h"); err == nil {
+		t.Fatal("PatchConfig succeeded, want error")
+	}
+	if n := g.callCount("config.patch"); n != 1 {
+		t.Errorf("config.patch calls = %d, want 1", n)
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestWSClient_ConnectRetries(t *testing.T) {
+	g := newFakeGateway(t)
+	var failures atomic.Int32
+	failures.Store(2)
+	g.handle("connect", func(map[string]any) (any, any) {
+		if failures.Add(-1) >= 0 {
+			return nil, map[string]any{"code": "UNAVAILABLE", "message": "gateway starting"}
+		}
+		return map[string]any{"protocol": 3}, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{
+		URL:            g.URL(),
+		ConnectRetries: intPtr(2),
+		MaxBackoff:     10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("expected success on third attempt: %v", err)
+	}
+	defer c.Close()
+
+	if n := g.callCount("connect"); n != 3 {
+		t.Fatalf("expected 3 connect attempts, got %d", n)
+	}
+}
+
+func TestWSClient_ConnectRetriesExhausted(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("connect", func(map[string]any) (any, any) {
+		return nil, map[string]any{"code": "UNAVAILABLE", "message": "gateway starting"}
+	})
+
+	_, err := NewWSClient(context.Background(), WSClientConfig{
+		URL:            g.URL(),
+		ConnectRetries: intPtr(1),
+		MaxBackoff:     10 * time.Millisecond,
+	})
+	if err == nil || !strings.Contains(err.Error(), "after 2 attempts") {
+		t.Fatalf("expected failure after 2 attempts, got %v", err)
+	}
+	if n := g.callCount("connect"); n != 2 {
+		t.Fatalf("expected 2 connect attempts, got %d", n)
+	}
+}
+
+func TestWSClient_ConnectTimeout(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("connect", func(map[string]any) (any, any) {
+		time.Sleep(2 * time.Second)
+		return map[string]any{"protocol": 3}, nil
+	})
+
+	start := time.Now()
+	_, err := NewWSClient(context.Background(), WSClientConfig{
+		URL:            g.URL(),
+		ConnectRetries: intPtr(0),
+		ConnectTimeout: 200 * time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("expected connect timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("connect_timeout not applied: took %s", elapsed)
+	}
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CronJob is a scheduled job from the gateway's job store, as returned by
+// cron.list. Jobs live outside the config file, so this includes jobs
+// created from chat. Timestamps are in milliseconds since the Unix epoch.
+type CronJob struct {
+	ID       string       `json:"id"`
+	Name     string       `json:"name,omitempty"`
+	AgentID  string       `json:"agentId,omitempty"`
+	Enabled  bool         `json:"enabled"`
+	Schedule CronSchedule `json:"schedule"`
+	State    CronJobState `json:"state"`
+}
+
+// CronSchedule is when a job runs. Kind is "cron" (Expr, in TZ), "every"
+// (EveryMs) or "at" (once, at AtMs).
+type CronSchedule struct {
+	Kind    string `json:"kind"`
+	Expr    string `json:"expr,omitempty"`
+	TZ      string `json:"tz,omitempty"`
+	EveryMs int64  `json:"everyMs,omitempty"`
+	AtMs    int64  `json:"atMs,omitempty"`
+}
+
+// CronJobState is what the gateway recorded about a job's runs. Fields are
+// zero for a job that has not run yet.
+type CronJobState struct {
+	LastRunAtMs int64  `json:"lastRunAtMs,omitempty"`
+	LastStatus  string `json:"lastStatus,omitempty"`
+	NextRunAtMs int64  `json:"nextRunAtMs,omitempty"`
+}
+
+// ListCronJobs returns all jobs in the gateway's job store, including
+// disabled ones.
+func ListCronJobs(ctx context.Context, c Client) ([]CronJob, error) {
+	payload, err := c.Call(ctx, "cron.list", map[string]any{"includeDisabled": true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Jobs []CronJob `json:"jobs"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("decode cron.list response: %w", err)
+	}
+	return out.Jobs, nil
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListCronJobs(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("cron.list", func(p map[string]any) (any, any) {
+		if p["includeDisabled"] != true {
+			return nil, map[string]any{"code": "INVALID_REQUEST", "message": "expected includeDisabled"}
+		}
+		return map[string]any{"jobs": []any{
+			map[string]any{
+				"id":       "daily-digest",
+				"name":     "Daily digest",
+				"agentId":  "main",
+				"enabled":  true,
+				"schedule": map[string]any{"kind": "cron", "expr": "0 8 * * *", "tz": "Europe/London"},
+				"state":    map[string]any{"lastRunAtMs": 1791000000000, "lastStatus": "ok", "nextRunAtMs": 1791086400000},
+			},
+			map[string]any{
+				"id":       "poll",
+				"enabled":  false,
+				"schedule": map[string]any{"kind": "every", "everyMs": 900000},
+			},
+		}}, nil
+	})
+	c := newReconnectClient(t, g)
+
+	jobs, err := ListCronJobs(context.Background(), c)
+	if err != nil {
+		t.Fatalf("ListCronJobs: %v", err)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("got %d jobs, want 2", len(jobs))
+	}
+	if j := jobs[0]; j.Schedule.Expr != "0 8 * * *" || j.Schedule.TZ != "Europe/London" || j.State.LastStatus != "ok" {
+		t.Errorf("job = %+v", j)
+	}
+	if j := jobs[1]; j.Enabled || j.Schedule.EveryMs != 900000 || j.State.LastRunAtMs != 0 {
+		t.Errorf("job = %+v", j)
+	}
+}
+
+func TestListCronJobs_FileMode(t *testing.T) {
+	c, err := NewFileClient(t.TempDir() + "/openclaw.json")
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	if _, err := ListCronJobs(context.Background(), c); err == nil {
+		t.Fatal("ListCronJobs succeeded in file mode")
+	}
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Device is a client paired with the gateway, as returned by the devices
+// RPCs. LastSeenAtMs is in milliseconds since the Unix epoch, and zero for a
+// device that has not connected since it was paired.
+type Device struct {
+	DeviceID      string   `json:"deviceId"`
+	PublicKey     string   `json:"publicKey,omitempty"`
+	DisplayName   string   `json:"displayName,omitempty"`
+	Role          string   `json:"role,omitempty"`
+	Scopes        []string `json:"scopes,omitempty"`
+	LastSeenAtMs  int64    `json:"lastSeenAtMs,omitempty"`
+	ClientID      string   `json:"clientId,omitempty"`
+	ClientMode    string   `json:"clientMode,omitempty"`
+	ClientVersion string   `json:"clientVersion,omitempty"`
+	Platform      string   `json:"platform,omitempty"`
+}
+
+// DeviceApproval identifies a pending device, by ID or public key, and the
+// access to grant it.
+type DeviceApproval struct {
+	DeviceID  string   `json:"deviceId,omitempty"`
+	PublicKey string   `json:"publicKey,omitempty"`
+	Role      string   `json:"role,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+}
+
+// ListDevices returns the devices paired with the gateway. Devices waiting
+// for approval are not included.
+func ListDevices(ctx context.Context, c Client) ([]Device, error) {
+	payload, err := c.Call(ctx, "devices.list", nil)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Devices []Device `json:"devices"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("decode devices.list response: %w", err)
+	}
+	return out.Devices, nil
+}
+
+// FindDevice returns the paired device with the given ID, or nil if there
+// is none.
+func FindDevice(ctx context.Context, c Client, deviceID string) (*Device, error) {
+	devices, err := ListDevices(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	for i := range devices {
+		if devices[i].DeviceID == deviceID {
+			return &devices[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ApproveDevice pairs a pending device and returns it as paired.
+func ApproveDevice(ctx context.Context, c Client, a DeviceApproval) (*Device, error) {
+	payload, err := c.Call(ctx, "devices.approve", a)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Device Device `json:"device"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("decode devices.approve response: %w", err)
+	}
+	if out.Device.DeviceID == "" {
+		return nil, fmt.Errorf("devices.approve returned no device")
+	}
+	return &out.Device, nil
+}
+
+// UpdateDevice changes the role and scopes of a paired device.
+func UpdateDevice(ctx context.Context, c Client, deviceID, role string, scopes []string) error {
+	_, err := c.Call(ctx, "devices.update", map[string]any{"deviceId": deviceID, "role": role, "scopes": scopes})
+	return err
+}
+
+// RevokeDevice unpairs a device. Its connections are closed and it has to
+// pair again to reconnect.
+func RevokeDevice(ctx context.Context, c Client, deviceID string) error {
+	_, err := c.Call(ctx, "devices.revoke", map[string]any{"deviceId": deviceID})
+	return err
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// fakeDevices serves the devices RPCs from an in-memory list on g.
+func fakeDevices(g *fakeGateway) {
+	var mu sync.Mutex
+	paired := map[string]map[string]any{}
+	pending := map[string]string{"pk-laptop": "dev-laptop"} // public key -> device ID
+
+	g.handle("devices.list", func(map[string]any) (any, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		list := []any{}
+		for _, d := range paired {
+			list = append(list, d)
+		}
+		return map[string]any{"devices": list}, nil
+	})
+	g.handle("devices.approve", func(p map[string]any) (any, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		id, _ := p["deviceId"].(string)
+		if pk, ok := p["publicKey"].(string); ok {
+			id = pending[pk]
+		}
+		if id == "" {
+			return nil, map[string]any{"code": "NOT_FOUND", "message": "no such pending device"}
+		}
+		d := map[string]any{"deviceId": id, "publicKey": "pk-laptop", "role": p["role"], "scopes": p["scopes"]}
+		paired[id] = d
+		return map[string]any{"device": d}, nil
+	})
+	g.handle("devices.update", func(p map[string]any) (any, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		d, ok := paired[p["deviceId"].(string)]
+		if !ok {
+			return nil, map[string]any{"code": "NOT_FOUND", "message": "no such device"}
+		}
+		d["role"], d["scopes"] = p["role"], p["scopes"]
+		return map[string]any{"ok": true}, nil
+	})
+	g.handle("devices.revoke", func(p map[string]any) (any, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		delete(paired, p["deviceId"].(string))
+		return map[string]any{"ok": true}, nil
+	})
+}
+
+func TestDevices_Lifecycle(t *testing.T) {
+	g := newFakeGateway(t)
+	fakeDevices(g)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	d, err := ApproveDevice(ctx, c, DeviceApproval{PublicKey: "pk-laptop", Role: "operator", Scopes: []string{"operator.read"}})
+	if err != nil {
+		t.Fatalf("ApproveDevice: %v", err)
+	}
+	if d.DeviceID != "dev-laptop" || d.Role != "operator" {
+		t.Errorf("approved device = %+v", d)
+	}
+
+	if err := UpdateDevice(ctx, c, "dev-laptop", "operator", []string{"operator.read", "operator.write"}); err != nil {
+		t.Fatalf("UpdateDevice: %v", err)
+	}
+	d, err = FindDevice(ctx, c, "dev-laptop")
+	if err != nil {
+		t.Fatalf("FindDevice: %v", err)
+	}
+	if d == nil || !slices.Equal(d.Scopes, []string{"operator.read", "operator.write"}) {
+		t.Errorf("device after update = %+v", d)
+	}
+
+	if err := RevokeDevice(ctx, c, "dev-laptop"); err != nil {
+		t.Fatalf("RevokeDevice: %v", err)
+	}
+	if d, err := FindDevice(ctx, c, "dev-laptop"); err != nil || d != nil {
+		t.Errorf("FindDevice after revoke = %+v, %v; want nil", d, err)
+	}
+}
+
+func TestDevices_ApproveUnknown(t *testing.T) {
+	g := newFakeGateway(t)
+	fakeDevices(g)
+	c := newReconnectClient(t, g)
+
+	if _, err := ApproveDevice(context.Background(), c, DeviceApproval{PublicKey: "pk-unknown"}); err == nil {
+		t.Fatal("ApproveDevice of an unknown key succeeded")
+	}
+}
+
+func TestDevices_FileMode(t *testing.T) {
+	c, err := NewFileClient(t.TempDir() + "/openclaw.json")
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	if _, err := ListDevices(context.Background(), c); err == nil {
+		t.Fatal("ListDevices succeeded in file mode")
+	}
+}
+
+func TestDevices_ListClientInfo(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("devices.list", func(map[string]any) (any, any) {
+		return map[string]any{"devices": []any{
+			map[string]any{
+				"deviceId":      "dev-laptop",
+				"role":          "operator",
+				"lastSeenAtMs":  1791000000000,
+				"clientId":      "openclaw-macos",
+				"clientMode":    "ui",
+				"clientVersion": "2026.10.1",
+				"platform":      "darwin",
+			},
+		}}, nil
+	})
+	c := newReconnectClient(t, g)
+
+	devices, err := ListDevices(context.Background(), c)
+	if err != nil {
+		t.Fatalf("ListDevices: %v", err)
+	}
+	if len(devices) != 1 {
+		t.Fatalf("got %d devices, want 1", len(devices))
+	}
+	d := devices[0]
+	if d.LastSeenAtMs != 1791000000000 || d.ClientID != "openclaw-macos" || d.ClientMode != "ui" || d.ClientVersion != "2026.10.1" || d.Platform != "darwin" {
+		t.Errorf("device = %+v", d)
+	}
+}
@@ -0,0 +1,350 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// Discovery sources, in the order DiscoverGateways tries them by default.
+const (
+	DiscoverTailscale = "tailscale"
+	DiscoverMDNS      = "mdns"
+)
+
+// DefaultGatewayPort is the port an OpenClaw gateway listens on unless
+// configured otherwise.
+const DefaultGatewayPort = 18789
+
+// DefaultDiscoveryTimeout bounds how long each discovery source may take.
+const DefaultDiscoveryTimeout = 3 * time.Second
+
+// mdnsService is the DNS-SD service type gateways advertise on the LAN.
+const mdnsService = "_openclaw-gw._tcp.local."
+
+// DiscoveredGateway is a gateway found on the tailnet or local network.
+type DiscoveredGateway struct {
+	// Name is the peer hostname or mDNS instance name.
+	Name string
+	// Host is the DNS name of the machine (MagicDNS or .local name).
+	Host string
+	// Address is the IP address the gateway was found at.
+	Address string
+	Port    int
+	// Source is DiscoverTailscale or DiscoverMDNS.
+	Source string
+}
+
+// URL returns the WebSocket URL of the gateway.
+func (g DiscoveredGateway) URL() string {
+	host := g.Address
+	if host == "" {
+		host = g.Host
+	}
+	return "ws://" + net.JoinHostPort(host, strconv.Itoa(g.Port))
+}
+
+// DiscoverOptions controls DiscoverGateways.
+type DiscoverOptions struct {
+	// Sources lists the sources to query, in order. Defaults to Tailscale
+	// then mDNS.
+	Sources []string
+	// Port is probed on Tailscale peers. Defaults to DefaultGatewayPort.
+	Port int
+	// Timeout bounds each source. Defaults to DefaultDiscoveryTimeout.
+	Timeout time.Duration
+}
+
+// DiscoverGateways looks for gateways on the tailnet and the local network.
+// Tailscale peers count when they are online and accept connections on the
+// gateway port; on the LAN, gateways are found through their mDNS
+// advertisement. Results keep source order and are sorted by name within a
+// source. A failing source, such as a missing tailscale binary, is only
+// reported when no source found anything.
+func DiscoverGateways(ctx context.Context, opts DiscoverOptions) ([]DiscoveredGateway, error) {
+	if len(opts.Sources) == 0 {
+		opts.Sources = []string{DiscoverTailscale, DiscoverMDNS}
+	}
+	if opts.Port <= 0 {
+		opts.Port = DefaultGatewayPort
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultDiscoveryTimeout
+	}
+
+	var found []DiscoveredGateway
+	var errs []error
+	seen := make(map[string]bool)
+	for _, source := range opts.Sources {
+		sctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		var gws []DiscoveredGateway
+		var err error
+		switch source {
+		case DiscoverTailscale:
+			gws, err = discoverTailscale(sctx, opts.Port)
+		case DiscoverMDNS:
+			gws, err = discoverMDNS(sctx)
+		default:
+			err = fmt.Errorf("unknown discovery source %q", source)
+		}
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", source, err))
+			continue
+		}
+		for _, g := range gws {
+			if key := g.URL(); !seen[key] {
+				seen[key] = true
+				found = append(found, g)
+			}
+		}
+	}
+	if len(found) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return found, nil
+}
+
+// ── Tailscale ───────────────────────────────────────────────
+
+// tailscaleStatus returns the output of `tailscale status --json`.
+var tailscaleStatus = func(ctx context.Context) ([]byte, error) {
+	out, err := exec.CommandContext(ctx, "tailscale", "status", "--json").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("tailscale status: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("tailscale status: %w", err)
+	}
+	return out, nil
+}
+
+// probeGateway reports whether something accepts TCP connections at addr.
+var probeGateway = func(ctx context.Context, addr string) bool {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+type tailscalePeer struct {
+	HostName     string   `json:"HostName"`
+	DNSName      string   `json:"DNSName"`
+	TailscaleIPs []string `json:"TailscaleIPs"`
+	Online       bool     `json:"Online"`
+}
+
+func discoverTailscale(ctx context.Context, port int) ([]DiscoveredGateway, error) {
+	out, err := tailscaleStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var status struct {
+		Peer map[string]tailscalePeer `json:"Peer"`
+	}
+	if err := json.Unmarshal(out, &status); err != nil {
+		return nil, fmt.Errorf("parsing tailscale status: %w", err)
+	}
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		found []DiscoveredGateway
+	)
+	for _, peer := range status.Peer {
+		addr := tailscaleAddr(peer.TailscaleIPs)
+		if !peer.Online || addr == "" {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !probeGateway(ctx, net.JoinHostPort(addr, strconv.Itoa(port))) {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			found = append(found, DiscoveredGateway{
+				Name:    peer.HostName,
+				Host:    strings.TrimSuffix(peer.DNSName, "."),
+				Address: addr,
+				Port:    port,
+				Source:  DiscoverTailscale,
+			})
+		}()
+	}
+	wg.Wait()
+	sortGateways(found)
+	return found, nil
+}
+
+// tailscaleAddr prefers a peer's IPv4 address, which every client can route.
+func tailscaleAddr(ips []string) string {
+	for _, ip := range ips {
+		if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() != nil {
+			return ip
+		}
+	}
+	if len(ips) > 0 {
+		return ips[0]
+	}
+	return ""
+}
+
+// ── mDNS ────────────────────────────────────────────────────
+
+var mdnsGroup = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: 5353}
+
+// discoverMDNS sends a DNS-SD browse query for gateways and collects answers
+// until ctx is done. The query goes out from an ephemeral port, so
+// responders reply to it directly (RFC 6762 legacy unicast) and no socket on
+// 5353 is needed.
+func discoverMDNS(ctx context.Context) ([]DiscoveredGateway, error) {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4zero})
+	if err != nil {
+		return nil, fmt.Errorf("opening mDNS socket: %w", err)
+	}
+	defer conn.Close()
+
+	query, err := mdnsQuery()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.WriteToUDP(query, mdnsGroup); err != nil {
+		return nil, fmt.Errorf("sending mDNS query: %w", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(DefaultDiscoveryTimeout)
+	}
+	conn.SetReadDeadline(deadline)
+
+	recs := newMDNSRecords()
+	buf := make([]byte, 9000)
+	for {
+		n, _, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				break
+			}
+			return nil, fmt.Errorf("reading mDNS responses: %w", err)
+		}
+		recs.add(buf[:n])
+	}
+	return recs.gateways(), nil
+}
+
+func mdnsQuery() ([]byte, error) {
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{})
+	if err := b.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := b.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName(mdnsService),
+		Type:  dnsmessage.TypePTR,
+		Class: dnsmessage.ClassINET,
+	}); err != nil {
+		return nil, err
+	}
+	return b.Finish()
+}
+
+// mdnsRecords accumulates the records of one browse. Responders may split
+// the PTR, SRV and address records across packets.
+type mdnsRecords struct {
+	instances map[string]string // lowercased name to name as advertised
+	srv       map[string]dnsmessage.SRVResource
+	addrs     map[string]string
+}
+
+func newMDNSRecords() *mdnsRecords {
+	return &mdnsRecords{
+		instances: make(map[string]string),
+		srv:       make(map[string]dnsmessage.SRVResource),
+		addrs:     make(map[string]string),
+	}
+}
+
+// add records the resources of one response. Malformed packets are ignored.
+func (m *mdnsRecords) add(msg []byte) {
+	var p dnsmessage.Parser
+	if _, err := p.Start(msg); err != nil {
+		return
+	}
+	if err := p.SkipAllQuestions(); err != nil {
+		return
+	}
+	var all []dnsmessage.Resource
+	for _, section := range []func() ([]dnsmessage.Resource, error){p.AllAnswers, p.AllAuthorities, p.AllAdditionals} {
+		rs, err := section()
+		if err != nil {
+			break
+		}
+		all = append(all, rs...)
+	}
+
+	for _, r := range all {
+		name := strings.ToLower(r.Header.Name.String())
+		switch body := r.Body.(type) {
+		case *dnsmessage.PTRResource:
+			if name == mdnsService {
+				m.instances[strings.ToLower(body.PTR.String())] = body.PTR.String()
+			}
+		case *dnsmessage.SRVResource:
+			m.srv[name] = *body
+		case *dnsmessage.AResource:
+			m.addrs[name] = net.IP(body.A[:]).String()
+		case *dnsmessage.AAAAResource:
+			if _, ok := m.addrs[name]; !ok {
+				m.addrs[name] = net.IP(body.AAAA[:]).String()
+			}
+		}
+	}
+}
+
+// gateways resolves each advertised instance to its host, address and port.
+// Instances whose SRV record never arrived are skipped.
+func (m *mdnsRecords) gateways() []DiscoveredGateway {
+	var found []DiscoveredGateway
+	for instance, advertised := range m.instances {
+		srv, ok := m.srv[instance]
+		if !ok {
+			continue
+		}
+		target := strings.ToLower(srv.Target.String())
+		found = append(found, DiscoveredGateway{
+			Name:    advertised[:len(advertised)-len("."+mdnsService)],
+			Host:    strings.TrimSuffix(target, "."),
+			Address: m.addrs[target],
+			Port:    int(srv.Port),
+			Source:  DiscoverMDNS,
+		})
+	}
+	sortGateways(found)
+	return found
+}
+
+func sortGateways(gws []DiscoveredGateway) {
+	sort.Slice(gws, func(i, j int) bool {
+		if gws[i].Name != gws[j].Name {
+			return gws[i].Name < gws[j].Name
+		}
+		return gws[i].Address < gws[j].Address
+	})
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func stubTailscale(t *testing.T, status string, listening map[string]bool) {
+	t.Helper()
+	origStatus, origProbe := tailscaleStatus, probeGateway
+	t.Cleanup(func() { tailscaleStatus, probeGateway = origStatus, origProbe })
+	tailscaleStatus = func(context.Context) ([]byte, error) { return []byte(status), nil }
+	probeGateway = func(_ context.Context, addr string) bool { return listening[addr] }
+}
+
+func TestDiscoverGateways_Tailscale(t *testing.T) {
+	stubTailscale(t, `{
+  "Self": {"HostName": "laptop", "TailscaleIPs": ["100.64.0.1"], "Online": true},
+  "Peer": {
+    "nodekey:a": {"HostName": "homelab", "DNSName": "homelab.tail1234.ts.net.", "TailscaleIPs": ["fd7a:115c:a1e0::2", "100.64.0.2"], "Online": true},
+    "nodekey:b": {"HostName": "nas", "DNSName": "nas.tail1234.ts.net.", "TailscaleIPs": ["100.64.0.3"], "Online": true},
+    "nodekey:c": {"HostName": "old-pi", "DNSName": "old-pi.tail1234.ts.net.", "TailscaleIPs": ["100.64.0.4"], "Online": false}
+  }
+}`, map[string]bool{"100.64.0.2:18789": true, "100.64.0.4:18789": true})
+
+	got, err := DiscoverGateways(context.Background(), DiscoverOptions{Sources: []string{DiscoverTailscale}})
+	if err != nil {
+		t.Fatalf("DiscoverGateways: %v", err)
+	}
+	want := DiscoveredGateway{
+		Name:    "homelab",
+		Host:    "homelab.tail1234.ts.net",
+		Address: "100.64.0.2",
+		Port:    18789,
+		Source:  DiscoverTailscale,
+	}
+	if len(got) != 1 || got[0] != want {
+		t.Fatalf("got %+v, want [%+v]", got, want)
+	}
+	if url := got[0].URL(); url != "ws://100.64.0.2:18789" {
+		t.Errorf("URL() = %s", url)
+	}
+}
+
+func TestDiscoverGateways_SourceErrors(t *testing.T) {
+	orig := tailscaleStatus
+	t.Cleanup(func() { tailscaleStatus = orig })
+	tailscaleStatus = func(context.Context) ([]byte, error) {
+		return nil, errors.New("tailscale status: executable file not found")
+	}
+
+	_, err := DiscoverGateways(context.Background(), DiscoverOptions{Sources: []string{DiscoverTailscale, "bonjour"}})
+	if err == nil {
+		t.Fatal("expected error when every source fails")
+	}
+	for _, want := range []string{"tailscale: tailscale status", `unknown discovery source "bonjour"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func buildMDNSResponse(t *testing.T, build func(b *dnsmessage.Builder) error) []byte {
+	t.Helper()
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{Response: true, Authoritative: true})
+	if err := b.StartAnswers(); err != nil {
+		t.Fatal(err)
+	}
+	if err := build(&b); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := b.Finish()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+func TestMDNSRecords(t *testing.T) {
+	hdr := func(name string, typ dnsmessage.Type) dnsmessage.ResourceHeader {
+		return dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Type: typ, Class: dnsmessage.ClassINET, TTL: 120}
+	}
+	instance := "Office Mini._openclaw-gw._tcp.local."
+
+	recs := newMDNSRecords()
+	// PTR in one packet, SRV and A in another, as some responders send them.
+	recs.add(buildMDNSResponse(t, func(b *dnsmessage.Builder) error {
+		if err := b.PTRResource(hdr(mdnsService, dnsmessage.TypePTR), dnsmessage.PTRResource{PTR: dnsmessage.MustNewName(instance)}); err != nil {
+			return err
+		}
+		// An advertisement for another service is ignored.
+		return b.PTRResource(hdr("_http._tcp.local.", dnsmessage.TypePTR), dnsmessage.PTRResource{PTR: dnsmessage.MustNewName("web._http._tcp.local.")})
+	}))
+	recs.add(buildMDNSResponse(t, func(b *dnsmessage.Builder) error {
+		if err := b.SRVResource(hdr(instance, dnsmessage.TypeSRV), dnsmessage.SRVResource{Target: dnsmessage.MustNewName("office-mini.local."), Port: 18790}); err != nil {
+			return err
+		}
+		return b.AResource(hdr("office-mini.local.", dnsmessage.TypeA), dnsmessage.AResource{A: [4]byte{192, 168, 1, 20}})
+	}))
+	recs.add([]byte("not dns"))
+
+	got := recs.gateways()
+	want := DiscoveredGateway{
+		Name:    "Office Mini",
+		Host:    "office-mini.local",
+		Address: "192.168.1.20",
+		Port:    18790,
+		Source:  DiscoverMDNS,
+	}
+	if len(got) != 1 || got[0] != want {
+		t.Fatalf("got %+v, want [%+v]", got, want)
+	}
+}
+
+func TestMDNSQuery(t *testing.T) {
+	msg, err := mdnsQuery()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var p dnsmessage.Parser
+	if _, err := p.Start(msg); err != nil {
+		t.Fatal(err)
+	}
+	q, err := p.Question()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Name.String() != mdnsService || q.Type != dnsmessage.TypePTR {
+		t.Errorf("unexpected question %v", q)
+	}
+}
@@ -0,0 +1,280 @@
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// DefaultDockerHost is the Docker Engine endpoint used when none is given.
+const DefaultDockerHost = "unix:///var/run/docker.sock"
+
+// DefaultContainerConfigPath is where the official OpenClaw image keeps its
+// config file.
+const DefaultContainerConfigPath = "/home/node/.openclaw/openclaw.json"
+
+// DockerConfig locates a config file inside a container.
+type DockerConfig struct {
+	// Host is the Docker Engine endpoint in DOCKER_HOST form
+	// (unix:///var/run/docker.sock or tcp://host:2375). Defaults to
+	// DefaultDockerHost.
+	Host string
+	// Container is the container name or ID.
+	Container string
+	// Path is the absolute path of the config file inside the container.
+	// Defaults to DefaultContainerConfigPath.
+	Path string
+}
+
+// NewDockerFileClient returns a FileClient for a config file inside a running
+// container. The file is copied in and out with the Docker Engine archive API,
+// so neither a bind mount nor the gateway port is needed.
+func NewDockerFileClient(ctx context.Context, cfg DockerConfig) (*FileClient, error) {
+	if cfg.Container == "" {
+		return nil, fmt.Errorf("docker container name is required")
+	}
+	if cfg.Path == "" {
+		cfg.Path = DefaultContainerConfigPath
+	}
+	if !path.IsAbs(cfg.Path) {
+		return nil, fmt.Errorf("config path %q must be absolute inside the container", cfg.Path)
+	}
+	d, err := newDockerFile(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.inspect(ctx); err != nil {
+		return nil, err
+	}
+	return &FileClient{store: d}, nil
+}
+
+// dockerFile is a configStore backed by the Docker Engine API.
+type dockerFile struct {
+	http      *http.Client
+	base      string
+	container string
+	path      string
+
+	// resolved is path after following symlinks on the last read, and mode
+	// the file mode found there. Writes reuse both.
+	resolved string
+	mode     int64
+}
+
+func newDockerFile(cfg DockerConfig) (*dockerFile, error) {
+	host := cfg.Host
+	if host == "" {
+		host = DefaultDockerHost
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid docker host %q: %w", host, err)
+	}
+
+	d := &dockerFile{container: cfg.Container, path: path.Clean(cfg.Path), mode: 0o644}
+	switch u.Scheme {
+	case "unix":
+		socket := u.Path
+		dialer := &net.Dialer{Timeout: 10 * time.Second}
+		d.http = &http.Client{Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, "unix", socket)
+			},
+		}}
+		d.base = "http://docker"
+	case "tcp", "http":
+		d.http = &http.Client{}
+		d.base = "http://" + u.Host
+	default:
+		return nil, fmt.Errorf("unsupported docker host %q: use unix:// or tcp://", host)
+	}
+	return d, nil
+}
+
+func (d *dockerFile) String() string {
+	return "container " + d.container + ":" + d.path
+}
+
+func (d *dockerFile) do(ctx context.Context, method, endpoint string, query url.Values, body io.Reader) (*http.Response, error) {
+	u := d.base + "/containers/" + url.PathEscape(d.container) + endpoint
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-tar")
+	}
+	resp, err := d.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("docker API: %w", err)
+	}
+	return resp, nil
+}
+
+// apiError turns a failed Docker API response into an error.
+func apiError(resp *http.Response) error {
+	var body struct {
+		Message string `json:"message"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if json.Unmarshal(data, &body) != nil || body.Message == "" {
+		body.Message = strings.TrimSpace(string(data))
+	}
+	return fmt.Errorf("docker API: %s (HTTP %d)", body.Message, resp.StatusCode)
+}
+
+func (d *dockerFile) inspect(ctx context.Context) error {
+	resp, err := d.do(ctx, http.MethodGet, "/json", nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("no such container %q", d.container)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return apiError(resp)
+	}
+	return nil
+}
+
+// maxSymlinks bounds how many symlinks read follows to reach the file.
+const maxSymlinks = 8
+
+func (d *dockerFile) read(ctx context.Context) ([]byte, error) {
+	p := d.path
+	for range maxSymlinks {
+		data, hdr, err := d.readArchive(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		switch hdr.Typeflag {
+		case tar.TypeReg:
+			d.resolved, d.mode = p, hdr.Mode
+			return data, nil
+		case tar.TypeSymlink:
+			target := hdr.Linkname
+			if !path.IsAbs(target) {
+				target = path.Join(path.Dir(p), target)
+			}
+			p = target
+		default:
+			return nil, fmt.Errorf("%s is not a regular file", p)
+		}
+	}
+	return nil, fmt.Errorf("too many symlinks resolving %s", d.path)
+}
+
+// readArchive fetches p from the container. The Docker API returns a tar
+// stream whose first entry is p itself.
+func (d *dockerFile) readArchive(ctx context.Context, p string) ([]byte, *tar.Header, error) {
+	resp, err := d.do(ctx, http.MethodGet, "/archive", url.Values{"path": {p}}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		if err := apiError(resp); strings.Contains(err.Error(), "No such container") {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("%s: %w", p, fs.ErrNotExist)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, apiError(resp)
+	}
+
+	tr := tar.NewReader(resp.Body)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading archive of %s: %w", p, err)
+	}
+	data, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading archive of %s: %w", p, err)
+	}
+	return data, hdr, nil
+}
+
+func (d *dockerFile) exists(ctx context.Context, p string) (bool, error) {
+	resp, err := d.do(ctx, http.MethodHead, "/archive", url.Values{"path": {p}}, nil)
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+	return false, fmt.Errorf("docker API: stat %s: HTTP %d", p, resp.StatusCode)
+}
+
+// write uploads the file as a tar archive. Missing parent directories are
+// included in the archive, and copyUIDGID makes everything owned by the
+// container's user rather than root.
+func (d *dockerFile) write(ctx context.Context, data []byte) error {
+	target := d.resolved
+	if target == "" {
+		target = d.path
+	}
+
+	// Find the deepest existing ancestor to extract into.
+	root := path.Dir(target)
+	var missing []string
+	for root != "/" {
+		ok, err := d.exists(ctx, root)
+		if err != nil {
+			return err
+		}
+		if ok {
+			break
+		}
+		missing = append([]string{root}, missing...)
+		root = path.Dir(root)
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	now := time.Now()
+	rel := func(p string) string { return strings.TrimPrefix(strings.TrimPrefix(p, root), "/") }
+	for _, dir := range missing {
+		if err := tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: rel(dir) + "/", Mode: 0o755, ModTime: now}); err != nil {
+			return err
+		}
+	}
+	hdr := &tar.Header{Typeflag: tar.TypeReg, Name: rel(target), Mode: d.mode, Size: int64(len(data)), ModTime: now}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	resp, err := d.do(ctx, http.MethodPut, "/archive", url.Values{"path": {root}, "copyUIDGID": {"1"}}, &buf)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("writing %s: %w", d, apiError(resp))
+	}
+	return nil
+}
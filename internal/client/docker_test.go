@@ -0,0 +1,181 @@
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDocker implements the container inspect and archive endpoints of the
+// Docker Engine API against an in-memory filesystem.
+type fakeDocker struct {
+	srv *httptest.Server
+
+	mu    sync.Mutex
+	files map[string][]byte
+	links map[string]string
+	dirs  map[string]bool
+}
+
+func newFakeDocker(t *testing.T) *fakeDocker {
+	t.Helper()
+	d := &fakeDocker{
+		files: map[string][]byte{},
+		links: map[string]string{},
+		dirs:  map[string]bool{"/": true, "/home": true, "/home/node": true},
+	}
+	d.srv = httptest.NewServer(http.HandlerFunc(d.serve))
+	t.Cleanup(d.srv.Close)
+	return d
+}
+
+func (d *fakeDocker) host() string {
+	return "tcp://" + strings.TrimPrefix(d.srv.URL, "http://")
+}
+
+func (d *fakeDocker) serve(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	rest, ok := strings.CutPrefix(r.URL.Path, "/containers/openclaw/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"message":"No such container: `+r.URL.Path+`"}`)
+		return
+	}
+	p := r.URL.Query().Get("path")
+
+	switch {
+	case rest == "json" && r.Method == http.MethodGet:
+		io.WriteString(w, `{"Id":"abc123","State":{"Running":true}}`)
+
+	case rest == "archive" && r.Method == http.MethodHead:
+		if !d.dirs[p] && d.files[p] == nil {
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+	case rest == "archive" && r.Method == http.MethodGet:
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		switch {
+		case d.links[p] != "":
+			tw.WriteHeader(&tar.Header{Typeflag: tar.TypeSymlink, Name: path.Base(p), Linkname: d.links[p]})
+		case d.files[p] != nil:
+			tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: path.Base(p), Mode: 0o600, Size: int64(len(d.files[p]))})
+			tw.Write(d.files[p])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"message":"Could not find the file `+p+` in container openclaw"}`)
+			return
+		}
+		tw.Close()
+		w.Write(buf.Bytes())
+
+	case rest == "archive" && r.Method == http.MethodPut:
+		if !d.dirs[p] || r.URL.Query().Get("copyUIDGID") != "1" {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"message":"bad extract request"}`)
+			return
+		}
+		tr := tar.NewReader(r.Body)
+		for {
+			hdr, err := tr.Next()
+			if err != nil {
+				break
+			}
+			name := path.Join(p, hdr.Name)
+			if hdr.Typeflag == tar.TypeDir {
+				d.dirs[name] = true
+				continue
+			}
+			if !d.dirs[path.Dir(name)] {
+				w.WriteHeader(http.StatusInternalServerError)
+				io.WriteString(w, `{"message":"parent of `+name+` missing"}`)
+				return
+			}
+			d.files[name], _ = io.ReadAll(tr)
+		}
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestDockerFileClient(t *testing.T) {
+	d := newFakeDocker(t)
+	ctx := context.Background()
+
+	c, err := NewDockerFileClient(ctx, DockerConfig{Host: d.host(), Container: "openclaw"})
+	if err != nil {
+		t.Fatalf("NewDockerFileClient: %v", err)
+	}
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig on missing file: %v", err)
+	}
+	if cfg.Raw != "{}" {
+		t.Errorf("expected empty config, got %q", cfg.Raw)
+	}
+
+	// ~/.openclaw does not exist yet, so the upload has to create it.
+	if err := PatchSection(ctx, c, "gateway", map[string]any{"port": 18789}, cfg.Hash); err != nil {
+		t.Fatalf("PatchSection: %v", err)
+	}
+	if !d.dirs["/home/node/.openclaw"] {
+		t.Error("expected /home/node/.openclaw to be created")
+	}
+
+	section, _, err := GetSection(ctx, c, "gateway")
+	if err != nil {
+		t.Fatalf("GetSection: %v", err)
+	}
+	if section["port"].(float64) != 18789 {
+		t.Errorf("expected port 18789, got %v", section["port"])
+	}
+}
+
+func TestDockerFileClient_Symlink(t *testing.T) {
+	d := newFakeDocker(t)
+	d.dirs["/home/node/.openclaw"] = true
+	d.dirs["/config"] = true
+	d.links[DefaultContainerConfigPath] = "../../../config/openclaw.json"
+	d.files["/config/openclaw.json"] = []byte(`{gateway: {port: 18789}}`)
+	ctx := context.Background()
+
+	c, err := NewDockerFileClient(ctx, DockerConfig{Host: d.host(), Container: "openclaw"})
+	if err != nil {
+		t.Fatalf("NewDockerFileClient: %v", err)
+	}
+	if err := PatchSection(ctx, c, "messages", map[string]any{"ackReaction": "eyes"}, ""); err != nil {
+		t.Fatalf("PatchSection: %v", err)
+	}
+	if got := string(d.files["/config/openclaw.json"]); !strings.Contains(got, "ackReaction") {
+		t.Errorf("symlink target was not updated: %s", got)
+	}
+	if d.files[DefaultContainerConfigPath] != nil {
+		t.Error("symlink was replaced by a regular file")
+	}
+}
+
+func TestDockerFileClient_Errors(t *testing.T) {
+	d := newFakeDocker(t)
+	ctx := context.Background()
+
+	if _, err := NewDockerFileClient(ctx, DockerConfig{Host: d.host(), Container: "missing"}); err == nil || !strings.Contains(err.Error(), `no such container "missing"`) {
+		t.Errorf("unknown container: got %v", err)
+	}
+	if _, err := NewDockerFileClient(ctx, DockerConfig{Host: d.host(), Container: "openclaw", Path: "openclaw.json"}); err == nil || !strings.Contains(err.Error(), "must be absolute") {
+		t.Errorf("relative path: got %v", err)
+	}
+	if _, err := NewDockerFileClient(ctx, DockerConfig{Host: "ssh://docker-host", Container: "openclaw"}); err == nil || !strings.Contains(err.Error(), "unsupported docker host") {
+		t.Errorf("ssh host: got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventConfigChanged is the event the gateway sends whenever its config
+// changes, whoever changed it.
+const EventConfigChanged = "config.changed"
+
+// eventBuffer is how many undelivered events a subscription holds before
+// further events are dropped.
+const eventBuffer = 16
+
+// Event is a message the gateway sends without being asked.
+type Event struct {
+	Name    string
+	Payload json.RawMessage
+}
+
+type subscription struct {
+	name string
+	ch   chan Event
+}
+
+// Subscribe returns a channel that receives the gateway events called name,
+// across reconnects, until cancel is called or the client is closed, which
+// closes the channel. Events are dropped while the channel is full, so a
+// slow subscriber cannot stall the connection.
+func (c *WSClient) Subscribe(name string) (events <-chan Event, cancel func()) {
+	sub := &subscription{name: name, ch: make(chan Event, eventBuffer)}
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	if c.subs == nil {
+		c.subs = make(map[*subscription]bool)
+	}
+	c.subs[sub] = true
+	return sub.ch, func() {
+		c.subMu.Lock()
+		defer c.subMu.Unlock()
+		if c.subs[sub] {
+			delete(c.subs, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+// dispatchEvent delivers an event frame from the read pump.
+func (c *WSClient) dispatchEvent(frame wsFrame) {
+	payload, err := json.Marshal(frame.Payload)
+	if err != nil {
+		return
+	}
+	ev := Event{Name: frame.Event, Payload: payload}
+
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	if ev.Name == EventConfigChanged {
+		c.recordChange(ev)
+	}
+	for sub := range c.subs {
+		if sub.name != ev.Name {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+		}
+	}
+}
+
+func (c *WSClient) closeSubscriptions() {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	for sub := range c.subs {
+		close(sub.ch)
+	}
+	c.subs = nil
+}
+
+// ConfigChange is a config change the gateway reported.
+type ConfigChange struct {
+	// Hash is the config hash after the change, if the gateway sent one.
+	Hash string
+	// At is when the event arrived.
+	At time.Time
+	// OutOfBand is true when the change was not made by this client: its
+	// hash is not one this client's writes produced. When the gateway does
+	// not return the new hash from writes, or the change came without a
+	// hash, it is only attributed to this client if it arrived while one of
+	// its writes was in flight.
+	OutOfBand bool
+
+	duringWrite bool
+}
+
+// recordChange notes a config.changed event. Caller must hold c.subMu.
+func (c *WSClient) recordChange(ev Event) {
+	var p struct {
+		Hash string `json:"hash"`
+	}
+	_ = json.Unmarshal(ev.Payload, &p)
+	c.changes = append(c.changes, ConfigChange{
+		Hash:        p.Hash,
+		At:          time.Now(),
+		duringWrite: c.writing.Load() > 0,
+	})
+}
+
+// startWrite marks a write as in flight until the returned func is called.
+func (c *WSClient) startWrite() (done func()) {
+	c.writing.Add(1)
+	return func() { c.writing.Add(-1) }
+}
+
+// recordHash notes the config hash a successful write produced, if its
+// response included one. The change event may arrive before or after the
+// response, so changes are only classified when they are read.
+func (c *WSClient) recordHash(hash string) {
+	if hash == "" {
+		return
+	}
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	if c.produced == nil {
+		c.produced = make(map[string]bool)
+	}
+	c.produced[hash] = true
+}
+
+// responseHash returns the config hash in a write response payload, or "".
+func responseHash(payload any) string {
+	p, _ := payload.(map[string]any)
+	hash, _ := p["hash"].(string)
+	return hash
+}
+
+// ConfigChanges returns the config changes the gateway has reported since
+// the client connected, oldest first.
+func (c *WSClient) ConfigChanges() []ConfigChange {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	out := make([]ConfigChange, len(c.changes))
+	for i, ch := range c.changes {
+		if ch.Hash != "" && len(c.produced) > 0 {
+			ch.OutOfBand = !c.produced[ch.Hash]
+		} else {
+			ch.OutOfBand = !ch.duringWrite
+		}
+		out[i] = ch
+	}
+	return out
+}
+
+// ConfigChanges returns the changes reported to c, looking through wrapping
+// clients. ok is false when c does not receive gateway events, as in file
+// mode.
+func ConfigChanges(c Client) (changes []ConfigChange, ok bool) {
+	for {
+		switch v := c.(type) {
+		case interface{ ConfigChanges() []ConfigChange }:
+			return v.ConfigChanges(), true
+		case interface{ Unwrap() Client }:
+			c = v.Unwrap()
+		default:
+			return nil, false
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWSClient_SubscribeReceivesEvents(t *testing.T) {
+	g := newFakeGateway(t)
+	c := newReconnectClient(t, g)
+
+	events, cancel := c.Subscribe("agent.status")
+	defer cancel()
+
+	g.emit("presence", map[string]any{"online": true})
+	g.emit("agent.status", map[string]any{"agentId": "main"})
+
+	select {
+	case ev := <-events:
+		if ev.Name != "agent.status" || string(ev.Payload) != `{"agentId":"main"}` {
+			t.Errorf("event = %s %s", ev.Name, ev.Payload)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+
+	cancel()
+	if _, open := <-events; open {
+		t.Error("channel still open after cancel")
+	}
+}
+
+func TestWSClient_RecordsConfigChanges(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("config.patch", func(map[string]any) (any, any) {
+		return map[string]any{"ok": true, "hash": "h2"}, nil
+	})
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	events, cancel := c.Subscribe(EventConfigChanged)
+	defer cancel()
+	waitEvent := func() {
+		t.Helper()
+		select {
+		case <-events:
+		case <-time.After(5 * time.Second):
+			t.Fatal("no config.changed event received")
+		}
+	}
+
+	// Someone else edits the config before this client writes.
+	g.emit(EventConfigChanged, map[string]any{"hash": "h1"})
+	waitEvent()
+
+	if err := c.PatchConfig(ctx, map[string]any{"a": 1}, "h1"); err != nil {
+		t.Fatalf("PatchConfig: %v", err)
+	}
+	g.emit(EventConfigChanged, map[string]any{"hash": "h2"})
+	waitEvent()
+
+	// Someone else edits the config after this client has written.
+	g.emit(EventConfigChanged, map[string]any{"hash": "h3"})
+	waitEvent()
+
+	changes, ok := ConfigChanges(NewCachedClient(NewSerialClient(c), DefaultConfigCacheTTL))
+	if !ok {
+		t.Fatal("ConfigChanges did not find the WebSocket client")
+	}
+	if len(changes) != 3 {
+		t.Fatalf("changes = %+v, want 3", changes)
+	}
+	if changes[0].Hash != "h1" || !changes[0].OutOfBand {
+		t.Errorf("first change = %+v, want out of band h1", changes[0])
+	}
+	if changes[1].Hash != "h2" || changes[1].OutOfBand {
+		t.Errorf("second change = %+v, want this client's write h2", changes[1])
+	}
+	if changes[2].Hash != "h3" || !changes[2].OutOfBand {
+		t.Errorf("third change = %+v, want out of band h3", changes[2])
+	}
+	if n := g.callCount("config.get"); n != 0 {
+		t.Errorf("config.get calls = %d, want 0", n)
+	}
+
+	if _, ok := ConfigChanges(&memClient{}); ok {
+		t.Error("ConfigChanges reported events for a client without them")
+	}
+}
+
+func TestWSClient_ConfigChangesWithoutWriteHashes(t *testing.T) {
+	g := newFakeGateway(t)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	events, cancel := c.Subscribe(EventConfigChanged)
+	defer cancel()
+
+	// The gateway announces the change before answering the write, and the
+	// answer carries no hash.
+	g.handle("config.patch", func(map[string]any) (any, any) {
+		g.emit(EventConfigChanged, map[string]any{"hash": "h2"})
+		return map[string]any{"ok": true}, nil
+	})
+	if err := c.PatchConfig(ctx, map[string]any{"a": 1}, "h1"); err != nil {
+		t.Fatalf("PatchConfig: %v", err)
+	}
+	<-events
+	g.emit(EventConfigChanged, map[string]any{"hash": "h3"})
+	select {
+	case <-events:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no config.changed event received")
+	}
+
+	changes := c.ConfigChanges()
+	if len(changes) != 2 || changes[0].OutOfBand || !changes[1].OutOfBand {
+		t.Errorf("changes = %+v, want only h3 out of band", changes)
+	}
+	if n := g.callCount("config.get"); n != 0 {
+		t.Errorf("config.get calls = %d, want 0", n)
+	}
+}
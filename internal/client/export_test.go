@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWSClient_ExportImportUseBackupRPCs(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"channels":{"telegram":{"botToken":"__OPENCLAW_REDACTED__"}}}`)
+	full := `{"channels":{"telegram":{"botToken":"123:abc"}}}`
+	g.handle("config.export", func(map[string]any) (any, any) {
+		return map[string]any{"raw": full, "hash": "h1"}, nil
+	})
+	var imported string
+	g.handle("config.import", func(params map[string]any) (any, any) {
+		imported, _ = params["raw"].(string)
+		return map[string]any{"ok": true}, nil
+	})
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	cfg, err := c.ExportConfig(ctx)
+	if err != nil {
+		t.Fatalf("ExportConfig: %v", err)
+	}
+	if cfg.Raw != full || cfg.Hash != "h1" {
+		t.Errorf("export = %+v", cfg)
+	}
+	if err := c.ImportConfig(ctx, full, cfg.Hash); err != nil {
+		t.Fatalf("ImportConfig: %v", err)
+	}
+	if imported != full {
+		t.Errorf("imported = %s", imported)
+	}
+	if n := g.callCount("config.apply"); n != 0 {
+		t.Errorf("config.apply calls = %d, want 0", n)
+	}
+}
+
+func TestWSClient_ExportImportFallBack(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"a":1}`)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		cfg, err := c.ExportConfig(ctx)
+		if err != nil {
+			t.Fatalf("ExportConfig: %v", err)
+		}
+		if cfg.Raw != `{"a":1}` {
+			t.Errorf("raw = %s", cfg.Raw)
+		}
+	}
+	if err := c.ImportConfig(ctx, `{"b":2}`, ""); err != nil {
+		t.Fatalf("ImportConfig: %v", err)
+	}
+	if err := c.ImportConfig(ctx, `{"c":3}`, ""); err != nil {
+		t.Fatalf("ImportConfig: %v", err)
+	}
+	if got := g.getRaw(); got != `{"c":3}` {
+		t.Errorf("raw = %s", got)
+	}
+	// Missing methods are only tried once.
+	if n := g.callCount("config.export"); n != 1 {
+		t.Errorf("config.export calls = %d, want 1", n)
+	}
+	if n := g.callCount("config.import"); n != 1 {
+		t.Errorf("config.import calls = %d, want 1", n)
+	}
+}
@@ -0,0 +1,225 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// fakeGateway is a minimal in-process OpenClaw gateway used to exercise the
+// WebSocket client without a real gateway. It speaks just enough of the
+// protocol: the connect.challenge event, the connect handshake, config.get,
+// config.patch (with baseHash checking), config.apply and health.
+type fakeGateway struct {
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	raw      string
+	connects []map[string]any
+	calls    []string
+	// handlers override the built-in behaviour for a method. Returning a
+	// non-nil errPayload produces an ok=false response.
+	handlers map[string]func(params map[string]any) (payload any, errPayload any)
+	// restarts counts, per method, how many upcoming calls drop the
+	// connection after being handled instead of replying, as the gateway
+	// does when a config change makes it restart.
+	restarts map[string]int
+	// senders write to each connection made so far.
+	senders []func(v any)
+	// compress accepts permessage-deflate when the client offers it.
+	compress bool
+}
+
+func newFakeGateway(t *testing.T) *fakeGateway {
+	t.Helper()
+	g := &fakeGateway{raw: "{}", handlers: map[string]func(map[string]any) (any, any){}, restarts: map[string]int{}}
+	g.srv = httptest.NewServer(http.HandlerFunc(g.serveWS))
+	t.Cleanup(g.srv.Close)
+	return g
+}
+
+func newFakeTLSGateway(t *testing.T) *fakeGateway {
+	t.Helper()
+	g := &fakeGateway{raw: "{}", handlers: map[string]func(map[string]any) (any, any){}, restarts: map[string]int{}}
+	g.srv = httptest.NewTLSServer(http.HandlerFunc(g.serveWS))
+	t.Cleanup(g.srv.Close)
+	return g
+}
+
+// URL returns the ws:// (or wss://) URL of the fake gateway.
+func (g *fakeGateway) URL() string {
+	return "ws" + strings.TrimPrefix(g.srv.URL, "http")
+}
+
+func (g *fakeGateway) handle(method string, fn func(params map[string]any) (any, any)) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.handlers[method] = fn
+}
+
+// restartOn makes the next n calls to method close the connection instead
+// of replying.
+func (g *fakeGateway) restartOn(method string, n int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.restarts[method] = n
+}
+
+func (g *fakeGateway) takeRestart(method string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.restarts[method] == 0 {
+		return false
+	}
+	g.restarts[method]--
+	return true
+}
+
+// emit sends an event to every connected client.
+func (g *fakeGateway) emit(event string, payload any) {
+	g.mu.Lock()
+	senders := append([]func(any){}, g.senders...)
+	g.mu.Unlock()
+	for _, send := range senders {
+		send(map[string]any{"type": "event", "event": event, "payload": payload})
+	}
+}
+
+func (g *fakeGateway) setRaw(raw string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.raw = raw
+}
+
+func (g *fakeGateway) getRaw() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.raw
+}
+
+func (g *fakeGateway) callCount(method string) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	n := 0
+	for _, m := range g.calls {
+		if m == method {
+			n++
+		}
+	}
+	return n
+}
+
+func (g *fakeGateway) lastConnect() map[string]any {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.connects) == 0 {
+		return nil
+	}
+	return g.connects[len(g.connects)-1]
+}
+
+func (g *fakeGateway) serveWS(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	upgrader := websocket.Upgrader{
+		CheckOrigin:       func(*http.Request) bool { return true },
+		EnableCompression: g.compress,
+	}
+	g.mu.Unlock()
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var writeMu sync.Mutex
+	send := func(v any) {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		_ = conn.WriteJSON(v)
+	}
+	g.mu.Lock()
+	g.senders = append(g.senders, send)
+	g.mu.Unlock()
+
+	send(map[string]any{
+		"type":    "event",
+		"event":   "connect.challenge",
+		"payload": map[string]any{"nonce": "test-nonce"},
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req struct {
+			Type   string         `json:"type"`
+			ID     string         `json:"id"`
+			Method string         `json:"method"`
+			Params map[string]any `json:"params"`
+		}
+		if err := json.Unmarshal(data, &req); err != nil || req.Type != "req" {
+			continue
+		}
+
+		payload, errPayload := g.dispatch(req.Method, req.Params)
+		if g.takeRestart(req.Method) {
+			return
+		}
+		resp := map[string]any{"type": "res", "id": req.ID, "ok": errPayload == nil}
+		if errPayload != nil {
+			resp["error"] = errPayload
+		} else {
+			resp["payload"] = payload
+		}
+		send(resp)
+	}
+}
+
+func (g *fakeGateway) dispatch(method string, params map[string]any) (any, any) {
+	g.mu.Lock()
+	g.calls = append(g.calls, method)
+	if method == "connect" {
+		g.connects = append(g.connects, params)
+	}
+	fn := g.handlers[method]
+	g.mu.Unlock()
+
+	if fn != nil {
+		return fn(params)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	switch method {
+	case "connect":
+		return map[string]any{"protocol": 3}, nil
+	case "config.get":
+		return map[string]any{"raw": g.raw, "hash": hashBytes([]byte(g.raw))}, nil
+	case "config.patch":
+		if base, _ := params["baseHash"].(string); base != hashBytes([]byte(g.raw)) {
+			return nil, map[string]any{"code": "CONFLICT", "message": "config changed since last load; baseHash mismatch"}
+		}
+		var patch, current map[string]any
+		rawPatch, _ := params["raw"].(string)
+		if err := json.Unmarshal([]byte(rawPatch), &patch); err != nil {
+			return nil, map[string]any{"code": "INVALID", "message": err.Error()}
+		}
+		_ = json.Unmarshal([]byte(g.raw), &current)
+		out, _ := json.Marshal(mergePatch(current, patch))
+		g.raw = string(out)
+		return map[string]any{"ok": true}, nil
+	case "config.apply":
+		g.raw, _ = params["raw"].(string)
+		return map[string]any{"ok": true}, nil
+	case "health":
+		return map[string]any{"ok": true, "ts": 1700000000000, "defaultAgentId": "main"}, nil
+	}
+	return nil, map[string]any{"code": "METHOD_NOT_FOUND", "message": "unknown method " + method}
+}
@@ -1,11 +1,14 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,8 +19,52 @@ import (
 // This is the fallback for when no running Gateway is available
 // (e.g. pre-provisioning a config before first boot).
 type FileClient struct {
-	path string
-	mu   sync.Mutex
+	// Strict makes writes fail with a *ConfigChangedError when the file was
+	// changed by someone else since the caller read it, instead of merging
+	// over the change.
+	Strict bool
+
+	store   configStore
+	mu      sync.Mutex
+	written string // hash of the last contents this client wrote
+}
+
+// ConfigChangedError reports a strict write rejected because the config file
+// changed outside this client after the caller read it.
+type ConfigChangedError struct {
+	Location string
+}
+
+func (e *ConfigChangedError) Error() string {
+	return fmt.Sprintf("%s was changed outside Terraform since it was read", e.Location)
+}
+
+// checkBaseHash enforces Strict for a write based on baseHash. Hashes made
+// stale only by this client's own writes are accepted: Terraform applies
+// resources in parallel, and each patches only its own section. Caller must
+// hold f.mu.
+func (f *FileClient) checkBaseHash(cur *ConfigPayload, baseHash string, replace bool) error {
+	if !f.Strict || baseHash == "" || baseHash == cur.Hash {
+		return nil
+	}
+	// A replacement would discard whatever changed since the read, even
+	// if this client made the change.
+	if !replace && cur.Hash == f.written {
+		return nil
+	}
+	return &ConfigChangedError{Location: f.store.String()}
+}
+
+// configStore is where a FileClient keeps the config file: the local
+// filesystem, or a container reached through the Docker API.
+type configStore interface {
+	// read returns the file contents, or an error wrapping fs.ErrNotExist
+	// if there is no config file yet.
+	read(ctx context.Context) ([]byte, error)
+	// write replaces the file, creating missing parent directories.
+	write(ctx context.Context, data []byte) error
+	// String describes the location for error messages.
+	String() string
 }
 
 // NewFileClient creates a client that operates on the given config file path.
@@ -27,84 +74,186 @@ func NewFileClient(path string) (*FileClient, error) {
 	if err != nil {
 		return nil, fmt.Errorf("expanding config path: %w", err)
 	}
-	return &FileClient{path: expanded}, nil
+	return &FileClient{store: localFile(expanded)}, nil
+}
+
+// localFile is a config file on the local filesystem.
+type localFile string
+
+func (l localFile) read(context.Context) ([]byte, error) {
+	return os.ReadFile(string(l))
+}
+
+func (l localFile) write(_ context.Context, data []byte) error {
+	if err := ensureDir(string(l)); err != nil {
+		return err
+	}
+	return writeFileAtomic(string(l), data, 0o644)
+}
+
+// writeFileAtomic replaces the file at name with data so that a crash leaves
+// either the old or the new contents, never a truncated file the gateway
+// cannot boot from. The data is written to a temporary file in the same
+// directory, synced, and renamed over the original. An existing file keeps
+// its permission bits; a new one gets perm. A symlinked config is replaced
+// at its target, leaving the link in place.
+func writeFileAtomic(name string, data []byte, perm fs.FileMode) error {
+	if target, err := filepath.EvalSymlinks(name); err == nil {
+		name = target
+	}
+	if info, err := os.Stat(name); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	dir := filepath.Dir(name)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(name)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return err
+	}
+
+	// Sync the directory so the rename itself survives a crash. Not every
+	// platform supports this, so failures are ignored.
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+	return nil
 }
 
+func (l localFile) String() string { return string(l) }
+
 // GetConfig implements Client.
-func (f *FileClient) GetConfig(_ context.Context) (*ConfigPayload, error) {
+func (f *FileClient) GetConfig(ctx context.Context) (*ConfigPayload, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	return f.getConfigLocked()
+	return f.getConfigLocked(ctx)
 }
 
 // getConfigLocked reads the config file. Caller must hold f.mu.
-func (f *FileClient) getConfigLocked() (*ConfigPayload, error) {
-	data, err := os.ReadFile(f.path)
-	if os.IsNotExist(err) {
+func (f *FileClient) getConfigLocked(ctx context.Context) (*ConfigPayload, error) {
+	data, err := f.store.read(ctx)
+	if errors.Is(err, fs.ErrNotExist) {
 		// No config file yet -- return empty config.
 		return &ConfigPayload{
 			Raw:  "{}",
-			Hash: hashBytes([]byte("{}")),
+			Hash: hashConfig([]byte("{}")),
 		}, nil
 	}
 	if err != nil {
-		return nil, fmt.Errorf("reading %s: %w", f.path, err)
+		return nil, fmt.Errorf("reading %s: %w", f.store, err)
 	}
 
 	raw := string(data)
 
 	return &ConfigPayload{
 		Raw:  raw,
-		Hash: hashBytes(data),
+		Hash: hashConfig(data),
 	}, nil
 }
 
+// GetConfigSection implements Client. The whole file is read regardless.
+func (f *FileClient) GetConfigSection(ctx context.Context, keys ...string) (*SectionPayload, error) {
+	cfg, err := f.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sectionOf(cfg, keys...)
+}
+
 // PatchConfig implements Client.
-// In file mode, concurrent access is serialized by the mutex, so the caller-
-// provided baseHash is intentionally ignored. The mutex guarantees that no
-// other goroutine can modify the file between our read and write, making
-// optimistic-concurrency checks unnecessary (and counterproductive when
-// Terraform applies multiple resources in parallel).
-func (f *FileClient) PatchConfig(_ context.Context, patch map[string]any, _ string) error {
+// In file mode, concurrent access is serialized by the mutex, so unless
+// Strict is set the caller-provided baseHash is intentionally ignored. The
+// mutex guarantees that no other goroutine can modify the file between our
+// read and write, making optimistic-concurrency checks unnecessary (and
+// counterproductive when Terraform applies multiple resources in parallel).
+func (f *FileClient) PatchConfig(ctx context.Context, patch map[string]any, baseHash string) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	cfg, err := f.getConfigLocked()
+	cfg, err := f.getConfigLocked(ctx)
 	if err != nil {
 		return err
 	}
+	if err := f.checkBaseHash(cfg, baseHash, false); err != nil {
+		return err
+	}
 
 	existing, err := parseRawJSON(cfg.Raw)
 	if err != nil {
 		return fmt.Errorf("parsing existing config: %w", err)
 	}
 
-	merged := mergePatch(existing, patch)
-
-	out, err := json.MarshalIndent(merged, "", "  ")
+	// Edit the file in place so comments and formatting survive. If that is
+	// not possible (e.g. duplicate keys), rewrite it from the merged config.
+	out, err := patchPreserving([]byte(cfg.Raw), patch)
 	if err != nil {
-		return fmt.Errorf("marshaling config: %w", err)
+		out, err = json.MarshalIndent(mergePatch(existing, patch), "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshaling config: %w", err)
+		}
 	}
 
-	if err := ensureDir(f.path); err != nil {
+	return f.writeLocked(ctx, out)
+}
+
+// writeLocked replaces the file and records what was written. Caller must
+// hold f.mu.
+func (f *FileClient) writeLocked(ctx context.Context, data []byte) error {
+	if err := f.store.write(ctx, data); err != nil {
 		return err
 	}
-
-	return os.WriteFile(f.path, out, 0o644)
+	f.written = hashConfig(data)
+	return nil
 }
 
 // ApplyConfig implements Client.
-// Like PatchConfig, the baseHash is ignored in file mode because the mutex
-// serializes all access.
-func (f *FileClient) ApplyConfig(_ context.Context, raw string, _ string) error {
+// Like PatchConfig, the baseHash is ignored in file mode unless Strict is set,
+// because the mutex serializes all access.
+func (f *FileClient) ApplyConfig(ctx context.Context, raw string, baseHash string) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	if err := ensureDir(f.path); err != nil {
-		return err
+	if f.Strict && baseHash != "" {
+		cfg, err := f.getConfigLocked(ctx)
+		if err != nil {
+			return err
+		}
+		if err := f.checkBaseHash(cfg, baseHash, true); err != nil {
+			return err
+		}
 	}
+	return f.writeLocked(ctx, []byte(raw))
+}
 
-	return os.WriteFile(f.path, []byte(raw), 0o644)
+// ExportConfig implements Client. The file holds the whole config, secrets
+// included, so this is GetConfig.
+func (f *FileClient) ExportConfig(ctx context.Context) (*ConfigPayload, error) {
+	return f.GetConfig(ctx)
+}
+
+// ImportConfig implements Client by writing raw as the new file.
+func (f *FileClient) ImportConfig(ctx context.Context, raw string, baseHash string) error {
+	return f.ApplyConfig(ctx, raw, baseHash)
 }
 
 // Health implements Client. Not supported in file mode.
@@ -112,11 +261,37 @@ func (f *FileClient) Health(_ context.Context) (*HealthPayload, error) {
 	return nil, fmt.Errorf("health check not available in file mode (no running gateway)")
 }
 
+// Call implements Client. Only the agents.files RPCs are supported in file
+// mode, served from the agent workspace on disk.
+func (f *FileClient) Call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	switch method {
+	case "agents.files.get", "agents.files.set", "agents.files.delete":
+		return f.callWorkspace(ctx, method, params)
+	}
+	return nil, fmt.Errorf("%s not available in file mode (no running gateway)", method)
+}
+
 // Close implements Client.
 func (f *FileClient) Close() error {
 	return nil
 }
 
+// IsFileMode reports whether c edits the config file directly, looking
+// through wrapping clients. RPCs other than the agents.files ones fail in
+// file mode.
+func IsFileMode(c Client) bool {
+	for {
+		switch v := c.(type) {
+		case *FileClient:
+			return true
+		case interface{ Unwrap() Client }:
+			c = v.Unwrap()
+		default:
+			return false
+		}
+	}
+}
+
 // mergePatch applies RFC 7396 JSON Merge Patch semantics.
 func mergePatch(target, patch map[string]any) map[string]any {
 	if target == nil {
@@ -131,17 +306,10 @@ func mergePatch(target, patch map[string]any) map[string]any {
 
 		patchMap, patchIsMap := patchVal.(map[string]any)
 		if patchIsMap {
-			targetVal, ok := target[key]
-			if !ok {
-				target[key] = patchMap
-				continue
-			}
-			targetMap, targetIsMap := targetVal.(map[string]any)
-			if targetIsMap {
-				target[key] = mergePatch(targetMap, patchMap)
-			} else {
-				target[key] = patchMap
-			}
+			// A missing or non-object target is patched as an empty object,
+			// so nulls in the patch are dropped rather than written.
+			targetMap, _ := target[key].(map[string]any)
+			target[key] = mergePatch(targetMap, patchMap)
 		} else {
 			target[key] = patchVal
 		}
@@ -155,6 +323,34 @@ func hashBytes(data []byte) string {
 	return hex.EncodeToString(h[:])
 }
 
+// hashConfig hashes a config file by content rather than bytes. The config
+// is re-encoded with sorted keys and no whitespace first, so a file the
+// gateway rewrote with different indentation, key order or comments keeps
+// its hash and is not mistaken for a change. Files that do not parse are
+// hashed as they are.
+func hashConfig(data []byte) string {
+	src := data
+	if !json.Valid(src) {
+		converted, err := json5ToJSON(src)
+		if err != nil {
+			return hashBytes(data)
+		}
+		src = converted
+	}
+	// Numbers are kept as written; as float64 they could round together.
+	dec := json.NewDecoder(bytes.NewReader(src))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return hashBytes(data)
+	}
+	canonical, err := json.Marshal(v)
+	if err != nil {
+		return hashBytes(data)
+	}
+	return hashBytes(canonical)
+}
+
 func expandPath(path string) (string, error) {
 	if strings.HasPrefix(path, "~/") {
 		home, err := os.UserHomeDir()
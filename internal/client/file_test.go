@@ -3,8 +3,10 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -159,6 +161,109 @@ func TestFileClient_PatchConfig_IgnoresStaleHash(t *testing.T) {
 	}
 }
 
+func TestFileClient_PatchConfig_StrictRejectsExternalChange(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "openclaw.json")
+	os.WriteFile(path, []byte(`{"gateway":{"port":18789}}`), 0o644)
+
+	c, err := NewFileClient(path)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	c.Strict = true
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	os.WriteFile(path, []byte(`{"gateway":{"port":9999}}`), 0o644)
+
+	err = c.PatchConfig(ctx, map[string]any{"test": true}, cfg.Hash)
+	var changed *ConfigChangedError
+	if !errors.As(err, &changed) {
+		t.Fatalf("err = %v, want *ConfigChangedError", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != `{"gateway":{"port":9999}}` {
+		t.Errorf("file = %s, want the external change untouched", data)
+	}
+}
+
+func TestFileClient_PatchConfig_StrictAllowsOwnWrites(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "openclaw.json")
+	os.WriteFile(path, []byte(`{}`), 0o644)
+
+	c, err := NewFileClient(path)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	c.Strict = true
+	ctx := context.Background()
+
+	// Two resources read the same config, as in a parallel apply.
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if err := c.PatchConfig(ctx, map[string]any{"a": 1}, cfg.Hash); err != nil {
+		t.Fatalf("first PatchConfig: %v", err)
+	}
+	if err := c.PatchConfig(ctx, map[string]any{"b": 2}, cfg.Hash); err != nil {
+		t.Fatalf("second PatchConfig: %v", err)
+	}
+
+	// Replacing the config from the stale read would drop the patches.
+	if err := c.ApplyConfig(ctx, `{}`, cfg.Hash); err == nil {
+		t.Error("ApplyConfig with a stale hash succeeded")
+	}
+}
+
+func TestFileClient_HashIgnoresFormatting(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "openclaw.json")
+	os.WriteFile(path, []byte(`{"gateway":{"port":18789,"bind":"loopback"},"agents":{}}`), 0o644)
+
+	c, err := NewFileClient(path)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	c.Strict = true
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+
+	// The gateway rewrites the file with its own formatting.
+	os.WriteFile(path, []byte(`{
+  // written by the gateway
+  agents: {},
+  gateway: {bind: "loopback", port: 18789},
+}
+`), 0o644)
+	reformatted, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if reformatted.Hash != cfg.Hash {
+		t.Errorf("hash changed after reformatting: %s, want %s", reformatted.Hash, cfg.Hash)
+	}
+	if err := c.PatchConfig(ctx, map[string]any{"test": true}, cfg.Hash); err != nil {
+		t.Fatalf("PatchConfig after reformatting: %v", err)
+	}
+
+	os.WriteFile(path, []byte(`{"gateway":{"port":18790,"bind":"loopback"},"agents":{}}`), 0o644)
+	changed, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if changed.Hash == cfg.Hash {
+		t.Error("hash unchanged after a value changed")
+	}
+}
+
 func TestFileClient_PatchConfig_DeleteSection(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "openclaw.json")
@@ -197,6 +302,69 @@ func TestFileClient_PatchConfig_DeleteSection(t *testing.T) {
 	}
 }
 
+func TestFileClient_JSON5(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "openclaw.json5")
+
+	os.WriteFile(path, []byte(`// Managed partly by hand.
+{
+  gateway: {
+    port: 18789, // default
+    bind: 'loopback',
+  },
+  /* channels are added by Terraform */
+}
+`), 0o644)
+
+	c, err := NewFileClient(path)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	ctx := context.Background()
+
+	section, _, err := GetSection(ctx, c, "gateway")
+	if err != nil {
+		t.Fatalf("GetSection: %v", err)
+	}
+	if section["bind"] != "loopback" || section["port"].(float64) != 18789 {
+		t.Errorf("unexpected gateway section %v", section)
+	}
+
+	if err := PatchSection(ctx, c, "messages", map[string]any{"ackReaction": "eyes"}, ""); err != nil {
+		t.Fatalf("PatchSection: %v", err)
+	}
+	section, _, err = GetSection(ctx, c, "messages")
+	if err != nil {
+		t.Fatalf("GetSection after patch: %v", err)
+	}
+	if section["ackReaction"] != "eyes" {
+		t.Errorf("expected ackReaction=eyes, got %v", section["ackReaction"])
+	}
+
+	data, _ := os.ReadFile(path)
+	for _, want := range []string{"// Managed partly by hand.", "port: 18789, // default", "bind: 'loopback',"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("patched file lost %q:\n%s", want, data)
+		}
+	}
+}
+
+func TestFileClient_InvalidJSON5(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "openclaw.json")
+
+	os.WriteFile(path, []byte("{\n  gateway: { port: 18789 \n}"), 0o644)
+
+	c, err := NewFileClient(path)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	_, _, err = GetSection(context.Background(), c, "gateway")
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Fatalf("expected a positioned parse error, got %v", err)
+	}
+}
+
 func TestFileClient_ApplyConfig(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "openclaw.json")
@@ -221,6 +389,93 @@ func TestFileClient_ApplyConfig(t *testing.T) {
 	}
 }
 
+func TestFileClient_ExportImportRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	src, err := NewFileClient(filepath.Join(dir, "a.json"))
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	dst, err := NewFileClient(filepath.Join(dir, "b.json"))
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	ctx := context.Background()
+
+	raw := `{channels: {telegram: {botToken: "123:abc"}}} // json5`
+	if err := src.ApplyConfig(ctx, raw, ""); err != nil {
+		t.Fatalf("ApplyConfig: %v", err)
+	}
+	exported, err := src.ExportConfig(ctx)
+	if err != nil {
+		t.Fatalf("ExportConfig: %v", err)
+	}
+	if exported.Raw != raw {
+		t.Errorf("exported %q, want %q", exported.Raw, raw)
+	}
+	if err := dst.ImportConfig(ctx, exported.Raw, ""); err != nil {
+		t.Fatalf("ImportConfig: %v", err)
+	}
+	imported, err := dst.ExportConfig(ctx)
+	if err != nil {
+		t.Fatalf("ExportConfig: %v", err)
+	}
+	if imported.Raw != raw || imported.Hash != exported.Hash {
+		t.Errorf("imported %+v, want %+v", imported, exported)
+	}
+}
+
+func TestFileClient_WritePreservesMode(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "openclaw.json")
+	os.WriteFile(path, []byte(`{"gateway":{"port":18789}}`), 0o600)
+
+	c, err := NewFileClient(path)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	ctx := context.Background()
+	if err := c.PatchConfig(ctx, map[string]any{"gateway": map[string]any{"port": 9999}}, ""); err != nil {
+		t.Fatalf("PatchConfig: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("perm = %o, want 600", perm)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("directory has %d entries, want only the config (temp file left behind?)", len(entries))
+	}
+}
+
+func TestFileClient_WriteFollowsSymlink(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "real.json")
+	link := filepath.Join(dir, "openclaw.json")
+	os.WriteFile(target, []byte(`{}`), 0o644)
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlinks unsupported: %v", err)
+	}
+
+	c, err := NewFileClient(link)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	if err := c.ApplyConfig(context.Background(), `{"a":1}`, ""); err != nil {
+		t.Fatalf("ApplyConfig: %v", err)
+	}
+
+	if info, err := os.Lstat(link); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("config path is no longer a symlink")
+	}
+	if data, _ := os.ReadFile(target); string(data) != `{"a":1}` {
+		t.Errorf("target = %s", data)
+	}
+}
+
 func TestFileClient_Health_Unsupported(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "openclaw.json")
@@ -236,6 +491,17 @@ func TestFileClient_Health_Unsupported(t *testing.T) {
 	}
 }
 
+func TestFileClient_Call_Unsupported(t *testing.T) {
+	c, err := NewFileClient(filepath.Join(t.TempDir(), "openclaw.json"))
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+
+	if _, err := c.Call(context.Background(), "sessions.list", nil); err == nil {
+		t.Fatal("expected error for Call in file mode")
+	}
+}
+
 func TestMergePatch(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -279,6 +545,12 @@ func TestMergePatch(t *testing.T) {
 			patch:  map[string]any{"a": map[string]any{"nested": true}},
 			want:   map[string]any{"a": map[string]any{"nested": true}},
 		},
+		{
+			name:   "nulls in new object",
+			target: map[string]any{},
+			patch:  map[string]any{"a": map[string]any{"b": nil, "c": map[string]any{"d": nil}, "e": 1}},
+			want:   map[string]any{"a": map[string]any{"c": map[string]any{}, "e": 1}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -386,3 +658,19 @@ func TestPatchNestedSection(t *testing.T) {
 		t.Errorf("expected open, got %v", section["dmPolicy"])
 	}
 }
+
+func TestIsFileMode(t *testing.T) {
+	fc, err := NewFileClient(filepath.Join(t.TempDir(), "openclaw.json"))
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	if !IsFileMode(fc) {
+		t.Error("IsFileMode(file client) = false")
+	}
+	if !IsFileMode(NewSerialClient(NewBackupClient(fc, filepath.Join(t.TempDir(), "openclaw.json"), 1))) {
+		t.Error("IsFileMode(wrapped file client) = false")
+	}
+	if IsFileMode(newReconnectClient(t, newFakeGateway(t))) {
+		t.Error("IsFileMode(ws client) = true")
+	}
+}
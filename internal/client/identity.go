@@ -0,0 +1,127 @@
+package client
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// DeviceIdentity is the Ed25519 keypair used to sign the connect handshake.
+// The gateway pairs devices by ID, so reusing one identity across runs keeps
+// a single entry in its paired-device list.
+type DeviceIdentity struct {
+	key ed25519.PrivateKey
+}
+
+// deviceIdentityFile is the on-disk format written by LoadOrCreateDeviceIdentity.
+type deviceIdentityFile struct {
+	Version    int    `json:"version"`
+	DeviceID   string `json:"deviceId"`
+	PublicKey  string `json:"publicKey"`
+	PrivateKey string `json:"privateKey"` // base64url Ed25519 seed
+}
+
+// NewDeviceIdentity generates a fresh identity.
+func NewDeviceIdentity() (*DeviceIdentity, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate device key: %w", err)
+	}
+	return &DeviceIdentity{key: priv}, nil
+}
+
+// ID is the SHA-256 hex of the raw 32-byte public key.
+func (d *DeviceIdentity) ID() string {
+	sum := sha256.Sum256(d.PublicKey())
+	return fmt.Sprintf("%x", sum)
+}
+
+// PublicKey returns the raw 32-byte public key.
+func (d *DeviceIdentity) PublicKey() ed25519.PublicKey {
+	return d.key.Public().(ed25519.PublicKey)
+}
+
+// Sign signs payload with the identity's private key.
+func (d *DeviceIdentity) Sign(payload []byte) []byte {
+	return ed25519.Sign(d.key, payload)
+}
+
+// LoadOrCreateDeviceIdentity reads the identity stored at path. If the file
+// does not exist, a new identity is generated and written there with
+// owner-only permissions.
+func LoadOrCreateDeviceIdentity(path string) (*DeviceIdentity, error) {
+	expanded, err := expandPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("expanding device identity path: %w", err)
+	}
+
+	id, err := loadDeviceIdentity(expanded)
+	if !errors.Is(err, fs.ErrNotExist) {
+		return id, err
+	}
+
+	id, err = NewDeviceIdentity()
+	if err != nil {
+		return nil, err
+	}
+	if err := id.save(expanded); err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			// Another process created it first; use theirs.
+			return loadDeviceIdentity(expanded)
+		}
+		return nil, err
+	}
+	return id, nil
+}
+
+func loadDeviceIdentity(path string) (*DeviceIdentity, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f deviceIdentityFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parsing device identity %s: %w", path, err)
+	}
+	seed, err := base64.RawURLEncoding.DecodeString(f.PrivateKey)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("device identity %s has an invalid private key", path)
+	}
+	id := &DeviceIdentity{key: ed25519.NewKeyFromSeed(seed)}
+	if f.DeviceID != "" && f.DeviceID != id.ID() {
+		return nil, fmt.Errorf("device identity %s: deviceId does not match its key", path)
+	}
+	return id, nil
+}
+
+func (d *DeviceIdentity) save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("creating device identity directory: %w", err)
+	}
+	out, err := json.MarshalIndent(deviceIdentityFile{
+		Version:    1,
+		DeviceID:   d.ID(),
+		PublicKey:  base64.RawURLEncoding.EncodeToString(d.PublicKey()),
+		PrivateKey: base64.RawURLEncoding.EncodeToString(d.key.Seed()),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(out, '\n')); err != nil {
+		f.Close()
+		os.Remove(path)
+		return fmt.Errorf("writing device identity: %w", err)
+	}
+	return f.Close()
+}
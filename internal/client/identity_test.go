@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadOrCreateDeviceIdentity(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "identity", "device.json")
+
+	first, err := LoadOrCreateDeviceIdentity(path)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("identity file not written: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Fatalf("expected 0600 permissions, got %o", perm)
+	}
+
+	second, err := LoadOrCreateDeviceIdentity(path)
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if first.ID() != second.ID() {
+		t.Fatalf("device ID changed across loads: %s != %s", first.ID(), second.ID())
+	}
+}
+
+func TestLoadOrCreateDeviceIdentity_Mismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "device.json")
+	if _, err := LoadOrCreateDeviceIdentity(path); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	other, _ := NewDeviceIdentity()
+	tampered := strings.Replace(string(data), `"deviceId": "`, `"deviceId": "`+other.ID()[:8], 1)
+	if err := os.WriteFile(path, []byte(tampered), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadOrCreateDeviceIdentity(path); err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Fatalf("expected deviceId mismatch error, got %v", err)
+	}
+}
+
+func TestWSClient_PersistentDeviceIdentity(t *testing.T) {
+	g := newFakeGateway(t)
+	identity, err := LoadOrCreateDeviceIdentity(filepath.Join(t.TempDir(), "device.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for i := 0; i < 2; i++ {
+		c, err := NewWSClient(ctx, WSClientConfig{URL: g.URL(), DeviceIdentity: identity})
+		if err != nil {
+			t.Fatalf("connect %d: %v", i, err)
+		}
+		c.Close()
+
+		device, _ := g.lastConnect()["device"].(map[string]any)
+		if device["id"] != identity.ID() {
+			t.Fatalf("connect %d used device %v, want %s", i, device["id"], identity.ID())
+		}
+	}
+}
@@ -0,0 +1,486 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// json5ToJSON converts a JSON5 document (comments, trailing commas, unquoted
+// keys, single-quoted strings, hex and signed numbers, ...) into the
+// equivalent strict JSON so it can be decoded with encoding/json. OpenClaw
+// config files are JSON5, and humans use all of these.
+func json5ToJSON(src []byte) ([]byte, error) {
+	p := &json5Parser{src: src}
+	if _, err := p.document(); err != nil {
+		return nil, err
+	}
+	return p.out.Bytes(), nil
+}
+
+// parseJSON5Tree parses src and returns the byte spans of its values, for
+// editing the document in place.
+func parseJSON5Tree(src []byte) (*json5Node, error) {
+	p := &json5Parser{src: src}
+	return p.document()
+}
+
+// json5Node is the location of a parsed value in the source. Only objects
+// record their members; other values are edited as a whole.
+type json5Node struct {
+	start, end int
+	isObject   bool
+	members    []json5Member
+}
+
+// json5Member is one key/value pair of an object.
+type json5Member struct {
+	key      string
+	keyStart int
+	// quoted reports whether the key was written as a string literal.
+	quoted bool
+	value  *json5Node
+	// comma is the offset of the comma following the member, or -1.
+	comma int
+}
+
+func (p *json5Parser) document() (*json5Node, error) {
+	p.skipSpace()
+	n, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected %q after top-level value", p.peekRune())
+	}
+	return n, nil
+}
+
+type json5Parser struct {
+	src []byte
+	pos int
+	out bytes.Buffer
+	// err records an unterminated block comment found while skipping space.
+	err error
+}
+
+// errorf reports a syntax error at the current position as line:column.
+func (p *json5Parser) errorf(format string, args ...any) error {
+	line, col := 1, 1
+	for _, r := range string(p.src[:p.pos]) {
+		if r == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	return fmt.Errorf("json5: line %d, column %d: %s", line, col, fmt.Sprintf(format, args...))
+}
+
+func (p *json5Parser) peekRune() rune {
+	if p.pos >= len(p.src) {
+		return utf8.RuneError
+	}
+	r, _ := utf8.DecodeRune(p.src[p.pos:])
+	return r
+}
+
+func (p *json5Parser) nextRune() rune {
+	r, n := utf8.DecodeRune(p.src[p.pos:])
+	p.pos += n
+	return r
+}
+
+func isJSON5Space(r rune) bool {
+	switch r {
+	case '\t', '\n', '\v', '\f', '\r', ' ', 0xA0, 0x2028, 0x2029, 0xFEFF:
+		return true
+	}
+	return unicode.Is(unicode.Zs, r)
+}
+
+// skipSpace skips whitespace and comments.
+func (p *json5Parser) skipSpace() {
+	for p.pos < len(p.src) {
+		switch {
+		case bytes.HasPrefix(p.src[p.pos:], []byte("//")):
+			end := bytes.IndexAny(p.src[p.pos:], "\n\r")
+			if end < 0 {
+				p.pos = len(p.src)
+			} else {
+				p.pos += end
+			}
+		case bytes.HasPrefix(p.src[p.pos:], []byte("/*")):
+			end := bytes.Index(p.src[p.pos+2:], []byte("*/"))
+			if end < 0 {
+				if p.err == nil {
+					p.err = p.errorf("unterminated block comment")
+				}
+				p.pos = len(p.src)
+			} else {
+				p.pos += end + 4
+			}
+		case isJSON5Space(p.peekRune()):
+			p.nextRune()
+		default:
+			return
+		}
+	}
+}
+
+func (p *json5Parser) value() (*json5Node, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.pos >= len(p.src) {
+		return nil, p.errorf("unexpected end of input")
+	}
+	n := &json5Node{start: p.pos}
+	var err error
+	switch c := p.src[p.pos]; {
+	case c == '{':
+		n.isObject = true
+		n.members, err = p.object()
+	case c == '[':
+		err = p.array()
+	case c == '"' || c == '\'':
+		var s string
+		if s, err = p.str(); err == nil {
+			err = p.writeString(s)
+		}
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		err = p.number()
+	default:
+		err = p.literal()
+	}
+	if err != nil {
+		return nil, err
+	}
+	n.end = p.pos
+	return n, nil
+}
+
+// literal reads one of the keywords true, false and null.
+func (p *json5Parser) literal() error {
+	ident := p.ident()
+	switch ident {
+	case "true", "false", "null":
+		p.out.WriteString(ident)
+		return nil
+	case "Infinity", "NaN":
+		return p.errorf("%s cannot be represented in JSON", ident)
+	case "":
+		return p.errorf("unexpected %q", p.peekRune())
+	}
+	return p.errorf("unexpected identifier %q", ident)
+}
+
+func (p *json5Parser) object() ([]json5Member, error) {
+	p.pos++ // {
+	p.out.WriteByte('{')
+	var members []json5Member
+	for {
+		p.skipSpace()
+		if p.err != nil {
+			return nil, p.err
+		}
+		if p.pos < len(p.src) && p.src[p.pos] == '}' {
+			p.pos++
+			p.out.WriteByte('}')
+			return members, nil
+		}
+		if len(members) > 0 {
+			p.out.WriteByte(',')
+		}
+
+		m := json5Member{keyStart: p.pos, comma: -1}
+		if p.pos < len(p.src) && (p.src[p.pos] == '"' || p.src[p.pos] == '\'') {
+			s, err := p.str()
+			if err != nil {
+				return nil, err
+			}
+			m.key, m.quoted = s, true
+		} else if m.key = p.ident(); m.key == "" {
+			if p.pos >= len(p.src) {
+				return nil, p.errorf("unterminated object")
+			}
+			return nil, p.errorf("expected object key, got %q", p.peekRune())
+		}
+		if err := p.writeString(m.key); err != nil {
+			return nil, err
+		}
+
+		p.skipSpace()
+		if p.pos >= len(p.src) || p.src[p.pos] != ':' {
+			return nil, p.errorf("expected ':' after object key %q", m.key)
+		}
+		p.pos++
+		p.out.WriteByte(':')
+		p.skipSpace()
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		m.value = v
+
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == ',' {
+			m.comma = p.pos
+			p.pos++
+			members = append(members, m)
+			continue
+		}
+		members = append(members, m)
+		p.skipSpace()
+		if p.pos >= len(p.src) || p.src[p.pos] != '}' {
+			return nil, p.errorf("expected ',' or '}' in object")
+		}
+	}
+}
+
+func (p *json5Parser) array() error {
+	p.pos++ // [
+	p.out.WriteByte('[')
+	first := true
+	for {
+		p.skipSpace()
+		if p.err != nil {
+			return p.err
+		}
+		if p.pos < len(p.src) && p.src[p.pos] == ']' {
+			p.pos++
+			p.out.WriteByte(']')
+			return nil
+		}
+		if !first {
+			p.out.WriteByte(',')
+		}
+		first = false
+
+		if _, err := p.value(); err != nil {
+			return err
+		}
+
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		p.skipSpace()
+		if p.pos >= len(p.src) || p.src[p.pos] != ']' {
+			return p.errorf("expected ',' or ']' in array")
+		}
+	}
+}
+
+// ident reads an ECMAScript IdentifierName, returning "" if there is none at
+// the current position. Unicode escapes in identifiers are not supported.
+func (p *json5Parser) ident() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		r := p.peekRune()
+		isStart := r == '$' || r == '_' || unicode.IsLetter(r) || unicode.Is(unicode.Nl, r)
+		isPart := unicode.IsDigit(r) || unicode.In(r, unicode.Mn, unicode.Mc, unicode.Pc) || r == 0x200C || r == 0x200D
+		if !isStart && !(isPart && p.pos > start) {
+			break
+		}
+		p.nextRune()
+	}
+	return string(p.src[start:p.pos])
+}
+
+// str reads a single- or double-quoted string and returns its value.
+func (p *json5Parser) str() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.pos >= len(p.src) {
+			return "", p.errorf("unterminated string")
+		}
+		r := p.nextRune()
+		switch {
+		case r == rune(quote):
+			return b.String(), nil
+		case r == '\n' || r == '\r':
+			return "", p.errorf("unescaped line break in string")
+		case r != '\\':
+			b.WriteRune(r)
+			continue
+		}
+
+		if p.pos >= len(p.src) {
+			return "", p.errorf("unterminated string")
+		}
+		esc := p.nextRune()
+		switch esc {
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'v':
+			b.WriteByte('\v')
+		case '0':
+			if p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+				return "", p.errorf("octal escapes are not allowed")
+			}
+			b.WriteByte(0)
+		case 'x':
+			v, err := p.hexDigits(2)
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(rune(v))
+		case 'u':
+			v, err := p.hexDigits(4)
+			if err != nil {
+				return "", err
+			}
+			r := rune(v)
+			if utf16IsHighSurrogate(r) && bytes.HasPrefix(p.src[p.pos:], []byte(`\u`)) {
+				save := p.pos
+				p.pos += 2
+				lo, err := p.hexDigits(4)
+				if err == nil && utf16IsLowSurrogate(rune(lo)) {
+					r = (r-0xD800)<<10 + (rune(lo) - 0xDC00) + 0x10000
+				} else {
+					p.pos = save
+				}
+			}
+			b.WriteRune(r)
+		case '\r':
+			// Line continuation; a CRLF counts as one line break.
+			if p.pos < len(p.src) && p.src[p.pos] == '\n' {
+				p.pos++
+			}
+		case '\n', 0x2028, 0x2029:
+			// Line continuation.
+		default:
+			if esc >= '1' && esc <= '9' {
+				return "", p.errorf("invalid escape \\%c", esc)
+			}
+			b.WriteRune(esc)
+		}
+	}
+}
+
+func utf16IsHighSurrogate(r rune) bool { return r >= 0xD800 && r < 0xDC00 }
+func utf16IsLowSurrogate(r rune) bool  { return r >= 0xDC00 && r < 0xE000 }
+
+func (p *json5Parser) hexDigits(n int) (uint64, error) {
+	if p.pos+n > len(p.src) {
+		return 0, p.errorf("truncated hex escape")
+	}
+	v, err := strconv.ParseUint(string(p.src[p.pos:p.pos+n]), 16, 32)
+	if err != nil {
+		return 0, p.errorf("invalid hex escape %q", p.src[p.pos:p.pos+n])
+	}
+	p.pos += n
+	return v, nil
+}
+
+func (p *json5Parser) writeString(s string) error {
+	enc, err := json.Marshal(s)
+	if err != nil {
+		return p.errorf("encoding string: %v", err)
+	}
+	p.out.Write(enc)
+	return nil
+}
+
+// number reads a JSON5 number and writes it in strict JSON form.
+func (p *json5Parser) number() error {
+	start := p.pos
+	neg := false
+	if c := p.src[p.pos]; c == '+' || c == '-' {
+		neg = c == '-'
+		p.pos++
+	}
+
+	if ident := p.ident(); ident == "Infinity" || ident == "NaN" {
+		return p.errorf("%s cannot be represented in JSON", ident)
+	} else if ident != "" {
+		return p.errorf("invalid number %q", p.src[start:p.pos])
+	}
+	if bytes.HasPrefix(p.src[p.pos:], []byte("0x")) || bytes.HasPrefix(p.src[p.pos:], []byte("0X")) {
+		p.pos += 2
+		digits := p.scan(func(c byte) bool {
+			return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+		})
+		v, ok := new(big.Int).SetString(digits, 16)
+		if !ok {
+			return p.errorf("invalid hex number %q", p.src[start:p.pos])
+		}
+		if neg {
+			v.Neg(v)
+		}
+		p.out.WriteString(v.String())
+		return nil
+	}
+
+	intPart := p.scan(isDigit)
+	fracPart, hasDot := "", false
+	if p.pos < len(p.src) && p.src[p.pos] == '.' {
+		hasDot = true
+		p.pos++
+		fracPart = p.scan(isDigit)
+	}
+	if intPart == "" && fracPart == "" {
+		return p.errorf("invalid number %q", p.src[start:p.pos])
+	}
+	if len(intPart) > 1 && intPart[0] == '0' {
+		return p.errorf("leading zeros are not allowed in %q", p.src[start:p.pos])
+	}
+	expPart := ""
+	if p.pos < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+		expStart := p.pos
+		p.pos++
+		if p.pos < len(p.src) && (p.src[p.pos] == '+' || p.src[p.pos] == '-') {
+			p.pos++
+		}
+		if p.scan(isDigit) == "" {
+			return p.errorf("invalid exponent in %q", p.src[start:p.pos])
+		}
+		expPart = string(p.src[expStart:p.pos])
+	}
+
+	if neg {
+		p.out.WriteByte('-')
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	p.out.WriteString(intPart)
+	if hasDot && fracPart != "" {
+		p.out.WriteByte('.')
+		p.out.WriteString(fracPart)
+	}
+	p.out.WriteString(expPart)
+	return nil
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func (p *json5Parser) scan(ok func(byte) bool) string {
+	start := p.pos
+	for p.pos < len(p.src) && ok(p.src[p.pos]) {
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJSON5ToJSON(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"strict", `{"a":1,"b":[true,null]}`, `{"a":1,"b":[true,null]}`},
+		{"line comment", "{\n  // port\n  \"a\": 1\n}", `{"a":1}`},
+		{"block comment", `/* head */ {"a": /* inline */ 1}`, `{"a":1}`},
+		{"trailing commas", `{"a": [1, 2,], "b": 3,}`, `{"a":[1,2],"b":3}`},
+		{"unquoted keys", `{gateway: {port: 18789, $x: 1, _y: 2}}`, `{"gateway":{"port":18789,"$x":1,"_y":2}}`},
+		{"single quotes", `{'a': 'it\'s "quoted"'}`, `{"a":"it's \"quoted\""}`},
+		{"escapes", `['\x41é\v\0', "a\
+b"]`, `["Aé\u000b\u0000","ab"]`},
+		{"surrogate pair", `"\ud83d\ude00"`, `"😀"`},
+		{"numbers", `[+1, -2, .5, 5., 0x1F, -0xff, 1e3, 2.5E-2]`, `[1,-2,0.5,5,31,-255,1e3,2.5E-2]`},
+		{"whitespace", "\ufeff{ \"a\" :\t\u00a01}", `{"a":1}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json5ToJSON([]byte(tc.in))
+			if err != nil {
+				t.Fatalf("json5ToJSON: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestJSON5ToJSON_Errors(t *testing.T) {
+	cases := []struct {
+		name, in, wantErr string
+	}{
+		{"unterminated object", `{"a": 1`, "line 1, column 8"},
+		{"unterminated comment", "{\n/* a", "unterminated block comment"},
+		{"infinity", `{a: Infinity}`, "Infinity cannot be represented"},
+		{"nan", `[-NaN]`, "NaN cannot be represented"},
+		{"bare word", `{a: yes}`, `unexpected identifier "yes"`},
+		{"trailing data", `{} {}`, "after top-level value"},
+		{"leading zero", `[01]`, "leading zeros"},
+		{"missing colon", "{\n  a 1\n}", "line 2, column 5"},
+		{"raw newline in string", "'a\nb'", "unescaped line break"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := json5ToJSON([]byte(tc.in))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
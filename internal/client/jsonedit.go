@@ -0,0 +1,356 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// patchPreserving applies an RFC 7396 merge patch to a JSON or JSON5
+// document by rewriting only the members the patch touches. Comments, key
+// order, quoting and indentation everywhere else are left exactly as they
+// were, so hand-maintained config files stay readable after terraform apply.
+//
+// The result is parsed back and compared with what mergePatch would produce;
+// any difference is reported as an error so the caller can fall back to a
+// full rewrite rather than write a subtly wrong file.
+func patchPreserving(src []byte, patch map[string]any) ([]byte, error) {
+	root, err := parseJSON5Tree(src)
+	if err != nil {
+		return nil, err
+	}
+	if !root.isObject {
+		return nil, fmt.Errorf("top-level value is not an object")
+	}
+
+	current, err := parseRawJSON(string(src))
+	if err != nil {
+		return nil, err
+	}
+	merged := mergePatch(current, patch)
+
+	e := &jsonEditor{src: src, unit: detectIndentUnit(src, root)}
+	if len(root.members) == 0 && e.isBlank(root.start+1, root.end-1) {
+		// An empty document has no formatting to keep.
+		return json.MarshalIndent(merged, "", "  ")
+	}
+	e.patchObject(root, patch, e.singleLine(root))
+	out := e.apply()
+
+	if err := sameJSON(out, merged); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// sameJSON reports an error unless doc decodes to the same value as want.
+func sameJSON(doc []byte, want map[string]any) error {
+	got, err := parseRawJSON(string(doc))
+	if err != nil {
+		return fmt.Errorf("edited document does not parse: %w", err)
+	}
+	// Round-trip want so numbers and slices have the types json decoding gives.
+	data, err := json.Marshal(want)
+	if err != nil {
+		return err
+	}
+	var norm map[string]any
+	if err := json.Unmarshal(data, &norm); err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(got, norm) {
+		return fmt.Errorf("edited document does not match the merged config")
+	}
+	return nil
+}
+
+type textEdit struct {
+	start, end int
+	text       string
+}
+
+type jsonEditor struct {
+	src   []byte
+	unit  string
+	edits []textEdit
+}
+
+func (e *jsonEditor) replace(start, end int, text string) {
+	e.edits = append(e.edits, textEdit{start, end, text})
+}
+
+// apply splices the edits into the source. Insertions at a position go
+// before a deletion starting there, and overlapping deletions are merged.
+func (e *jsonEditor) apply() []byte {
+	sort.SliceStable(e.edits, func(i, j int) bool {
+		a, b := e.edits[i], e.edits[j]
+		return a.start < b.start || (a.start == b.start && a.end < b.end)
+	})
+	var out bytes.Buffer
+	pos := 0
+	for _, ed := range e.edits {
+		if ed.start < pos {
+			if ed.end <= pos {
+				continue
+			}
+			ed.start = pos
+		}
+		out.Write(e.src[pos:ed.start])
+		out.WriteString(ed.text)
+		pos = ed.end
+	}
+	out.Write(e.src[pos:])
+	return out.Bytes()
+}
+
+// patchObject records the edits that apply patch to obj. parentInline
+// reports whether the enclosing object is written on a single line.
+func (e *jsonEditor) patchObject(obj *json5Node, patch map[string]any, parentInline bool) {
+	keys := make([]string, 0, len(patch))
+	for k := range patch {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// Later duplicates win when a JSON5 object is decoded, so edit the last one.
+	index := make(map[string]int, len(obj.members))
+	for i, m := range obj.members {
+		index[m.key] = i
+	}
+
+	removed := make(map[int]bool)
+	var added []string
+	for _, k := range keys {
+		v := patch[k]
+		i, ok := index[k]
+		if !ok {
+			if v != nil {
+				added = append(added, k)
+			}
+			continue
+		}
+		m := obj.members[i]
+		switch pv, isMap := v.(map[string]any); {
+		case v == nil:
+			removed[i] = true
+		case isMap && m.value.isObject:
+			e.patchObject(m.value, pv, e.singleLine(obj))
+		default:
+			e.replace(m.value.start, m.value.end, e.render(withoutNulls(v), e.lineIndent(m.keyStart), e.singleLine(obj)))
+		}
+	}
+
+	if len(removed) == 0 && len(added) == 0 {
+		return
+	}
+	if len(obj.members) == 0 && e.isBlank(obj.start+1, obj.end-1) {
+		// Nothing worth preserving inside: write the object out afresh.
+		fresh := make(map[string]any, len(added))
+		for _, k := range added {
+			fresh[k] = withoutNulls(patch[k])
+		}
+		e.replace(obj.start, obj.end, e.render(fresh, e.lineIndent(obj.start), parentInline))
+		return
+	}
+
+	lastKept := -1
+	for i := range obj.members {
+		if removed[i] {
+			e.removeMember(obj.members[i])
+		} else {
+			lastKept = i
+		}
+	}
+
+	trailingComma := len(obj.members) > 0 && obj.members[len(obj.members)-1].comma >= 0
+	if len(added) == 0 && lastKept >= 0 && lastKept < len(obj.members)-1 && !trailingComma {
+		// The old last member had no comma; keep it that way for strict JSON.
+		c := obj.members[lastKept].comma
+		e.replace(c, c+1, "")
+	}
+
+	if len(added) > 0 {
+		e.addMembers(obj, lastKept, patch, added, trailingComma)
+	}
+}
+
+// withoutNulls returns a patch value as written where nothing was before:
+// nulls inside an object delete keys that do not exist, so they are dropped.
+func withoutNulls(v any) any {
+	if m, ok := v.(map[string]any); ok {
+		return mergePatch(nil, m)
+	}
+	return v
+}
+
+// addMembers inserts new keys at the end of obj.
+func (e *jsonEditor) addMembers(obj *json5Node, lastKept int, patch map[string]any, keys []string, trailingComma bool) {
+	inline := e.singleLine(obj)
+	indent := e.memberIndent(obj)
+	bare := len(obj.members) > 0
+	for _, m := range obj.members {
+		bare = bare && !m.quoted
+	}
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		key := quoteKey(k, bare)
+		parts[i] = key + ": " + e.render(withoutNulls(patch[k]), indent, inline)
+	}
+
+	var anchor *json5Member
+	if lastKept >= 0 {
+		anchor = &obj.members[lastKept]
+	}
+	hasComma := anchor != nil && anchor.comma >= 0
+	closeLine := e.lineStart(obj.end - 1)
+
+	if !inline && e.isBlank(closeLine, obj.end-1) && (anchor == nil || anchor.value.end <= closeLine) {
+		// Closing brace on its own line: add one line per member above it.
+		var b strings.Builder
+		for i, part := range parts {
+			b.WriteString(indent + part)
+			if i < len(parts)-1 || trailingComma {
+				b.WriteByte(',')
+			}
+			b.WriteByte('\n')
+		}
+		if anchor != nil && !hasComma {
+			e.replace(anchor.value.end, anchor.value.end, ",")
+		}
+		e.replace(closeLine, closeLine, b.String())
+		return
+	}
+
+	lead := " "
+	if !inline {
+		lead = "\n" + indent
+	}
+	text := strings.Join(parts, ","+lead)
+	if trailingComma {
+		text += ","
+	}
+	switch {
+	case anchor == nil:
+		e.replace(obj.start+1, obj.start+1, lead+text+" ")
+	case hasComma:
+		e.replace(anchor.comma+1, anchor.comma+1, lead+text)
+	default:
+		e.replace(anchor.value.end, anchor.value.end, ","+lead+text)
+	}
+}
+
+// removeMember deletes m along with its comma. A member on lines of its own
+// takes its indentation and any trailing line comment with it.
+func (e *jsonEditor) removeMember(m json5Member) {
+	start, end := m.keyStart, m.value.end
+	if m.comma >= 0 {
+		end = m.comma + 1
+	}
+	ls := e.lineStart(start)
+	le := e.lineEnd(end)
+	if e.isBlank(ls, start) && e.isBlankOrComment(end, le) {
+		start = ls
+		end = le
+		if end < len(e.src) {
+			end++ // the newline
+		}
+	} else if m.comma >= 0 {
+		for end < len(e.src) && (e.src[end] == ' ' || e.src[end] == '\t') {
+			end++
+		}
+	} else {
+		for start > 0 && (e.src[start-1] == ' ' || e.src[start-1] == '\t') {
+			start--
+		}
+	}
+	e.replace(start, end, "")
+}
+
+// render formats v as JSON, either on one line or indented to sit at indent.
+func (e *jsonEditor) render(v any, indent string, inline bool) string {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if !inline {
+		enc.SetIndent(indent, e.unit)
+	}
+	if err := enc.Encode(v); err != nil {
+		// Every value in a patch came from json-compatible Go types; the
+		// result check in patchPreserving catches anything that slips by.
+		return "null"
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+var identKey = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// quoteKey renders an object key, leaving it bare when the surrounding object
+// uses bare keys and k is a plain identifier.
+func quoteKey(k string, bare bool) string {
+	if bare && identKey.MatchString(k) {
+		return k
+	}
+	data, _ := json.Marshal(k)
+	return string(data)
+}
+
+// memberIndent returns the indentation used for members of obj.
+func (e *jsonEditor) memberIndent(obj *json5Node) string {
+	for _, m := range obj.members {
+		if ls := e.lineStart(m.keyStart); e.isBlank(ls, m.keyStart) {
+			return string(e.src[ls:m.keyStart])
+		}
+	}
+	return e.lineIndent(obj.start) + e.unit
+}
+
+func (e *jsonEditor) singleLine(obj *json5Node) bool {
+	return !bytes.ContainsAny(e.src[obj.start:obj.end], "\n")
+}
+
+func (e *jsonEditor) lineStart(pos int) int {
+	return bytes.LastIndexByte(e.src[:pos], '\n') + 1
+}
+
+func (e *jsonEditor) lineEnd(pos int) int {
+	if i := bytes.IndexByte(e.src[pos:], '\n'); i >= 0 {
+		return pos + i
+	}
+	return len(e.src)
+}
+
+// lineIndent returns the leading whitespace of the line containing pos.
+func (e *jsonEditor) lineIndent(pos int) string {
+	ls := e.lineStart(pos)
+	end := ls
+	for end < len(e.src) && (e.src[end] == ' ' || e.src[end] == '\t') {
+		end++
+	}
+	return string(e.src[ls:end])
+}
+
+func (e *jsonEditor) isBlank(start, end int) bool {
+	return len(bytes.TrimSpace(e.src[start:end])) == 0
+}
+
+func (e *jsonEditor) isBlankOrComment(start, end int) bool {
+	rest := bytes.TrimSpace(e.src[start:end])
+	return len(rest) == 0 || bytes.HasPrefix(rest, []byte("//"))
+}
+
+// detectIndentUnit guesses one level of indentation from the first member of
+// the root object that sits on its own line.
+func detectIndentUnit(src []byte, root *json5Node) string {
+	for _, m := range root.members {
+		ls := bytes.LastIndexByte(src[:m.keyStart], '\n') + 1
+		if ls > 0 && len(bytes.TrimSpace(src[ls:m.keyStart])) == 0 && m.keyStart > ls {
+			return string(src[ls:m.keyStart])
+		}
+	}
+	return "  "
+}
@@ -0,0 +1,170 @@
+package client
+
+import "testing"
+
+func TestPatchPreserving(t *testing.T) {
+	cases := []struct {
+		name  string
+		src   string
+		patch map[string]any
+		want  string
+	}{
+		{
+			name: "replace value keeps comments",
+			src: `// OpenClaw config
+{
+  // Gateway settings
+  gateway: {
+    port: 18789, // default port
+    bind: 'loopback',
+  },
+}
+`,
+			patch: map[string]any{"gateway": map[string]any{"port": 19000}},
+			want: `// OpenClaw config
+{
+  // Gateway settings
+  gateway: {
+    port: 19000, // default port
+    bind: 'loopback',
+  },
+}
+`,
+		},
+		{
+			name: "add member with trailing commas and bare keys",
+			src: `{
+  gateway: {
+    port: 18789,
+  },
+}
+`,
+			patch: map[string]any{"gateway": map[string]any{"bind": "lan"}, "messages": map[string]any{"ackReaction": "eyes"}},
+			want: `{
+  gateway: {
+    port: 18789,
+    bind: "lan",
+  },
+  messages: {
+    "ackReaction": "eyes"
+  },
+}
+`,
+		},
+		{
+			name: "add member to strict JSON",
+			src: `{
+    "gateway": {
+        "port": 18789
+    }
+}`,
+			patch: map[string]any{"gateway": map[string]any{"bind": "lan"}},
+			want: `{
+    "gateway": {
+        "port": 18789,
+        "bind": "lan"
+    }
+}`,
+		},
+		{
+			name: "remove member with its comment",
+			src: `{
+  "gateway": {"port": 18789},
+  // legacy, remove me
+  "messages": {"ackReaction": "eyes"}, // trailing note
+  "tools": {}
+}
+`,
+			patch: map[string]any{"messages": nil},
+			want: `{
+  "gateway": {"port": 18789},
+  // legacy, remove me
+  "tools": {}
+}
+`,
+		},
+		{
+			name: "remove last member drops dangling comma",
+			src: `{
+  "gateway": {"port": 18789},
+  "messages": {"ackReaction": "eyes"}
+}
+`,
+			patch: map[string]any{"messages": nil},
+			want: `{
+  "gateway": {"port": 18789}
+}
+`,
+		},
+		{
+			name:  "single line object",
+			src:   `{"gateway": {"port": 18789, "bind": "lan"}}`,
+			patch: map[string]any{"gateway": map[string]any{"bind": nil, "auth": map[string]any{"mode": "token"}}},
+			want:  `{"gateway": {"port": 18789, "auth": {"mode":"token"}}}`,
+		},
+		{
+			name: "fill empty nested object",
+			src: `{
+  // plugins live here
+  "plugins": {}
+}
+`,
+			patch: map[string]any{"plugins": map[string]any{"entries": map[string]any{"voice": map[string]any{"enabled": true}}}},
+			want: `{
+  // plugins live here
+  "plugins": {
+    "entries": {
+      "voice": {
+        "enabled": true
+      }
+    }
+  }
+}
+`,
+		},
+		{
+			name:  "replace and remove across levels with tabs",
+			src:   "{\n\t\"a\": [1, 2],\n\t\"b\": {\n\t\t\"c\": 1,\n\t\t\"d\": 2\n\t}\n}\n",
+			patch: map[string]any{"a": []any{3}, "b": map[string]any{"d": nil, "e": map[string]any{"f": "g"}}},
+			want:  "{\n\t\"a\": [\n\t\t3\n\t],\n\t\"b\": {\n\t\t\"c\": 1,\n\t\t\"e\": {\n\t\t\t\"f\": \"g\"\n\t\t}\n\t}\n}\n",
+		},
+		{
+			name:  "nulls in new object are dropped",
+			src:   `{"gateway": {"port": 18789}}`,
+			patch: map[string]any{"update": map[string]any{"channel": "beta", "pinnedVersion": nil}},
+			want:  `{"gateway": {"port": 18789}, "update": {"channel":"beta"}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := patchPreserving([]byte(tc.src), tc.patch)
+			if err != nil {
+				t.Fatalf("patchPreserving: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPatchPreserving_EmptyDocument(t *testing.T) {
+	got, err := patchPreserving([]byte("{}"), map[string]any{"gateway": map[string]any{"port": 18789}})
+	if err != nil {
+		t.Fatalf("patchPreserving: %v", err)
+	}
+	want := "{\n  \"gateway\": {\n    \"port\": 18789\n  }\n}"
+	if string(got) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPatchPreserving_DuplicateKeys(t *testing.T) {
+	// Removing the later duplicate would expose the earlier one, so the edit
+	// must be rejected for the caller to fall back to a full rewrite.
+	_, err := patchPreserving([]byte(`{"a": 1, "a": 2}`), map[string]any{"a": nil})
+	if err == nil {
+		t.Fatal("expected an error for a patch that cannot be applied in place")
+	}
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Circuit breaker defaults, used by the provider unless configured otherwise.
+const (
+	DefaultBreakerThreshold = 5
+	DefaultBreakerCooldown  = 30 * time.Second
+)
+
+// CircuitOpenError is returned without contacting the gateway after it has
+// failed too many requests in a row, so a broken gateway fails the run
+// quickly instead of every resource waiting out its own retries.
+type CircuitOpenError struct {
+	// Failures is how many requests failed in a row.
+	Failures int
+	// Until is when requests will be sent again.
+	Until time.Time
+	// Last is the error of the most recent failed request.
+	Last error
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("gateway failed %d requests in a row, not sending more for %s (last error: %v)",
+		e.Failures, time.Until(e.Until).Round(time.Second), e.Last)
+}
+
+func (e *CircuitOpenError) Unwrap() error { return e.Last }
+
+// rpcLimiter paces the requests sent to one gateway and stops sending them
+// while the gateway keeps failing. The zero value imposes no limits.
+type rpcLimiter struct {
+	slots    chan struct{} // bounds requests in flight; nil for no bound
+	interval time.Duration // minimum gap between request starts
+
+	threshold int // consecutive failures that open the circuit; 0 disables it
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	next      time.Time // earliest start of the next request
+	failures  int
+	last      error
+	openUntil time.Time
+}
+
+func newRPCLimiter(cfg WSClientConfig) *rpcLimiter {
+	l := &rpcLimiter{threshold: cfg.BreakerThreshold, cooldown: cfg.BreakerCooldown}
+	if cfg.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, cfg.MaxInFlight)
+	}
+	if cfg.RequestsPerSecond > 0 {
+		l.interval = time.Second / time.Duration(cfg.RequestsPerSecond)
+	}
+	if l.cooldown <= 0 {
+		l.cooldown = DefaultBreakerCooldown
+	}
+	return l
+}
+
+// admit fails fast while the circuit is open. Health checks are always let
+// through: they are how a recovered gateway is noticed.
+func (l *rpcLimiter) admit(method string) error {
+	if l.threshold <= 0 || method == "health" {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if time.Now().Before(l.openUntil) {
+		return &CircuitOpenError{Failures: l.failures, Until: l.openUntil, Last: l.last}
+	}
+	return nil
+}
+
+// record updates the circuit with the outcome of a call. Only failures that
+// point at the gateway itself count; a rejected write means it is working.
+// Once open, the circuit lets requests through again after the cooldown,
+// and the first failure reopens it.
+func (l *rpcLimiter) record(ctx context.Context, method string, resp wsFrame, err error) {
+	if l.threshold <= 0 {
+		return
+	}
+	failed := err
+	if err == nil && (resp.OK == nil || !*resp.OK) && gatewayFailure(resp.Error) {
+		failed = fmt.Errorf("%s failed: %v", method, resp.Error)
+	}
+	if failed != nil && (ctx.Err() != nil || method == "health") {
+		// Cancelled by the caller, or a gateway that is still starting.
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if failed == nil {
+		l.failures = 0
+		l.last = nil
+		return
+	}
+	l.failures++
+	l.last = failed
+	if l.failures >= l.threshold {
+		l.openUntil = time.Now().Add(l.cooldown)
+	}
+}
+
+// pace waits for a free slot and for the request's turn under the rate
+// limit. The returned func gives the slot back.
+func (l *rpcLimiter) pace(ctx context.Context) (func(), error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+	if l.interval <= 0 {
+		return release, nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait := time.Until(start); wait > 0 {
+		t := time.NewTimer(wait)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+	return release, nil
+}
+
+// gatewayFailure reports whether a response error payload means the gateway
+// could not serve the request, as opposed to rejecting it.
+func gatewayFailure(payload any) bool {
+	e, ok := payload.(map[string]any)
+	if !ok {
+		return false
+	}
+	code, _ := e["code"].(string)
+	switch strings.ToUpper(code) {
+	case "UNAVAILABLE", "INTERNAL", "INTERNAL_ERROR", "RATE_LIMITED", "TOO_MANY_REQUESTS", "TIMEOUT":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newLimitedClient(t *testing.T, g *fakeGateway, cfg WSClientConfig) *WSClient {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cfg.URL = g.URL()
+	c, err := NewWSClient(ctx, cfg)
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestWSClient_BoundsRequestsInFlight(t *testing.T) {
+	g := newFakeGateway(t)
+	c := newLimitedClient(t, g, WSClientConfig{MaxInFlight: 1})
+
+	// The fake gateway answers one request at a time, so a second request
+	// sent while the first is unanswered would sit behind it. With one slot,
+	// the second is not even sent until the first is released.
+	release := make(chan struct{})
+	var running, peak atomic.Int32
+	g.handle("agent.run", func(map[string]any) (any, any) {
+		if n := running.Add(1); n > peak.Load() {
+			peak.Store(n)
+		}
+		defer running.Add(-1)
+		<-release
+		return map[string]any{}, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Call(context.Background(), "agent.run", nil); err != nil {
+				t.Errorf("Call: %v", err)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	c.mu.Lock()
+	s := c.sess
+	c.mu.Unlock()
+	s.mu.Lock()
+	pending := len(s.pending)
+	s.mu.Unlock()
+	if pending != 1 {
+		t.Errorf("requests in flight = %d, want 1", pending)
+	}
+	close(release)
+	wg.Wait()
+}
+
+func TestWSClient_PacesRequests(t *testing.T) {
+	g := newFakeGateway(t)
+	c := newLimitedClient(t, g, WSClientConfig{RequestsPerSecond: 20})
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := c.GetConfig(ctx); err != nil {
+			t.Fatalf("GetConfig: %v", err)
+		}
+	}
+	// At 20 per second, five requests span at least four 50ms gaps.
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("5 requests took %s, want at least 200ms", elapsed)
+	}
+}
+
+func TestWSClient_CircuitBreaker(t *testing.T) {
+	g := newFakeGateway(t)
+	c := newLimitedClient(t, g, WSClientConfig{BreakerThreshold: 2, BreakerCooldown: 100 * time.Millisecond})
+	ctx := context.Background()
+
+	var down atomic.Bool
+	down.Store(true)
+	g.handle("config.get", func(map[string]any) (any, any) {
+		if down.Load() {
+			return nil, map[string]any{"code": "UNAVAILABLE", "message": "overloaded"}
+		}
+		return map[string]any{"raw": "{}", "hash": "h"}, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetConfig(ctx); err == nil {
+			t.Fatal("GetConfig succeeded against a failing gateway")
+		}
+	}
+	_, err := c.GetConfig(ctx)
+	var open *CircuitOpenError
+	if !errors.As(err, &open) {
+		t.Fatalf("err = %v, want CircuitOpenError", err)
+	}
+	if open.Failures != 2 {
+		t.Errorf("Failures = %d, want 2", open.Failures)
+	}
+	if n := g.callCount("config.get"); n != 2 {
+		t.Errorf("config.get calls = %d, want 2 (none while open)", n)
+	}
+	// Health checks still reach the gateway.
+	if _, err := c.Health(ctx); err != nil {
+		t.Fatalf("Health: %v", err)
+	}
+
+	// After the cooldown, a working gateway closes the circuit again.
+	down.Store(false)
+	time.Sleep(150 * time.Millisecond)
+	if _, err := c.GetConfig(ctx); err != nil {
+		t.Fatalf("GetConfig after cooldown: %v", err)
+	}
+}
+
+func TestWSClient_CircuitIgnoresRejectedWrites(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("config.patch", func(map[string]any) (any, any) {
+		return nil, map[string]any{"code": "INVALID", "message": "channels.telegram.dmPolicy: invalid value"}
+	})
+	c := newLimitedClient(t, g, WSClientConfig{BreakerThreshold: 1})
+
+	for i := 0; i < 3; i++ {
+		err := c.PatchConfig(context.Background(), map[string]any{"a": 1}, "h")
+		var open *CircuitOpenError
+		if err == nil || errors.As(err, &open) {
+			t.Fatalf("PatchConfig: err = %v, want the gateway's rejection", err)
+		}
+	}
+}
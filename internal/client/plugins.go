@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Plugin is an installed plugin, as returned by the plugins RPCs.
+type Plugin struct {
+	ID       string `json:"id"`
+	Version  string `json:"version,omitempty"`
+	Source   string `json:"source,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+	Enabled  bool   `json:"enabled"`
+}
+
+// PluginInstall describes a plugin to install: a registry name, git URL or
+// path on the gateway host, optionally narrowed by a version constraint and
+// verified against a checksum.
+type PluginInstall struct {
+	Source   string `json:"source"`
+	Version  string `json:"version,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// ListPlugins returns the plugins installed on the gateway.
+func ListPlugins(ctx context.Context, c Client) ([]Plugin, error) {
+	payload, err := c.Call(ctx, "plugins.list", nil)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Plugins []Plugin `json:"plugins"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("decode plugins.list response: %w", err)
+	}
+	return out.Plugins, nil
+}
+
+// FindPlugin returns the installed plugin with the given ID, or nil if there
+// is none.
+func FindPlugin(ctx context.Context, c Client, pluginID string) (*Plugin, error) {
+	plugins, err := ListPlugins(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	for i := range plugins {
+		if plugins[i].ID == pluginID {
+			return &plugins[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// InstallPlugin installs a plugin, or upgrades it in place if it is already
+// installed, and returns it as installed.
+func InstallPlugin(ctx context.Context, c Client, p PluginInstall) (*Plugin, error) {
+	payload, err := c.Call(ctx, "plugins.install", p)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Plugin Plugin `json:"plugin"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("decode plugins.install response: %w", err)
+	}
+	if out.Plugin.ID == "" {
+		return nil, fmt.Errorf("plugins.install returned no plugin")
+	}
+	return &out.Plugin, nil
+}
+
+// UninstallPlugin removes an installed plugin. Its plugins.entries config is
+// left in place.
+func UninstallPlugin(ctx context.Context, c Client, pluginID string) error {
+	_, err := c.Call(ctx, "plugins.uninstall", map[string]any{"id": pluginID})
+	return err
+}
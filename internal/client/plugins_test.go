@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+// fakePlugins serves the plugins RPCs from an in-memory registry on g, which
+// knows a single plugin, voice-call, at versions 1.2.0 and 2.0.0.
+func fakePlugins(g *fakeGateway) {
+	var mu sync.Mutex
+	installed := map[string]map[string]any{}
+
+	g.handle("plugins.list", func(map[string]any) (any, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		list := []any{}
+		for _, p := range installed {
+			list = append(list, p)
+		}
+		return map[string]any{"plugins": list}, nil
+	})
+	g.handle("plugins.install", func(p map[string]any) (any, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		if p["source"] != "@openclaw/voice-call" {
+			return nil, map[string]any{"code": "NOT_FOUND", "message": "no such plugin in the registry"}
+		}
+		version := "2.0.0"
+		if p["version"] == "^1.0.0" {
+			version = "1.2.0"
+		}
+		plugin := map[string]any{"id": "voice-call", "version": version, "source": p["source"], "enabled": true}
+		installed["voice-call"] = plugin
+		return map[string]any{"plugin": plugin}, nil
+	})
+	g.handle("plugins.uninstall", func(p map[string]any) (any, any) {
+		mu.Lock()
+		defer mu.Unlock()
+		delete(installed, p["id"].(string))
+		return map[string]any{"ok": true}, nil
+	})
+}
+
+func TestPlugins_Lifecycle(t *testing.T) {
+	g := newFakeGateway(t)
+	fakePlugins(g)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	p, err := InstallPlugin(ctx, c, PluginInstall{Source: "@openclaw/voice-call", Version: "^1.0.0"})
+	if err != nil {
+		t.Fatalf("InstallPlugin: %v", err)
+	}
+	if p.ID != "voice-call" || p.Version != "1.2.0" {
+		t.Errorf("installed plugin = %+v", p)
+	}
+
+	if _, err := InstallPlugin(ctx, c, PluginInstall{Source: "@openclaw/voice-call"}); err != nil {
+		t.Fatalf("InstallPlugin (upgrade): %v", err)
+	}
+	p, err = FindPlugin(ctx, c, "voice-call")
+	if err != nil {
+		t.Fatalf("FindPlugin: %v", err)
+	}
+	if p == nil || p.Version != "2.0.0" {
+		t.Errorf("plugin after upgrade = %+v", p)
+	}
+
+	if err := UninstallPlugin(ctx, c, "voice-call"); err != nil {
+		t.Fatalf("UninstallPlugin: %v", err)
+	}
+	if p, err := FindPlugin(ctx, c, "voice-call"); err != nil || p != nil {
+		t.Errorf("FindPlugin after uninstall = %+v, %v; want nil", p, err)
+	}
+}
+
+func TestPlugins_InstallUnknown(t *testing.T) {
+	g := newFakeGateway(t)
+	fakePlugins(g)
+	c := newReconnectClient(t, g)
+
+	if _, err := InstallPlugin(context.Background(), c, PluginInstall{Source: "@openclaw/unknown"}); err == nil {
+		t.Fatal("InstallPlugin of an unknown plugin succeeded")
+	}
+}
+
+func TestPlugins_FileMode(t *testing.T) {
+	c, err := NewFileClient(t.TempDir() + "/openclaw.json")
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	if _, err := ListPlugins(context.Background(), c); err == nil {
+		t.Fatal("ListPlugins succeeded in file mode")
+	}
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxyFunc returns the proxy selection used by the WebSocket dialer.
+//
+// An explicit ProxyURL is used for every connection. Otherwise the standard
+// HTTPS_PROXY, HTTP_PROXY and NO_PROXY variables are honoured, with ALL_PROXY
+// (typically a socks5:// bastion) as the fallback when neither is set.
+func proxyFunc(cfg WSClientConfig) (func(*http.Request) (*url.URL, error), error) {
+	if cfg.ProxyURL != "" {
+		u, err := parseProxyURL(cfg.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		return http.ProxyURL(u), nil
+	}
+
+	env := httpproxy.FromEnvironment()
+	if all := getenvAny("ALL_PROXY", "all_proxy"); all != "" {
+		if env.HTTPProxy == "" {
+			env.HTTPProxy = all
+		}
+		if env.HTTPSProxy == "" {
+			env.HTTPSProxy = all
+		}
+	}
+	if env.HTTPProxy == "" && env.HTTPSProxy == "" {
+		return nil, nil
+	}
+
+	fn := env.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		u, err := fn(req.URL)
+		if u == nil || err != nil {
+			return u, err
+		}
+		return parseProxyURL(u.String())
+	}, nil
+}
+
+// parseProxyURL validates a proxy URL against the schemes gorilla/websocket
+// can dial. socks5h is accepted as an alias: host names are always resolved
+// by the SOCKS server.
+func parseProxyURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", raw, err)
+	}
+	switch u.Scheme {
+	case "http", "socks5":
+	case "socks5h":
+		u.Scheme = "socks5"
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q in %q (use http, socks5 or socks5h)", u.Scheme, raw)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", raw)
+	}
+	return u, nil
+}
+
+func getenvAny(keys ...string) string {
+	for _, k := range keys {
+		if v := os.Getenv(k); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newConnectProxy starts an HTTP proxy that only supports CONNECT, which is
+// what gorilla/websocket uses for http:// proxies. It counts tunnels opened.
+func newConnectProxy(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var tunnels atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+			return
+		}
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		hj, ok := w.(http.Hijacker)
+		if !ok {
+			upstream.Close()
+			http.Error(w, "hijacking not supported", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		conn, _, err := hj.Hijack()
+		if err != nil {
+			upstream.Close()
+			return
+		}
+		tunnels.Add(1)
+		go func() {
+			defer conn.Close()
+			defer upstream.Close()
+			go io.Copy(upstream, conn)
+			io.Copy(conn, upstream)
+		}()
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &tunnels
+}
+
+func TestWSClient_HTTPProxy(t *testing.T) {
+	g := newFakeGateway(t)
+	proxy, tunnels := newConnectProxy(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{URL: g.URL(), ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatalf("NewWSClient through proxy: %v", err)
+	}
+	defer c.Close()
+
+	if _, err := c.GetConfig(ctx); err != nil {
+		t.Fatalf("GetConfig through proxy: %v", err)
+	}
+	if n := tunnels.Load(); n != 1 {
+		t.Fatalf("expected 1 proxy tunnel, got %d", n)
+	}
+}
+
+func TestWSClient_InvalidProxyURL(t *testing.T) {
+	_, err := NewWSClient(context.Background(), WSClientConfig{
+		URL:      "ws://127.0.0.1:1",
+		ProxyURL: "ftp://proxy.example.com",
+	})
+	if err == nil || !strings.Contains(err.Error(), "unsupported proxy scheme") {
+		t.Fatalf("expected unsupported scheme error, got %v", err)
+	}
+}
+
+func TestProxyFunc_Environment(t *testing.T) {
+	for _, k := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy", "NO_PROXY", "no_proxy", "ALL_PROXY", "all_proxy"} {
+		t.Setenv(k, "")
+	}
+	req := func(rawURL string) *http.Request {
+		r, err := http.NewRequest(http.MethodGet, rawURL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	fn, err := proxyFunc(WSClientConfig{})
+	if err != nil || fn != nil {
+		t.Fatalf("expected no proxy without environment, got fn=%v err=%v", fn != nil, err)
+	}
+
+	t.Setenv("ALL_PROXY", "socks5h://bastion.example.com:1080")
+	t.Setenv("NO_PROXY", "internal.example.com")
+	fn, err = proxyFunc(WSClientConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := fn(req("https://gateway.example.com/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u == nil || u.String() != "socks5://bastion.example.com:1080" {
+		t.Fatalf("expected ALL_PROXY fallback, got %v", u)
+	}
+
+	u, err = fn(req("https://internal.example.com/"))
+	if err != nil || u != nil {
+		t.Fatalf("expected NO_PROXY host to bypass proxy, got %v, %v", u, err)
+	}
+
+	t.Setenv("HTTPS_PROXY", "http://egress.example.com:3128")
+	fn, err = proxyFunc(WSClientConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err = fn(req("https://gateway.example.com/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u == nil || u.Host != "egress.example.com:3128" {
+		t.Fatalf("expected HTTPS_PROXY to win over ALL_PROXY, got %v", u)
+	}
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newReconnectClient(t *testing.T, g *fakeGateway) *WSClient {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c, err := NewWSClient(ctx, WSClientConfig{URL: g.URL(), Token: "tok"})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestWSClient_ReplaysReadsAfterRestart(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"a":1}`)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	g.restartOn("config.get", 1)
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if cfg.Raw != `{"a":1}` {
+		t.Errorf("raw = %s", cfg.Raw)
+	}
+
+	g.restartOn("health", 1)
+	if _, err := c.Health(ctx); err != nil {
+		t.Fatalf("Health: %v", err)
+	}
+
+	if n := g.callCount("connect"); n != 3 {
+		t.Errorf("connect calls = %d, want 3", n)
+	}
+}
+
+func TestWSClient_ReplaysListRPCsAfterRestart(t *testing.T) {
+	for _, method := range []string{"sessions.list", "cron.list", "plugins.list"} {
+		t.Run(method, func(t *testing.T) {
+			g := newFakeGateway(t)
+			g.handle(method, func(map[string]any) (any, any) {
+				return map[string]any{"items": []any{}}, nil
+			})
+			c := newReconnectClient(t, g)
+
+			g.restartOn(method, 1)
+			if _, err := c.Call(context.Background(), method, map[string]any{}); err != nil {
+				t.Fatalf("Call: %v", err)
+			}
+			if n := g.callCount(method); n != 2 {
+				t.Errorf("%s calls = %d, want 2", method, n)
+			}
+		})
+	}
+}
+
+func TestWSClient_ReconnectsAfterPatchRestart(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"a":1}`)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+
+	// The gateway restarts before applying the patch. The config is
+	// unchanged, so the client sends the patch again.
+	applied := false
+	g.handle("config.patch", func(map[string]any) (any, any) {
+		if applied {
+			g.setRaw(`{"a":1,"b":2}`)
+		}
+		applied = true
+		return map[string]any{"ok": true}, nil
+	})
+	g.restartOn("config.patch", 1)
+	if err := c.PatchConfig(ctx, map[string]any{"b": 2}, cfg.Hash); err != nil {
+		t.Fatalf("PatchConfig: %v", err)
+	}
+	if got := g.getRaw(); got != `{"a":1,"b":2}` {
+		t.Errorf("raw = %s", got)
+	}
+	if n := g.callCount("config.patch"); n != 2 {
+		t.Errorf("config.patch calls = %d, want 2", n)
+	}
+
+	// The next resource in the apply sees a healthy connection.
+	if _, err := c.GetConfig(ctx); err != nil {
+		t.Fatalf("GetConfig after restart: %v", err)
+	}
+}
+
+func TestWSClient_PatchRestartAfterWriteIsNotResent(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"a":1}`)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+
+	// The patch lands, then the gateway restarts before replying. The config
+	// has changed, so the client cannot tell whether the patch was applied
+	// and reports the lost connection instead of sending it again.
+	g.restartOn("config.patch", 1)
+	err = c.PatchConfig(ctx, map[string]any{"b": 2}, cfg.Hash)
+	if !errors.Is(err, ErrConnectionLost) {
+		t.Fatalf("err = %v, want connection lost", err)
+	}
+	if got := g.getRaw(); got != `{"a":1,"b":2}` {
+		t.Errorf("raw = %s", got)
+	}
+	if n := g.callCount("config.patch"); n != 1 {
+		t.Errorf("config.patch calls = %d, want 1", n)
+	}
+}
+
+func TestWSClient_ReconnectsBeforeNextCall(t *testing.T) {
+	g := newFakeGateway(t)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	// config.apply is not replayed, but the following call must not fail
+	// just because the gateway restarted in between.
+	g.restartOn("config.apply", 1)
+	err := c.ApplyConfig(ctx, `{"x":true}`, "")
+	if err == nil || !strings.Contains(err.Error(), "connection closed") {
+		t.Fatalf("ApplyConfig: expected connection closed, got %v", err)
+	}
+	if n := g.callCount("config.apply"); n != 1 {
+		t.Errorf("config.apply calls = %d, want 1", n)
+	}
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if cfg.Raw != `{"x":true}` {
+		t.Errorf("raw = %s", cfg.Raw)
+	}
+}
+
+func TestWSClient_NoReconnectAfterClose(t *testing.T) {
+	g := newFakeGateway(t)
+	c := newReconnectClient(t, g)
+	c.Close()
+
+	if _, err := c.GetConfig(context.Background()); err == nil {
+		t.Fatal("expected error after Close")
+	}
+	if n := g.callCount("connect"); n != 1 {
+		t.Errorf("connect calls = %d, want 1", n)
+	}
+}
+
+func TestWSClient_FailsAllPendingCallsWhenConnectionDrops(t *testing.T) {
+	g := newFakeGateway(t)
+	release := make(chan struct{})
+	g.handle("agent.run", func(map[string]any) (any, any) {
+		<-release
+		return map[string]any{"ok": true}, nil
+	})
+	g.restartOn("agent.run", 1)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	const callers = 3
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			_, err := c.Call(ctx, "agent.run", nil)
+			errs <- err
+		}()
+	}
+	// Wait until every request is on the wire, then let the gateway drop
+	// the connection while they are all unanswered.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		c.mu.Lock()
+		s := c.sess
+		c.mu.Unlock()
+		s.mu.Lock()
+		n := len(s.pending)
+		s.mu.Unlock()
+		if n == callers {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("pending requests = %d, want %d", n, callers)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	close(release)
+
+	for i := 0; i < callers; i++ {
+		select {
+		case err := <-errs:
+			var lost *ConnectionLostError
+			if !errors.As(err, &lost) || !errors.Is(err, ErrConnectionLost) {
+				t.Fatalf("err = %v, want ConnectionLostError", err)
+			}
+			if lost.Method != "agent.run" {
+				t.Errorf("Method = %q, want agent.run", lost.Method)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("caller still blocked after the connection dropped")
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ReloadWaitClient holds back further gateway calls after each write until
+// the gateway is healthy again. A patch to a gateway with reload_mode
+// "restart" drops the connection while the gateway restarts, and without it
+// the resources applied next fail against a gateway that is not back yet.
+//
+// Writes take the lock exclusively and keep it while Health is polled;
+// reads share it, so they wait for a restart in progress but not for each
+// other.
+type ReloadWaitClient struct {
+	Client
+	timeout time.Duration
+
+	mu sync.RWMutex
+}
+
+// NewReloadWaitClient wraps c so each write waits up to timeout for the
+// gateway to report healthy before it returns.
+func NewReloadWaitClient(c Client, timeout time.Duration) *ReloadWaitClient {
+	return &ReloadWaitClient{Client: c, timeout: timeout}
+}
+
+// afterWrite waits for the gateway once a write has been accepted. A write
+// the gateway rejected did not reload anything.
+func (r *ReloadWaitClient) afterWrite(ctx context.Context, err error) error {
+	if err != nil {
+		return err
+	}
+	if err := WaitHealthy(ctx, r.Client, r.timeout); err != nil {
+		return fmt.Errorf("config written, but the gateway did not come back: %w", err)
+	}
+	return nil
+}
+
+// PatchConfig implements Client.
+func (r *ReloadWaitClient) PatchConfig(ctx context.Context, patch map[string]any, baseHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.afterWrite(ctx, r.Client.PatchConfig(ctx, patch, baseHash))
+}
+
+// ApplyConfig implements Client.
+func (r *ReloadWaitClient) ApplyConfig(ctx context.Context, raw string, baseHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.afterWrite(ctx, r.Client.ApplyConfig(ctx, raw, baseHash))
+}
+
+// ImportConfig implements Client.
+func (r *ReloadWaitClient) ImportConfig(ctx context.Context, raw string, baseHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.afterWrite(ctx, r.Client.ImportConfig(ctx, raw, baseHash))
+}
+
+// Call implements Client. RPCs that may change the config are treated as
+// writes.
+func (r *ReloadWaitClient) Call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	if replayable(method) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		return r.Client.Call(ctx, method, params)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out, err := r.Client.Call(ctx, method, params)
+	if err := r.afterWrite(ctx, err); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetConfig implements Client.
+func (r *ReloadWaitClient) GetConfig(ctx context.Context) (*ConfigPayload, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Client.GetConfig(ctx)
+}
+
+// GetConfigSection implements Client.
+func (r *ReloadWaitClient) GetConfigSection(ctx context.Context, keys ...string) (*SectionPayload, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Client.GetConfigSection(ctx, keys...)
+}
+
+// ExportConfig implements Client.
+func (r *ReloadWaitClient) ExportConfig(ctx context.Context) (*ConfigPayload, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Client.ExportConfig(ctx)
+}
+
+// Health implements Client.
+func (r *ReloadWaitClient) Health(ctx context.Context) (*HealthPayload, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Client.Health(ctx)
+}
+
+// Unwrap returns the wrapped client.
+func (r *ReloadWaitClient) Unwrap() Client { return r.Client }
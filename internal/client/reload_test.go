@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReloadWaitClient_WaitsForHealthAfterWrite(t *testing.T) {
+	orig := healthPollInterval
+	healthPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { healthPollInterval = orig })
+
+	g := newFakeGateway(t)
+	g.setRaw(`{"a":1}`)
+	c := NewReloadWaitClient(newReconnectClient(t, g), 2*time.Second)
+	ctx := context.Background()
+
+	// Restarting: the first checks after the patch fail.
+	checks := 0
+	g.handle("health", func(map[string]any) (any, any) {
+		checks++
+		if checks < 3 {
+			return nil, map[string]any{"code": "UNAVAILABLE", "message": "restarting"}
+		}
+		return map[string]any{"ok": true}, nil
+	})
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if err := c.PatchConfig(ctx, map[string]any{"b": 2}, cfg.Hash); err != nil {
+		t.Fatalf("PatchConfig: %v", err)
+	}
+	if checks != 3 {
+		t.Errorf("health checks = %d, want 3", checks)
+	}
+}
+
+func TestReloadWaitClient_ReportsGatewayThatStaysDown(t *testing.T) {
+	orig := healthPollInterval
+	healthPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { healthPollInterval = orig })
+
+	g := newFakeGateway(t)
+	g.setRaw(`{"a":1}`)
+	c := NewReloadWaitClient(newReconnectClient(t, g), 100*time.Millisecond)
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	g.handle("health", func(map[string]any) (any, any) {
+		return map[string]any{"ok": false}, nil
+	})
+	err = c.PatchConfig(ctx, map[string]any{"b": 2}, cfg.Hash)
+	if err == nil || !strings.Contains(err.Error(), "gateway did not come back") {
+		t.Fatalf("err = %v, want gateway did not come back", err)
+	}
+}
+
+func TestReloadWaitClient_SkipsWaitForRejectedWrite(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("config.patch", func(map[string]any) (any, any) {
+		return nil, map[string]any{"code": "INVALID", "message": "bad value"}
+	})
+	c := NewReloadWaitClient(newReconnectClient(t, g), time.Second)
+
+	if err := c.PatchConfig(context.Background(), map[string]any{"b": 2}, "h"); err == nil {
+		t.Fatal("PatchConfig succeeded, want error")
+	}
+	if n := g.callCount("health"); n != 0 {
+		t.Errorf("health calls = %d, want 0", n)
+	}
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWSClient_RoleAndScopes(t *testing.T) {
+	g := newFakeGateway(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{URL: g.URL()})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	c.Close()
+	if got := g.lastConnect()["role"]; got != DefaultRole {
+		t.Errorf("default role = %v", got)
+	}
+	if got := fmt.Sprint(g.lastConnect()["scopes"]); got != fmt.Sprint(DefaultScopes) {
+		t.Errorf("default scopes = %v", got)
+	}
+
+	c, err = NewWSClient(ctx, WSClientConfig{URL: g.URL(), Role: "operator", Scopes: []string{"operator.read"}})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	c.Close()
+	if got := fmt.Sprint(g.lastConnect()["scopes"]); got != "[operator.read]" {
+		t.Errorf("scopes = %v", got)
+	}
+}
+
+func TestWSClient_ScopeDeniedOnCall(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("config.patch", func(map[string]any) (any, any) {
+		return nil, map[string]any{"code": "FORBIDDEN", "message": "missing scope: operator.write"}
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{URL: g.URL(), Scopes: []string{"operator.read"}})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	defer c.Close()
+
+	err = c.PatchConfig(ctx, map[string]any{"a": 1}, "")
+	var scopeErr *ScopeError
+	if !errors.As(err, &scopeErr) {
+		t.Fatalf("expected ScopeError, got %v", err)
+	}
+	for _, want := range []string{"config.patch denied", "scopes operator.read", "missing scope: operator.write", "provider scopes setting"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestWSClient_ScopeDeniedOnConnect(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("connect", func(map[string]any) (any, any) {
+		return nil, map[string]any{"code": "FORBIDDEN", "message": "scope operator.admin not allowed for this token"}
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	retries := 3
+	_, err := NewWSClient(ctx, WSClientConfig{URL: g.URL(), ConnectRetries: &retries})
+	var scopeErr *ScopeError
+	if !errors.As(err, &scopeErr) || scopeErr.Method != "connect" {
+		t.Fatalf("expected connect ScopeError, got %v", err)
+	}
+	if n := g.callCount("connect"); n != 1 {
+		t.Errorf("connect attempts = %d, want 1 (scope errors are not retried)", n)
+	}
+}
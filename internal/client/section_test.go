@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestWSClient_GetConfigSectionRequestsSection(t *testing.T) {
+	g := newFakeGateway(t)
+	var asked any
+	g.handle("config.get", func(params map[string]any) (any, any) {
+		asked = params["sections"]
+		return map[string]any{
+			"hash":     "h1",
+			"sections": map[string]any{"channels.telegram": map[string]any{"enabled": true}},
+		}, nil
+	})
+	c := newReconnectClient(t, g)
+
+	m, hash, err := GetNestedSection(context.Background(), c, "channels", "telegram")
+	if err != nil {
+		t.Fatalf("GetNestedSection: %v", err)
+	}
+	if !reflect.DeepEqual(asked, []any{"channels.telegram"}) {
+		t.Errorf("sections = %v", asked)
+	}
+	if hash != "h1" || m["enabled"] != true {
+		t.Errorf("section = %v, hash %q", m, hash)
+	}
+}
+
+func TestWSClient_GetConfigSectionFallsBackToFullConfig(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"channels":{"telegram":{"enabled":true}}}`)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	// The fake gateway ignores sections and returns the whole config.
+	for range 2 {
+		m, hash, err := GetNestedSection(ctx, c, "channels", "telegram")
+		if err != nil {
+			t.Fatalf("GetNestedSection: %v", err)
+		}
+		if m["enabled"] != true || hash != hashBytes([]byte(g.getRaw())) {
+			t.Errorf("section = %v, hash %q", m, hash)
+		}
+	}
+	if !c.noSections.Load() {
+		t.Error("client still asks for sections after the gateway ignored them")
+	}
+
+	m, _, err := GetNestedSection(ctx, c, "channels", "slack")
+	if err != nil || m != nil {
+		t.Errorf("missing section = %v, %v; want nil, nil", m, err)
+	}
+}
+
+func TestWSClient_GetConfigSectionRetriesOnInvalidParams(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"gateway":{"port":18789}}`)
+	g.handle("config.get", func(params map[string]any) (any, any) {
+		if _, ok := params["sections"]; ok {
+			return nil, map[string]any{"code": "INVALID_PARAMS", "message": "unexpected property sections"}
+		}
+		raw := g.getRaw()
+		return map[string]any{"raw": raw, "hash": hashBytes([]byte(raw))}, nil
+	})
+	c := newReconnectClient(t, g)
+
+	m, _, err := GetSection(context.Background(), c, "gateway")
+	if err != nil {
+		t.Fatalf("GetSection: %v", err)
+	}
+	if m["port"] != float64(18789) {
+		t.Errorf("section = %v", m)
+	}
+	if n := g.callCount("config.get"); n != 2 {
+		t.Errorf("config.get calls = %d, want 2", n)
+	}
+}
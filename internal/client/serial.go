@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// SerialClient writes config one change at a time. The gateway checks each
+// write's baseHash, but Terraform applies independent resources in parallel,
+// so without it one resource's patch makes every other in-flight patch
+// stale and the gateway rejects them.
+//
+// Writes are queued on a mutex. A patch whose baseHash was superseded by a
+// write made through this client is rebased onto the current hash before
+// it is sent: resources patch only their own sections, so the earlier write
+// cannot conflict with it. Patches holding a list are the exception. Lists
+// such as agents.list and bindings are rebuilt whole from the config the
+// resource read, so a rebased list would drop the earlier write's entry;
+// they are sent against their own hash and fail if it is stale. Other
+// hashes are sent as they are, leaving conflicts with changes made outside
+// Terraform to the wrapped client.
+type SerialClient struct {
+	Client
+
+	mu         sync.Mutex
+	superseded map[string]bool // hashes replaced by writes through this client
+}
+
+// NewSerialClient wraps c so its writes are made one at a time.
+func NewSerialClient(c Client) *SerialClient {
+	return &SerialClient{Client: c, superseded: make(map[string]bool)}
+}
+
+// PatchConfig implements Client.
+func (s *SerialClient) PatchConfig(ctx context.Context, patch map[string]any, baseHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.superseded[baseHash] && !containsList(patch) {
+		cfg, err := s.Client.GetConfig(ctx)
+		if err != nil {
+			return err
+		}
+		baseHash = cfg.Hash
+	}
+	if err := s.Client.PatchConfig(ctx, patch, baseHash); err != nil {
+		return err
+	}
+	s.superseded[baseHash] = true
+	return nil
+}
+
+// ApplyConfig implements Client. A replacement config is never rebased, as
+// it would discard the writes made since it was read.
+func (s *SerialClient) ApplyConfig(ctx context.Context, raw string, baseHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.Client.ApplyConfig(ctx, raw, baseHash); err != nil {
+		return err
+	}
+	if baseHash != "" {
+		s.superseded[baseHash] = true
+	}
+	return nil
+}
+
+// ImportConfig implements Client. Like ApplyConfig, it is never rebased.
+func (s *SerialClient) ImportConfig(ctx context.Context, raw string, baseHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.Client.ImportConfig(ctx, raw, baseHash); err != nil {
+		return err
+	}
+	if baseHash != "" {
+		s.superseded[baseHash] = true
+	}
+	return nil
+}
+
+// Unwrap returns the wrapped client.
+func (s *SerialClient) Unwrap() Client { return s.Client }
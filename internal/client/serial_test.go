@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestSerialClient_ParallelPatchesAllLand(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{}`)
+	c := NewSerialClient(newReconnectClient(t, g))
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+
+	// Every resource read the same config before any of them wrote.
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = c.PatchConfig(ctx, map[string]any{fmt.Sprintf("k%d", i): i}, cfg.Hash)
+		}()
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("patch %d: %v", i, err)
+		}
+	}
+
+	if got, want := g.getRaw(), `{"k0":0,"k1":1,"k2":2,"k3":3,"k4":4}`; got != want {
+		t.Errorf("raw = %s, want %s", got, want)
+	}
+	// Each patch is rebased before it is sent, so none is rejected.
+	if n := g.callCount("config.patch"); n != 5 {
+		t.Errorf("config.patch calls = %d, want 5", n)
+	}
+}
+
+func TestSerialClient_ListPatchIsNotRebased(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"bindings":[]}`)
+	c := NewSerialClient(newReconnectClient(t, g))
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	// Two resources each add their binding to the list they read.
+	if err := c.PatchConfig(ctx, map[string]any{"bindings": []any{"a"}}, cfg.Hash); err != nil {
+		t.Fatalf("first PatchConfig: %v", err)
+	}
+	if err := c.PatchConfig(ctx, map[string]any{"bindings": []any{"b"}}, cfg.Hash); err == nil {
+		t.Error("second PatchConfig succeeded, want conflict")
+	}
+	if got := g.getRaw(); got != `{"bindings":["a"]}` {
+		t.Errorf("raw = %s", got)
+	}
+}
+
+func TestSerialClient_ApplyIsNotRebased(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"a":1}`)
+	var sent string
+	g.handle("config.apply", func(params map[string]any) (any, any) {
+		sent, _ = params["baseHash"].(string)
+		return nil, map[string]any{"code": "CONFLICT", "message": "config changed since last load; baseHash mismatch"}
+	})
+	c := NewSerialClient(newReconnectClient(t, g))
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if err := c.PatchConfig(ctx, map[string]any{"b": 2}, cfg.Hash); err != nil {
+		t.Fatalf("PatchConfig: %v", err)
+	}
+	// Applying the config read before the patch would drop the patch.
+	if err := c.ApplyConfig(ctx, `{"c":3}`, cfg.Hash); err == nil {
+		t.Error("ApplyConfig succeeded, want conflict")
+	}
+	if sent != cfg.Hash {
+		t.Errorf("apply sent hash %q, want the caller's %q", sent, cfg.Hash)
+	}
+}
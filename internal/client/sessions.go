@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Session is a live conversation session, as returned by sessions.list.
+// Timestamps are in milliseconds since the Unix epoch.
+type Session struct {
+	Key          string       `json:"key"`
+	AgentID      string       `json:"agentId,omitempty"`
+	Channel      string       `json:"channel,omitempty"`
+	Peer         *SessionPeer `json:"peer,omitempty"`
+	CreatedAt    int64        `json:"createdAt,omitempty"`
+	LastActiveAt int64        `json:"lastActiveAt,omitempty"`
+	MessageCount int64        `json:"messageCount"`
+}
+
+// SessionPeer is who a session is with: a direct message sender or a group.
+type SessionPeer struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+}
+
+// ListSessions returns the sessions the gateway currently holds.
+func ListSessions(ctx context.Context, c Client) ([]Session, error) {
+	payload, err := c.Call(ctx, "sessions.list", nil)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Sessions []Session `json:"sessions"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("decode sessions.list response: %w", err)
+	}
+	return out.Sessions, nil
+}
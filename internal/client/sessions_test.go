@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListSessions(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("sessions.list", func(map[string]any) (any, any) {
+		return map[string]any{"sessions": []any{
+			map[string]any{
+				"key":          "agent:main:telegram:dm:42",
+				"agentId":      "main",
+				"channel":      "telegram",
+				"peer":         map[string]any{"kind": "dm", "id": "42"},
+				"createdAt":    1791000000000,
+				"lastActiveAt": 1791003600000,
+				"messageCount": 12,
+			},
+			map[string]any{"key": "agent:main:main", "agentId": "main"},
+		}}, nil
+	})
+	c := newReconnectClient(t, g)
+
+	sessions, err := ListSessions(context.Background(), c)
+	if err != nil {
+		t.Fatalf("ListSessions: %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("got %d sessions, want 2", len(sessions))
+	}
+	s := sessions[0]
+	if s.Channel != "telegram" || s.Peer == nil || s.Peer.ID != "42" || s.LastActiveAt != 1791003600000 || s.MessageCount != 12 {
+		t.Errorf("session = %+v", s)
+	}
+	if sessions[1].Peer != nil || sessions[1].CreatedAt != 0 {
+		t.Errorf("main session = %+v", sessions[1])
+	}
+}
+
+func TestListSessions_FileMode(t *testing.T) {
+	c, err := NewFileClient(t.TempDir() + "/openclaw.json")
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	if _, err := ListSessions(context.Background(), c); err == nil {
+		t.Fatal("ListSessions succeeded in file mode")
+	}
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// startTestSSHServer runs a minimal SSH server that accepts the given client
+// key and forwards direct-tcpip channels, which is all an SSH tunnel needs.
+// It returns the listen address and the server's host key in
+// authorized_keys format.
+func startTestSSHServer(t *testing.T, clientKey ssh.PublicKey) (string, string) {
+	t.Helper()
+
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serverConfig := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if string(key.Marshal()) == string(clientKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, io.EOF
+		},
+	}
+	serverConfig.AddHostKey(hostSigner)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			nConn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSSHConn(nConn, serverConfig)
+		}
+	}()
+
+	return ln.Addr().String(), string(ssh.MarshalAuthorizedKey(hostSigner.PublicKey()))
+}
+
+func serveTestSSHConn(nConn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(nConn, config)
+	if err != nil {
+		nConn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newCh := range chans {
+		if newCh.ChannelType() != "direct-tcpip" {
+			newCh.Reject(ssh.UnknownChannelType, "unsupported")
+			continue
+		}
+		var target struct {
+			Host     string
+			Port     uint32
+			OrigHost string
+			OrigPort uint32
+		}
+		if err := ssh.Unmarshal(newCh.ExtraData(), &target); err != nil {
+			newCh.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+		upstream, err := net.Dial("tcp", net.JoinHostPort(target.Host, strconv.Itoa(int(target.Port))))
+		if err != nil {
+			newCh.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+		ch, chReqs, err := newCh.Accept()
+		if err != nil {
+			upstream.Close()
+			continue
+		}
+		go ssh.DiscardRequests(chReqs)
+		go func() {
+			defer ch.Close()
+			defer upstream.Close()
+			go io.Copy(upstream, ch)
+			io.Copy(ch, upstream)
+		}()
+	}
+}
+
+func newTestSSHKey(t *testing.T) (string, ssh.PublicKey) {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(block)), signer.PublicKey()
+}
+
+func TestWSClient_SSHTunnel(t *testing.T) {
+	g := newFakeGateway(t)
+	keyPEM, pub := newTestSSHKey(t)
+	sshAddr, hostKey := startTestSSHServer(t, pub)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{
+		URL: g.URL(),
+		SSH: &SSHTunnelConfig{
+			Host:       sshAddr,
+			User:       "openclaw",
+			PrivateKey: keyPEM,
+			HostKey:    hostKey,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewWSClient through SSH tunnel: %v", err)
+	}
+	defer c.Close()
+
+	if c.tunnel == nil {
+		t.Fatal("expected client to hold the SSH tunnel")
+	}
+	if _, err := c.GetConfig(ctx); err != nil {
+		t.Fatalf("GetConfig through SSH tunnel: %v", err)
+	}
+}
+
+func TestWSClient_SSHTunnel_HostKeyMismatch(t *testing.T) {
+	g := newFakeGateway(t)
+	keyPEM, pub := newTestSSHKey(t)
+	sshAddr, _ := startTestSSHServer(t, pub)
+	_, otherHostKey := startTestSSHServer(t, pub)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := NewWSClient(ctx, WSClientConfig{
+		URL: g.URL(),
+		SSH: &SSHTunnelConfig{
+			Host:       sshAddr,
+			User:       "openclaw",
+			PrivateKey: keyPEM,
+			HostKey:    otherHostKey,
+		},
+	})
+	if err == nil {
+		t.Fatal("expected host key mismatch error")
+	}
+}
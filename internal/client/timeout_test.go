@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWSClient_RPCTimeout(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("config.get", func(map[string]any) (any, any) {
+		time.Sleep(500 * time.Millisecond)
+		return map[string]any{"raw": "{}", "hash": hashBytes([]byte("{}"))}, nil
+	})
+	g.handle("config.apply", func(map[string]any) (any, any) {
+		time.Sleep(300 * time.Millisecond)
+		return map[string]any{"ok": true}, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{
+		URL:          g.URL(),
+		RPCTimeout:   100 * time.Millisecond,
+		ApplyTimeout: 2 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	defer c.Close()
+
+	_, err = c.GetConfig(ctx)
+	if err == nil || !strings.Contains(err.Error(), "config.get timed out after 100ms") {
+		t.Fatalf("expected config.get timeout, got %v", err)
+	}
+
+	// config.apply gets the longer timeout because it may restart the gateway.
+	if err := c.ApplyConfig(ctx, "{}", ""); err != nil {
+		t.Fatalf("ApplyConfig within apply timeout: %v", err)
+	}
+}
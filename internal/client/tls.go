@@ -0,0 +1,45 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+)
+
+// buildTLSConfig returns the TLS settings used when dialing wss:// URLs.
+// It returns nil when no custom settings are configured so the dialer falls
+// back to Go's defaults (system roots, full verification).
+func buildTLSConfig(cfg WSClientConfig) (*tls.Config, error) {
+	if cfg.CACertPEM == "" && !cfg.InsecureSkipVerify && cfg.ClientCertPEM == "" && cfg.ClientKeyPEM == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return nil, fmt.Errorf("ca certificate: no valid PEM certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertPEM != "" || cfg.ClientKeyPEM != "" {
+		if cfg.ClientCertPEM == "" || cfg.ClientKeyPEM == "" {
+			return nil, fmt.Errorf("client certificate: both certificate and key must be set")
+		}
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
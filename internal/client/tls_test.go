@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testCACertPEM(g *fakeGateway) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: g.srv.Certificate().Raw}))
+}
+
+func TestWSClient_TLS_CustomCA(t *testing.T) {
+	g := newFakeTLSGateway(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{
+		URL:       g.URL(),
+		CACertPEM: testCACertPEM(g),
+	})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	defer c.Close()
+
+	if _, err := c.GetConfig(ctx); err != nil {
+		t.Fatalf("GetConfig over wss: %v", err)
+	}
+}
+
+func TestWSClient_TLS_UntrustedCertificate(t *testing.T) {
+	g := newFakeTLSGateway(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	_, err := NewWSClient(ctx, WSClientConfig{URL: g.URL()})
+	if err == nil {
+		t.Fatal("expected certificate verification error, got nil")
+	}
+}
+
+func TestWSClient_TLS_InsecureSkipVerify(t *testing.T) {
+	g := newFakeTLSGateway(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{
+		URL:                g.URL(),
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	defer c.Close()
+}
+
+// newTestClientCert issues a client certificate signed by a throwaway CA and
+// returns the CA pool plus the PEM-encoded certificate and key.
+func newTestClientCert(t *testing.T) (*x509.CertPool, string, string) {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, _ := x509.ParseCertificate(caDER)
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTmpl, caCert, &clientKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return pool, string(certPEM), string(keyPEM)
+}
+
+func newFakeMTLSGateway(t *testing.T, clientCAs *x509.CertPool) *fakeGateway {
+	t.Helper()
+	g := &fakeGateway{raw: "{}", handlers: map[string]func(map[string]any) (any, any){}}
+	g.srv = httptest.NewUnstartedServer(http.HandlerFunc(g.serveWS))
+	g.srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	g.srv.StartTLS()
+	t.Cleanup(g.srv.Close)
+	return g
+}
+
+func TestWSClient_MTLS_ClientCertificate(t *testing.T) {
+	pool, certPEM, keyPEM := newTestClientCert(t)
+	g := newFakeMTLSGateway(t, pool)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{
+		URL:           g.URL(),
+		CACertPEM:     testCACertPEM(g),
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	})
+	if err != nil {
+		t.Fatalf("NewWSClient with client certificate: %v", err)
+	}
+	defer c.Close()
+
+	if _, err := c.Health(ctx); err != nil {
+		t.Fatalf("Health over mTLS: %v", err)
+	}
+}
+
+func TestWSClient_MTLS_MissingClientCertificate(t *testing.T) {
+	pool, _, _ := newTestClientCert(t)
+	g := newFakeMTLSGateway(t, pool)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	_, err := NewWSClient(ctx, WSClientConfig{
+		URL:       g.URL(),
+		CACertPEM: testCACertPEM(g),
+	})
+	if err == nil {
+		t.Fatal("expected handshake failure without a client certificate")
+	}
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+	cfg, err := buildTLSConfig(WSClientConfig{URL: "wss://example.com"})
+	if err != nil {
+		t.Fatalf("buildTLSConfig: %v", err)
+	}
+	if cfg != nil {
+		t.Error("expected nil TLS config when no TLS options are set")
+	}
+
+	if _, err := buildTLSConfig(WSClientConfig{CACertPEM: "not a certificate"}); err == nil {
+		t.Error("expected error for invalid CA PEM")
+	}
+
+	_, certPEM, keyPEM := newTestClientCert(t)
+	if _, err := buildTLSConfig(WSClientConfig{ClientCertPEM: certPEM}); err == nil {
+		t.Error("expected error when client key is missing")
+	}
+	cfg, err = buildTLSConfig(WSClientConfig{ClientCertPEM: certPEM, ClientKeyPEM: keyPEM})
+	if err != nil {
+		t.Fatalf("buildTLSConfig with client certificate: %v", err)
+	}
+	if len(cfg.Certificates) != 1 {
+		t.Errorf("expected 1 client certificate, got %d", len(cfg.Certificates))
+	}
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Usage is the spend the gateway has recorded for the current budget
+// period, as returned by usage.status.
+type Usage struct {
+	Period   string             `json:"period"`
+	TotalUSD float64            `json:"totalUsd"`
+	Agents   map[string]float64 `json:"agents,omitempty"`
+	Channels map[string]float64 `json:"channels,omitempty"`
+}
+
+// GetUsage returns the spend recorded for the current budget period.
+func GetUsage(ctx context.Context, c Client) (*Usage, error) {
+	payload, err := c.Call(ctx, "usage.status", nil)
+	if err != nil {
+		return nil, err
+	}
+	var out Usage
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("decode usage.status response: %w", err)
+	}
+	return &out, nil
+}
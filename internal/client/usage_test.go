@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetUsage(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("usage.status", func(map[string]any) (any, any) {
+		return map[string]any{
+			"period":   "2026-10",
+			"totalUsd": 42.5,
+			"agents":   map[string]any{"main": 40.0, "research": 2.5},
+		}, nil
+	})
+	c := newReconnectClient(t, g)
+
+	u, err := GetUsage(context.Background(), c)
+	if err != nil {
+		t.Fatalf("GetUsage: %v", err)
+	}
+	if u.Period != "2026-10" || u.TotalUSD != 42.5 || u.Agents["research"] != 2.5 {
+		t.Errorf("usage = %+v", u)
+	}
+	if u.Channels != nil {
+		t.Errorf("channels = %v, want nil", u.Channels)
+	}
+}
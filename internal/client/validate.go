@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ValidationIssue is one problem the gateway found in a config.
+type ValidationIssue struct {
+	// Path is the location of the offending value, as config keys from
+	// the root, e.g. ["channels", "telegram", "dmPolicy"]. Empty when the
+	// issue is not about a single value.
+	Path    []string
+	Message string
+}
+
+// ValidationError reports that the gateway rejected a config before it was
+// written.
+type ValidationError struct {
+	Method string
+	Issues []ValidationIssue
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Issues))
+	for i, is := range e.Issues {
+		if len(is.Path) == 0 {
+			msgs[i] = is.Message
+		} else {
+			msgs[i] = strings.Join(is.Path, ".") + ": " + is.Message
+		}
+	}
+	return fmt.Sprintf("%s rejected by config.validate: %s", e.Method, strings.Join(msgs, "; "))
+}
+
+// validate asks the gateway to check a write before it is made, so that a
+// typo such as dmPolicy = "allowlst" fails the apply instead of being
+// written. Gateways without config.validate are remembered and skipped.
+// The check is advisory: if it cannot run, the write goes ahead and the
+// gateway's own checks still apply.
+func (c *WSClient) validate(ctx context.Context, method string, params map[string]any) error {
+	if c.noValidate.Load() {
+		return nil
+	}
+	vparams := map[string]any{"mode": strings.TrimPrefix(method, "config.")}
+	for k, v := range params {
+		vparams[k] = v
+	}
+
+	resp, err := c.call(ctx, "config.validate", vparams)
+	if err != nil {
+		tflog.Debug(ctx, "config.validate unavailable, writing without it", map[string]any{"error": err.Error()})
+		return nil
+	}
+	if resp.OK == nil || !*resp.OK {
+		if issues := validationIssues(resp.Error); len(issues) > 0 {
+			return &ValidationError{Method: method, Issues: issues}
+		}
+		if unknownMethod(resp.Error) {
+			c.noValidate.Store(true)
+		}
+		tflog.Debug(ctx, "config.validate failed, writing without it", map[string]any{"error": fmt.Sprint(resp.Error)})
+		return nil
+	}
+	if issues := validationIssues(resp.Payload); len(issues) > 0 {
+		return &ValidationError{Method: method, Issues: issues}
+	}
+	return nil
+}
+
+// validationIssues extracts the issues from a config.validate payload or
+// error, which carries them as issues (or errors), optionally under details.
+func validationIssues(payload any) []ValidationIssue {
+	m, ok := payload.(map[string]any)
+	if !ok {
+		return nil
+	}
+	if details, ok := m["details"].(map[string]any); ok {
+		if issues := validationIssues(details); len(issues) > 0 {
+			return issues
+		}
+	}
+	list, ok := m["issues"].([]any)
+	if !ok {
+		list, _ = m["errors"].([]any)
+	}
+	var issues []ValidationIssue
+	for _, item := range list {
+		switch v := item.(type) {
+		case string:
+			issues = append(issues, ValidationIssue{Message: v})
+		case map[string]any:
+			msg, _ := v["message"].(string)
+			issues = append(issues, ValidationIssue{Path: issuePath(v["path"]), Message: msg})
+		}
+	}
+	if len(issues) == 0 {
+		if valid, ok := m["valid"].(bool); ok && !valid {
+			msg, _ := m["message"].(string)
+			if msg == "" {
+				msg = "config is invalid"
+			}
+			issues = append(issues, ValidationIssue{Message: msg})
+		}
+	}
+	return issues
+}
+
+// issuePath accepts a path as a dotted string or a list of keys and indexes.
+func issuePath(v any) []string {
+	switch p := v.(type) {
+	case string:
+		if p == "" {
+			return nil
+		}
+		return strings.Split(p, ".")
+	case []any:
+		keys := make([]string, 0, len(p))
+		for _, k := range p {
+			switch k := k.(type) {
+			case string:
+				keys = append(keys, k)
+			case float64:
+				keys = append(keys, strconv.FormatInt(int64(k), 10))
+			}
+		}
+		return keys
+	}
+	return nil
+}
+
+// unknownMethod reports whether a response error says the method does not
+// exist on this gateway.
+func unknownMethod(payload any) bool {
+	e, ok := payload.(map[string]any)
+	if !ok {
+		return false
+	}
+	code, _ := e["code"].(string)
+	msg, _ := e["message"].(string)
+	return strings.EqualFold(code, "METHOD_NOT_FOUND") || strings.Contains(strings.ToLower(msg), "unknown method")
+}
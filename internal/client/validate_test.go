@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestWSClient_ValidatesBeforePatch(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{}`)
+	var mode string
+	g.handle("config.validate", func(params map[string]any) (any, any) {
+		mode, _ = params["mode"].(string)
+		return nil, map[string]any{
+			"code":    "INVALID_CONFIG",
+			"message": "config is invalid",
+			"details": map[string]any{"issues": []any{
+				map[string]any{"path": "channels.telegram.dmPolicy", "message": `invalid value "allowlst"`},
+				map[string]any{"path": []any{"agents", "list", 0.0, "id"}, "message": "required"},
+			}},
+		}
+	})
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	err = c.PatchConfig(ctx, map[string]any{"channels": map[string]any{"telegram": map[string]any{"dmPolicy": "allowlst"}}}, cfg.Hash)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("err = %v, want *ValidationError", err)
+	}
+	want := []ValidationIssue{
+		{Path: []string{"channels", "telegram", "dmPolicy"}, Message: `invalid value "allowlst"`},
+		{Path: []string{"agents", "list", "0", "id"}, Message: "required"},
+	}
+	if !reflect.DeepEqual(verr.Issues, want) {
+		t.Errorf("issues = %+v, want %+v", verr.Issues, want)
+	}
+	if mode != "patch" {
+		t.Errorf("validate mode = %q, want patch", mode)
+	}
+	if n := g.callCount("config.patch"); n != 0 {
+		t.Errorf("config.patch calls = %d, want 0", n)
+	}
+}
+
+func TestWSClient_SkipsValidateWhenUnsupported(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{}`)
+	c := newReconnectClient(t, g)
+	ctx := context.Background()
+
+	for i := range 2 {
+		cfg, err := c.GetConfig(ctx)
+		if err != nil {
+			t.Fatalf("GetConfig: %v", err)
+		}
+		if err := c.PatchConfig(ctx, map[string]any{"n": i}, cfg.Hash); err != nil {
+			t.Fatalf("PatchConfig: %v", err)
+		}
+	}
+	// The fake gateway has no config.validate; it is only asked once.
+	if n := g.callCount("config.validate"); n != 1 {
+		t.Errorf("config.validate calls = %d, want 1", n)
+	}
+	if got := g.getRaw(); got != `{"n":1}` {
+		t.Errorf("raw = %s", got)
+	}
+}
+
+func TestWSClient_ValidPayloadAllowsApply(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("config.validate", func(map[string]any) (any, any) {
+		return map[string]any{"valid": true, "issues": []any{}}, nil
+	})
+	c := newReconnectClient(t, g)
+
+	if err := c.ApplyConfig(context.Background(), `{"a":1}`, ""); err != nil {
+		t.Fatalf("ApplyConfig: %v", err)
+	}
+	if got := g.getRaw(); got != `{"a":1}` {
+		t.Errorf("raw = %s", got)
+	}
+}
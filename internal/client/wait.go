@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// healthPollInterval is the delay between health checks in WaitHealthy.
+var healthPollInterval = time.Second
+
+// WaitHealthy polls Health until the gateway reports ok or timeout elapses.
+// Errors in between are expected while a freshly started gateway finishes
+// booting, so only the last one is reported.
+func WaitHealthy(ctx context.Context, c Client, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var last error
+	for {
+		health, err := c.Health(ctx)
+		switch {
+		case err != nil && ctx.Err() != nil && last != nil:
+			// Cut short by the deadline; the previous result says more.
+		case err != nil:
+			last = err
+		case health.OK:
+			return nil
+		default:
+			last = fmt.Errorf("gateway reports not ok")
+		}
+
+		select {
+		case <-time.After(healthPollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("gateway not healthy after %s: %w", timeout, last)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitHealthy(t *testing.T) {
+	orig := healthPollInterval
+	healthPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { healthPollInterval = orig })
+
+	g := newFakeGateway(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c, err := NewWSClient(ctx, WSClientConfig{URL: g.URL()})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	defer c.Close()
+
+	// Booting: an error, then not ok, then ok.
+	checks := 0
+	g.handle("health", func(map[string]any) (any, any) {
+		checks++
+		switch checks {
+		case 1:
+			return nil, map[string]any{"code": "UNAVAILABLE", "message": "starting"}
+		case 2:
+			return map[string]any{"ok": false}, nil
+		}
+		return map[string]any{"ok": true}, nil
+	})
+	if err := WaitHealthy(ctx, c, 2*time.Second); err != nil {
+		t.Fatalf("WaitHealthy: %v", err)
+	}
+	if checks != 3 {
+		t.Errorf("health checks = %d, want 3", checks)
+	}
+
+	g.handle("health", func(map[string]any) (any, any) {
+		return map[string]any{"ok": false}, nil
+	})
+	err = WaitHealthy(ctx, c, 100*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "gateway not healthy after 100ms: gateway reports not ok") {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+}
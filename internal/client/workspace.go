@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// defaultWorkspace is the workspace of agents that configure none, and of
+// agents.defaults when it sets none.
+const defaultWorkspace = "~/.openclaw/workspace"
+
+// WorkspaceFile is a file in an agent workspace, as returned by
+// agents.files.get.
+type WorkspaceFile struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	// Mode is the file's permission bits in octal (e.g. 0644). Empty when
+	// the gateway does not report it.
+	Mode    string `json:"mode,omitempty"`
+	Missing bool   `json:"missing,omitempty"`
+}
+
+// workspaceFileParams are the parameters of the agents.files RPCs. Name is
+// relative to the agent's workspace directory.
+type workspaceFileParams struct {
+	AgentID string  `json:"agentId"`
+	Name    string  `json:"name"`
+	Content *string `json:"content,omitempty"`
+	Mode    string  `json:"mode,omitempty"`
+}
+
+// GetWorkspaceFile returns a file from the workspace of the given agent, or
+// nil if there is no such file.
+func GetWorkspaceFile(ctx context.Context, c Client, agentID, name string) (*WorkspaceFile, error) {
+	payload, err := c.Call(ctx, "agents.files.get", workspaceFileParams{AgentID: agentID, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		File *WorkspaceFile `json:"file"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("decode agents.files.get response: %w", err)
+	}
+	if out.File == nil || out.File.Missing {
+		return nil, nil
+	}
+	return out.File, nil
+}
+
+// SetWorkspaceFile creates or replaces a file in the workspace of the given
+// agent. An empty mode leaves the permission bits to the gateway.
+func SetWorkspaceFile(ctx context.Context, c Client, agentID, name, content, mode string) error {
+	_, err := c.Call(ctx, "agents.files.set", workspaceFileParams{
+		AgentID: agentID,
+		Name:    name,
+		Content: &content,
+		Mode:    mode,
+	})
+	return err
+}
+
+// DeleteWorkspaceFile removes a file from the workspace of the given agent.
+// Removing a file that does not exist is not an error.
+func DeleteWorkspaceFile(ctx context.Context, c Client, agentID, name string) error {
+	_, err := c.Call(ctx, "agents.files.delete", workspaceFileParams{AgentID: agentID, Name: name})
+	return err
+}
+
+// ParseFileMode parses permission bits written in octal, such as 0644.
+func ParseFileMode(mode string) (fs.FileMode, error) {
+	v, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || v > 0o777 {
+		return 0, fmt.Errorf("invalid file mode %q: want octal permission bits such as 0644", mode)
+	}
+	return fs.FileMode(v), nil
+}
+
+// callWorkspace serves the agents.files RPCs in file mode by reading and
+// writing the agent's workspace directory directly.
+func (f *FileClient) callWorkspace(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	var p workspaceFileParams
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+	if !filepath.IsLocal(p.Name) {
+		return nil, fmt.Errorf("%s: %q is not a path inside the workspace", method, p.Name)
+	}
+	dir, err := f.workspaceDir(ctx, p.AgentID)
+	if err != nil {
+		return nil, err
+	}
+	name := filepath.Join(dir, p.Name)
+
+	switch method {
+	case "agents.files.get":
+		file := WorkspaceFile{Name: p.Name}
+		data, err := os.ReadFile(name)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			file.Missing = true
+		case err != nil:
+			return nil, err
+		default:
+			file.Content = string(data)
+			if info, err := os.Stat(name); err == nil {
+				file.Mode = fmt.Sprintf("%04o", info.Mode().Perm())
+			}
+		}
+		return json.Marshal(map[string]any{"file": file})
+
+	case "agents.files.set":
+		perm := fs.FileMode(0o644)
+		if p.Mode != "" {
+			if perm, err = ParseFileMode(p.Mode); err != nil {
+				return nil, err
+			}
+		}
+		if err := ensureDir(name); err != nil {
+			return nil, err
+		}
+		var content string
+		if p.Content != nil {
+			content = *p.Content
+		}
+		if err := writeFileAtomic(name, []byte(content), perm); err != nil {
+			return nil, err
+		}
+		// writeFileAtomic keeps the bits of an existing file.
+		if p.Mode != "" {
+			if err := os.Chmod(name, perm); err != nil {
+				return nil, err
+			}
+		}
+		return json.RawMessage(`{"ok":true}`), nil
+
+	case "agents.files.delete":
+		if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		return json.RawMessage(`{"ok":true}`), nil
+	}
+	return nil, fmt.Errorf("%s not available in file mode (no running gateway)", method)
+}
+
+// workspaceDir returns the workspace directory of the given agent: its own
+// workspace from agents.list, else agents.defaults.workspace, else the
+// OpenClaw default. Relative paths are taken from the config file's
+// directory. Only a config on the local filesystem is supported, since the
+// workspace must be reachable too.
+func (f *FileClient) workspaceDir(ctx context.Context, agentID string) (string, error) {
+	local, ok := f.store.(localFile)
+	if !ok {
+		return "", fmt.Errorf("workspace files not available for %s: only a local config file is supported", f.store)
+	}
+	cfg, err := f.GetConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	root, err := parseRawJSON(cfg.Raw)
+	if err != nil {
+		return "", fmt.Errorf("parsing config: %w", err)
+	}
+
+	workspace := defaultWorkspace
+	agents, _ := root["agents"].(map[string]any)
+	if defaults, ok := agents["defaults"].(map[string]any); ok {
+		if ws, ok := defaults["workspace"].(string); ok && ws != "" {
+			workspace = ws
+		}
+	}
+	list, _ := agents["list"].([]any)
+	for _, item := range list {
+		agent, _ := item.(map[string]any)
+		if id, _ := agent["id"].(string); id != agentID {
+			continue
+		}
+		if ws, ok := agent["workspace"].(string); ok && ws != "" {
+			workspace = ws
+		}
+		break
+	}
+
+	dir, err := expandPath(workspace)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(filepath.Dir(string(local)), dir)
+	}
+	return dir, nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWorkspaceFile_GatewayMissing(t *testing.T) {
+	g := newFakeGateway(t)
+	g.handle("agents.files.get", func(p map[string]any) (any, any) {
+		return map[string]any{"file": map[string]any{"name": p["name"], "missing": true}}, nil
+	})
+	c := newReconnectClient(t, g)
+
+	f, err := GetWorkspaceFile(context.Background(), c, "main", "AGENTS.md")
+	if err != nil {
+		t.Fatalf("GetWorkspaceFile: %v", err)
+	}
+	if f != nil {
+		t.Errorf("GetWorkspaceFile = %+v, want nil", f)
+	}
+}
+
+func TestWorkspaceFile_FileMode(t *testing.T) {
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, "openclaw.json")
+	os.WriteFile(cfgPath, []byte(`{"agents":{"defaults":{"workspace":"ws"},"list":[{"id":"research","workspace":"`+
+		filepath.Join(dir, "research")+`"}]}}`), 0o644)
+	c, err := NewFileClient(cfgPath)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+	ctx := context.Background()
+
+	if err := SetWorkspaceFile(ctx, c, "main", "docs/AGENTS.md", "# Agents\n", "0600"); err != nil {
+		t.Fatalf("SetWorkspaceFile: %v", err)
+	}
+	// main has no workspace of its own, so the relative default applies.
+	data, err := os.ReadFile(filepath.Join(dir, "ws", "docs", "AGENTS.md"))
+	if err != nil || string(data) != "# Agents\n" {
+		t.Fatalf("file on disk = %q, %v", data, err)
+	}
+	f, err := GetWorkspaceFile(ctx, c, "main", "docs/AGENTS.md")
+	if err != nil {
+		t.Fatalf("GetWorkspaceFile: %v", err)
+	}
+	if f == nil || f.Content != "# Agents\n" || f.Mode != "0600" {
+		t.Errorf("GetWorkspaceFile = %+v", f)
+	}
+
+	if err := SetWorkspaceFile(ctx, c, "research", "TOOLS.md", "tools", ""); err != nil {
+		t.Fatalf("SetWorkspaceFile: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "research", "TOOLS.md")); err != nil {
+		t.Errorf("agent workspace not used: %v", err)
+	}
+
+	if err := DeleteWorkspaceFile(ctx, c, "main", "docs/AGENTS.md"); err != nil {
+		t.Fatalf("DeleteWorkspaceFile: %v", err)
+	}
+	if err := DeleteWorkspaceFile(ctx, c, "main", "docs/AGENTS.md"); err != nil {
+		t.Errorf("second DeleteWorkspaceFile: %v", err)
+	}
+	if f, err := GetWorkspaceFile(ctx, c, "main", "docs/AGENTS.md"); err != nil || f != nil {
+		t.Errorf("GetWorkspaceFile after delete = %+v, %v; want nil", f, err)
+	}
+
+	if err := SetWorkspaceFile(ctx, c, "main", "../escape.md", "x", ""); err == nil {
+		t.Error("SetWorkspaceFile outside the workspace succeeded")
+	}
+}
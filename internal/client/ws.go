@@ -2,18 +2,19 @@ package client
 
 import (
 	"context"
-	"crypto/ed25519"
-	"crypto/rand"
-	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/ssh"
 )
 
 // wsFrame is the wire format for OpenClaw Gateway WebSocket messages.
@@ -26,95 +27,362 @@ type wsFrame struct {
 	Payload any    `json:"payload,omitempty"` // for responses
 	Error   any    `json:"error,omitempty"`   // for error responses
 	Event   string `json:"event,omitempty"`   // for events
+
+	size int // length of the encoded message, before any compression
+}
+
+// ErrConnectionLost matches the error returned to requests that were in
+// flight when the gateway connection went away, usually because a config
+// change made the gateway restart. Test for it with errors.Is.
+var ErrConnectionLost = errors.New("connection lost")
+
+// errClientClosed is returned by calls made after Close.
+var errClientClosed = errors.New("client closed")
+
+// ConnectionLostError is returned to every request still waiting for its
+// reply when the connection drops. Whether the gateway acted on the request
+// is unknown.
+type ConnectionLostError struct {
+	Method string
+	// Err is why the connection ended, when known.
+	Err error
+}
+
+func (e *ConnectionLostError) Error() string {
+	msg := e.Method + ": connection closed before the gateway replied"
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
 }
 
-// WSClient communicates with the OpenClaw Gateway over WebSocket.
+func (e *ConnectionLostError) Is(target error) bool { return target == ErrConnectionLost }
+
+func (e *ConnectionLostError) Unwrap() error { return e.Err }
+
+// WSClient communicates with the OpenClaw Gateway over WebSocket. When the
+// gateway restarts, the next call transparently reconnects.
 type WSClient struct {
+	tunnel   *ssh.Client // non-nil when dialing through an SSH tunnel
+	url      string
+	token    string
+	password string
+	nextID   atomic.Int64
+
+	// noValidate is set once the gateway turns out not to have
+	// config.validate.
+	noValidate atomic.Bool
+	// noSections is set once the gateway turns out not to support
+	// config.get's sections argument.
+	noSections atomic.Bool
+	// noExport and noImport are set once the gateway turns out not to have
+	// config.export and config.import.
+	noExport atomic.Bool
+	noImport atomic.Bool
+
+	rpcTimeout   time.Duration
+	applyTimeout time.Duration
+	identity     *DeviceIdentity
+	role         string
+	scopes       []string
+
+	// dial opens and authenticates a new session, retrying per the
+	// connect settings.
+	dial func(ctx context.Context) (*wsSession, error)
+
+	limits *rpcLimiter
+
+	mu     sync.Mutex // guards sess and closed; held while reconnecting
+	sess   *wsSession
+	closed bool
+
+	subMu    sync.Mutex // guards subs, changes and produced
+	subs     map[*subscription]bool
+	changes  []ConfigChange
+	produced map[string]bool // config hashes written by this client
+	writing  atomic.Int64    // writes in flight
+}
+
+// wsSession is one WebSocket connection to the gateway.
+type wsSession struct {
 	conn      *websocket.Conn
-	url       string
-	token     string
-	mu        sync.Mutex
-	pending   map[string]chan wsFrame
-	challenge chan wsFrame // receives the connect.challenge event
-	nextID    atomic.Int64
-	connected bool
+	mu        sync.Mutex              // guards writes and pending
+	pending   map[string]chan wsFrame // nil once the connection is gone
+	challenge chan wsFrame            // receives the connect.challenge event
+	events    func(f wsFrame)         // receives every other event
 	done      chan struct{}
+	// lost is why the connection ended. It is set before done is closed.
+	lost error
+}
+
+func (s *wsSession) isClosed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
 }
 
 // WSClientConfig holds connection parameters.
 type WSClientConfig struct {
 	URL   string
 	Token string
+	// Password authenticates against gateways with auth.mode = "password".
+	Password string
+
+	// CACertPEM is an optional PEM bundle trusted in addition to the system
+	// roots when dialing wss:// URLs.
+	CACertPEM string
+	// InsecureSkipVerify disables server certificate verification. Only
+	// intended for local testing against self-signed gateways.
+	InsecureSkipVerify bool
+	// ClientCertPEM and ClientKeyPEM, when both set, are presented as a TLS
+	// client certificate for gateways fronted by mTLS-enforcing proxies.
+	ClientCertPEM string
+	ClientKeyPEM  string
+
+	// SSH, when set, tunnels the WebSocket connection through an SSH server.
+	SSH *SSHTunnelConfig
+
+	// ProxyURL is an http:// or socks5:// proxy for the WebSocket dial.
+	// When empty, HTTPS_PROXY/HTTP_PROXY/ALL_PROXY are honoured.
+	ProxyURL string
+
+	// ConnectRetries is how many times a failed dial or handshake is
+	// retried. Nil means DefaultConnectRetries.
+	ConnectRetries *int
+	// ConnectTimeout bounds each dial + handshake attempt.
+	// Zero means DefaultConnectTimeout.
+	ConnectTimeout time.Duration
+	// MaxBackoff caps the exponential delay between attempts, which starts
+	// at one second. Zero means DefaultMaxBackoff.
+	MaxBackoff time.Duration
+
+	// RPCTimeout bounds each request/response round trip.
+	// Zero means DefaultRPCTimeout.
+	RPCTimeout time.Duration
+	// ApplyTimeout replaces RPCTimeout for config.apply, which may restart
+	// the gateway. Zero means DefaultApplyTimeout.
+	ApplyTimeout time.Duration
+
+	// DeviceIdentity signs the connect handshake. Nil generates an
+	// ephemeral identity for each connection.
+	DeviceIdentity *DeviceIdentity
+
+	// Role and Scopes are requested in the connect handshake. Empty values
+	// mean DefaultRole and DefaultScopes.
+	Role   string
+	Scopes []string
+
+	// MaxInFlight bounds how many requests wait for a reply at once, and
+	// RequestsPerSecond how often a new one may be sent. Zero means no limit.
+	MaxInFlight       int
+	RequestsPerSecond int
+	// BreakerThreshold is how many requests may fail in a row before the
+	// client stops sending any for BreakerCooldown (zero means
+	// DefaultBreakerCooldown). Zero disables the circuit breaker.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
 }
 
+// DefaultRole and DefaultScopes request full operator access, which
+// creating, updating and deleting resources needs.
+const DefaultRole = "operator"
+
+var DefaultScopes = []string{"operator.read", "operator.write", "operator.admin"}
+
+// Connection retry defaults, used when WSClientConfig leaves them unset.
+const (
+	DefaultConnectRetries = 5
+	DefaultConnectTimeout = 10 * time.Second
+	DefaultMaxBackoff     = 10 * time.Second
+	DefaultRPCTimeout     = 30 * time.Second
+	DefaultApplyTimeout   = 2 * time.Minute
+)
+
 // NewWSClient dials the Gateway and performs the connect handshake.
 // It retries with exponential backoff to tolerate gateway restarts
 // (e.g. after a config.patch triggers a reload/restart cycle).
 func NewWSClient(ctx context.Context, cfg WSClientConfig) (*WSClient, error) {
-	const maxRetries = 5
-	backoff := 1 * time.Second
+	// TLS settings are static, so a bad certificate or key is reported
+	// immediately instead of being retried.
+	tlsConfig, err := buildTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
 
-	var lastErr error
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			select {
-			case <-time.After(backoff):
-				backoff = backoff * 2
-				if backoff > 10*time.Second {
-					backoff = 10 * time.Second
+	proxy, err := proxyFunc(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	maxRetries := DefaultConnectRetries
+	if cfg.ConnectRetries != nil {
+		maxRetries = *cfg.ConnectRetries
+	}
+	connectTimeout := cfg.ConnectTimeout
+	if connectTimeout <= 0 {
+		connectTimeout = DefaultConnectTimeout
+	}
+	maxBackoff := cfg.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+
+	// Large configs are sent whole on every read, and JSON compresses
+	// well, so offer permessage-deflate. Gateways without it ignore the offer.
+	dialer := &websocket.Dialer{
+		HandshakeTimeout:  connectTimeout,
+		TLSClientConfig:   tlsConfig,
+		Proxy:             proxy,
+		EnableCompression: true,
+	}
+
+	var tunnel *ssh.Client
+	if cfg.SSH != nil {
+		tunnel, err = dialSSH(ctx, *cfg.SSH)
+		if err != nil {
+			return nil, err
+		}
+		dialer.NetDialContext = func(_ context.Context, network, addr string) (net.Conn, error) {
+			return dialTunnel(tunnel, network, addr)
+		}
+	}
+
+	c := &WSClient{
+		tunnel:   tunnel,
+		url:      cfg.URL,
+		token:    cfg.Token,
+		password: cfg.Password,
+
+		rpcTimeout:   cfg.RPCTimeout,
+		applyTimeout: cfg.ApplyTimeout,
+		identity:     cfg.DeviceIdentity,
+		role:         cfg.Role,
+		scopes:       cfg.Scopes,
+		limits:       newRPCLimiter(cfg),
+	}
+	if c.role == "" {
+		c.role = DefaultRole
+	}
+	if len(c.scopes) == 0 {
+		c.scopes = DefaultScopes
+	}
+	if c.rpcTimeout <= 0 {
+		c.rpcTimeout = DefaultRPCTimeout
+	}
+	if c.applyTimeout <= 0 {
+		c.applyTimeout = DefaultApplyTimeout
+	}
+
+	c.dial = func(ctx context.Context) (*wsSession, error) {
+		backoff := min(1*time.Second, maxBackoff)
+
+		var lastErr error
+		for attempt := 0; attempt <= maxRetries; attempt++ {
+			if attempt > 0 {
+				select {
+				case <-time.After(backoff):
+					backoff = min(backoff*2, maxBackoff)
+				case <-ctx.Done():
+					return nil, fmt.Errorf("ws connect cancelled after %d attempts: %w (last error: %v)", attempt, ctx.Err(), lastErr)
 				}
-			case <-ctx.Done():
-				return nil, fmt.Errorf("ws connect cancelled after %d attempts: %w (last error: %v)", attempt, ctx.Err(), lastErr)
 			}
-		}
 
-		c, err := dialAndHandshake(ctx, cfg)
-		if err == nil {
-			return c, nil
+			attemptCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+			s, err := c.dialSession(attemptCtx, dialer)
+			cancel()
+			if err == nil {
+				return s, nil
+			}
+			var scopeErr *ScopeError
+			if errors.As(err, &scopeErr) {
+				// Retrying cannot change what the credentials allow.
+				return nil, err
+			}
+			lastErr = err
 		}
-		lastErr = err
+		return nil, fmt.Errorf("ws connect failed after %d attempts: %w", maxRetries+1, lastErr)
 	}
 
-	return nil, lastErr
-}
-
-func dialAndHandshake(ctx context.Context, cfg WSClientConfig) (*WSClient, error) {
-	dialer := websocket.Dialer{
-		HandshakeTimeout: 10 * time.Second,
+	c.sess, err = c.dial(ctx)
+	if err != nil {
+		if tunnel != nil {
+			tunnel.Close()
+		}
+		return nil, err
 	}
+	return c, nil
+}
 
-	conn, _, err := dialer.DialContext(ctx, cfg.URL, nil)
+func (c *WSClient) dialSession(ctx context.Context, dialer *websocket.Dialer) (*wsSession, error) {
+	conn, resp, err := dialer.DialContext(ctx, c.url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("ws dial %s: %w", cfg.URL, err)
+		return nil, fmt.Errorf("ws dial %s: %w", c.url, err)
 	}
+	tflog.Debug(ctx, "gateway connection opened", map[string]any{
+		"url":         c.url,
+		"compression": strings.Contains(resp.Header.Get("Sec-WebSocket-Extensions"), "permessage-deflate"),
+	})
 
-	c := &WSClient{
+	s := &wsSession{
 		conn:      conn,
-		url:       cfg.URL,
-		token:     cfg.Token,
 		pending:   make(map[string]chan wsFrame),
 		challenge: make(chan wsFrame, 1),
+		events:    c.dispatchEvent,
 		done:      make(chan struct{}),
 	}
 
 	// Start the read pump before handshake so we can receive the response.
-	go c.readPump()
+	go s.readPump()
 
 	// Perform the mandatory connect handshake.
-	if err := c.handshake(ctx); err != nil {
+	if err := c.handshake(ctx, s); err != nil {
 		conn.Close()
 		return nil, fmt.Errorf("ws handshake: %w", err)
 	}
+	return s, nil
+}
 
-	c.connected = true
-	return c, nil
+// session returns the current session, reconnecting first if the gateway
+// has dropped it since the last call.
+func (c *WSClient) session(ctx context.Context) (*wsSession, error) {
+	c.mu.Lock()
+	s := c.sess
+	c.mu.Unlock()
+	if !s.isClosed() {
+		return s, nil
+	}
+	return c.reconnect(ctx, s)
 }
 
-func (c *WSClient) handshake(ctx context.Context) error {
+// reconnect replaces the closed session old. Concurrent callers that saw the
+// same session wait for, and then share, a single new one.
+func (c *WSClient) reconnect(ctx context.Context, old *wsSession) (*wsSession, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, errClientClosed
+	}
+	if c.sess != old {
+		return c.sess, nil
+	}
+	old.conn.Close()
+	s, err := c.dial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reconnecting to gateway: %w", err)
+	}
+	c.sess = s
+	return s, nil
+}
+
+func (c *WSClient) handshake(ctx context.Context, s *wsSession) error {
 	// Wait for the gateway's connect.challenge event (sent immediately on WS open).
 	var challengeNonce string
 	select {
-	case frame := <-c.challenge:
+	case frame := <-s.challenge:
+		traceFrame(ctx, "recv", frame)
 		if p, ok := frame.Payload.(map[string]any); ok {
 			if n, ok := p["nonce"].(string); ok {
 				challengeNonce = n
@@ -126,26 +394,26 @@ func (c *WSClient) handshake(ctx context.Context) error {
 		return ctx.Err()
 	}
 
-	// Generate an ephemeral Ed25519 keypair for device identity.
+	// Without a persistent identity, generate an ephemeral Ed25519 keypair.
 	// The gateway auto-pairs local (loopback) connections silently.
-	pub, priv, err := ed25519.GenerateKey(rand.Reader)
-	if err != nil {
-		return fmt.Errorf("generate device key: %w", err)
+	identity := c.identity
+	if identity == nil {
+		var err error
+		if identity, err = NewDeviceIdentity(); err != nil {
+			return err
+		}
 	}
-
-	// Device ID = SHA-256 hex of the raw 32-byte public key.
-	idHash := sha256.Sum256(pub)
-	deviceID := fmt.Sprintf("%x", idHash)
+	deviceID := identity.ID()
 
 	// Public key as base64url (raw 32 bytes, no padding).
-	publicKeyB64 := base64.RawURLEncoding.EncodeToString(pub)
+	publicKeyB64 := base64.RawURLEncoding.EncodeToString(identity.PublicKey())
 
 	signedAt := time.Now().UnixMilli()
 
 	clientID := "cli"
 	clientMode := "cli"
-	role := "operator"
-	scopes := []string{"operator.read", "operator.write", "operator.admin"}
+	role := c.role
+	scopes := c.scopes
 
 	// Build the signed payload per the OpenClaw device auth protocol.
 	// v1 format (local, no nonce): v1|deviceId|clientId|clientMode|role|scopes|signedAt|token
@@ -165,7 +433,7 @@ func (c *WSClient) handshake(ctx context.Context) error {
 			version, deviceID, clientID, clientMode, role, scopeStr, signedAt, authToken)
 	}
 
-	sig := ed25519.Sign(priv, []byte(signedPayload))
+	sig := identity.Sign([]byte(signedPayload))
 	signatureB64 := base64.RawURLEncoding.EncodeToString(sig)
 
 	device := map[string]any{
@@ -200,14 +468,21 @@ func (c *WSClient) handshake(ctx context.Context) error {
 		params["auth"] = map[string]any{
 			"token": c.token,
 		}
+	} else if c.password != "" {
+		params["auth"] = map[string]any{
+			"password": c.password,
+		}
 	}
 
-	resp, err := c.call(ctx, "connect", params)
+	resp, err := c.roundTrip(ctx, s, "connect", params)
 	if err != nil {
 		return err
 	}
 
 	if resp.OK == nil || !*resp.OK {
+		if msg, ok := scopeDenial(resp.Error); ok {
+			return &ScopeError{Method: "connect", Role: role, Scopes: scopes, Message: msg}
+		}
 		errBytes, _ := json.Marshal(resp.Error)
 		return fmt.Errorf("connect rejected: %s", string(errBytes))
 	}
@@ -215,18 +490,123 @@ func (c *WSClient) handshake(ctx context.Context) error {
 	return nil
 }
 
+// ScopeError reports a request the gateway refused because the role or
+// scopes granted to this connection do not allow it.
+type ScopeError struct {
+	// Method is the refused RPC method, or "connect" when the gateway would
+	// not grant the requested role or scopes at all.
+	Method  string
+	Role    string
+	Scopes  []string
+	Message string
+}
+
+func (e *ScopeError) Error() string {
+	granted := fmt.Sprintf("role %s with scopes %s", e.Role, strings.Join(e.Scopes, ", "))
+	if e.Method == "connect" {
+		return fmt.Sprintf("gateway refused %s: %s. Request a role and scopes these credentials are allowed to use", granted, e.Message)
+	}
+	return fmt.Sprintf("%s denied for %s: %s. Add the missing scope to the provider scopes setting", e.Method, granted, e.Message)
+}
+
+// scopeDenial reports whether a response error payload is a permission
+// failure, and returns its message.
+func scopeDenial(payload any) (string, bool) {
+	e, ok := payload.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	code, _ := e["code"].(string)
+	msg, _ := e["message"].(string)
+	switch strings.ToUpper(code) {
+	case "FORBIDDEN", "MISSING_SCOPE", "INSUFFICIENT_SCOPE":
+	default:
+		if !strings.Contains(strings.ToLower(msg), "scope") {
+			return "", false
+		}
+	}
+	if msg == "" {
+		msg = code
+	}
+	return msg, true
+}
+
+// failed turns an ok=false response into an error.
+func (c *WSClient) failed(method string, resp wsFrame) error {
+	if msg, ok := scopeDenial(resp.Error); ok {
+		return &ScopeError{Method: method, Role: c.role, Scopes: c.scopes, Message: msg}
+	}
+	return fmt.Errorf("%s failed: %v", method, resp.Error)
+}
+
+// replayable reports whether method may safely be re-sent when the
+// connection drops before its response arrives.
+func replayable(method string) bool {
+	return method == "config.get" || method == "config.export" || method == "health" ||
+		method == "devices.list" || method == "usage.status" || method == "sessions.list" ||
+		method == "cron.list" || method == "plugins.list" || method == "agents.files.get"
+}
+
+// call sends a request on the current session. If the connection drops
+// while a read-only request is in flight, it reconnects and retries it once.
+// Nothing is sent while the circuit breaker is open.
 func (c *WSClient) call(ctx context.Context, method string, params any) (wsFrame, error) {
+	if err := c.limits.admit(method); err != nil {
+		return wsFrame{}, err
+	}
+	resp, err := c.callSession(ctx, method, params)
+	c.limits.record(ctx, method, resp, err)
+	return resp, err
+}
+
+func (c *WSClient) callSession(ctx context.Context, method string, params any) (wsFrame, error) {
+	s, err := c.session(ctx)
+	if err != nil {
+		return wsFrame{}, err
+	}
+	resp, err := c.roundTrip(ctx, s, method, params)
+	if !errors.Is(err, ErrConnectionLost) || !replayable(method) {
+		return resp, err
+	}
+	if s, err = c.reconnect(ctx, s); err != nil {
+		return wsFrame{}, err
+	}
+	return c.roundTrip(ctx, s, method, params)
+}
+
+func (c *WSClient) roundTrip(ctx context.Context, s *wsSession, method string, params any) (wsFrame, error) {
+	timeout := c.rpcTimeout
+	if method == "config.apply" || method == "config.import" {
+		timeout = c.applyTimeout
+	}
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	release, err := c.limits.pace(callCtx)
+	if err != nil {
+		if ctx.Err() == nil {
+			return wsFrame{}, fmt.Errorf("%s timed out after %s waiting to be sent", method, timeout)
+		}
+		return wsFrame{}, err
+	}
+	defer release()
+
 	id := fmt.Sprintf("tf-%d", c.nextID.Add(1))
 	ch := make(chan wsFrame, 1)
 
-	c.mu.Lock()
-	c.pending[id] = ch
-	c.mu.Unlock()
+	s.mu.Lock()
+	if s.pending == nil {
+		s.mu.Unlock()
+		<-s.done
+		return wsFrame{}, &ConnectionLostError{Method: method, Err: s.lost}
+	}
+	s.pending[id] = ch
+	s.mu.Unlock()
 
 	defer func() {
-		c.mu.Lock()
-		delete(c.pending, id)
-		c.mu.Unlock()
+		s.mu.Lock()
+		delete(s.pending, id)
+		s.mu.Unlock()
 	}()
 
 	frame := wsFrame{
@@ -240,29 +620,41 @@ func (c *WSClient) call(ctx context.Context, method string, params any) (wsFrame
 	if err != nil {
 		return wsFrame{}, fmt.Errorf("marshal request: %w", err)
 	}
+	frame.size = len(data)
+	traceFrame(ctx, "send", frame)
 
-	c.mu.Lock()
-	err = c.conn.WriteMessage(websocket.TextMessage, data)
-	c.mu.Unlock()
+	s.mu.Lock()
+	err = s.conn.WriteMessage(websocket.TextMessage, data)
+	s.mu.Unlock()
 	if err != nil {
-		return wsFrame{}, fmt.Errorf("ws write: %w", err)
+		// The connection is unusable; closing it ends readPump, which
+		// fails every other request waiting on it.
+		s.conn.Close()
+		<-s.done
+		return wsFrame{}, &ConnectionLostError{Method: method, Err: err}
 	}
 
 	select {
 	case resp := <-ch:
+		traceFrame(ctx, "recv", resp)
 		return resp, nil
-	case <-ctx.Done():
+	case <-callCtx.Done():
+		if ctx.Err() == nil {
+			return wsFrame{}, fmt.Errorf("%s timed out after %s", method, timeout)
+		}
 		return wsFrame{}, ctx.Err()
-	case <-c.done:
-		return wsFrame{}, fmt.Errorf("connection closed")
+	case <-s.done:
+		return wsFrame{}, &ConnectionLostError{Method: method, Err: s.lost}
 	}
 }
 
-func (c *WSClient) readPump() {
-	defer close(c.done)
+// readPump routes incoming frames until the connection fails, then shuts
+// the session down.
+func (s *wsSession) readPump() {
 	for {
-		_, message, err := c.conn.ReadMessage()
+		_, message, err := s.conn.ReadMessage()
 		if err != nil {
+			s.shutdown(err)
 			return
 		}
 
@@ -270,27 +662,42 @@ func (c *WSClient) readPump() {
 		if err := json.Unmarshal(message, &frame); err != nil {
 			continue
 		}
+		frame.size = len(message)
 
 		// Route responses to pending callers.
 		if frame.Type == "res" && frame.ID != "" {
-			c.mu.Lock()
-			ch, ok := c.pending[frame.ID]
-			c.mu.Unlock()
+			s.mu.Lock()
+			ch, ok := s.pending[frame.ID]
+			// A duplicate reply must not block the pump on a full channel.
+			delete(s.pending, frame.ID)
+			s.mu.Unlock()
 			if ok {
 				ch <- frame
 			}
 		}
 
-		// Route the connect.challenge event.
+		// Route the connect.challenge event; hand the rest to subscribers.
 		if frame.Type == "event" && frame.Event == "connect.challenge" {
 			select {
-			case c.challenge <- frame:
+			case s.challenge <- frame:
 			default:
 			}
+		} else if frame.Type == "event" && s.events != nil {
+			s.events(frame)
 		}
 	}
 }
 
+// shutdown records why the connection ended and wakes every request waiting
+// on it. Requests started afterwards fail straight away.
+func (s *wsSession) shutdown(cause error) {
+	s.mu.Lock()
+	s.lost = cause
+	s.pending = nil
+	s.mu.Unlock()
+	close(s.done)
+}
+
 // GetConfig implements Client.
 func (c *WSClient) GetConfig(ctx context.Context) (*ConfigPayload, error) {
 	resp, err := c.call(ctx, "config.get", map[string]any{})
@@ -298,10 +705,14 @@ func (c *WSClient) GetConfig(ctx context.Context) (*ConfigPayload, error) {
 		return nil, err
 	}
 	if resp.OK == nil || !*resp.OK {
-		return nil, fmt.Errorf("config.get failed: %v", resp.Error)
+		return nil, c.failed("config.get", resp)
 	}
+	return decodeConfigPayload(resp.Payload)
+}
 
-	payloadBytes, err := json.Marshal(resp.Payload)
+// decodeConfigPayload decodes the payload of a config.get response.
+func decodeConfigPayload(payload any) (*ConfigPayload, error) {
+	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("marshal payload: %w", err)
 	}
@@ -333,8 +744,77 @@ func (c *WSClient) GetConfig(ctx context.Context) (*ConfigPayload, error) {
 	}, nil
 }
 
-// PatchConfig implements Client.
+// patchConflictRetries bounds how often PatchConfig re-reads the config and
+// re-sends a patch the gateway rejected because the config changed.
+const patchConflictRetries = 3
+
+// GetConfigSection implements Client. The section is requested with
+// config.get's sections argument, so the gateway sends only that part of the
+// config. Gateways without sections support send the whole config, and the
+// section is picked out of it; once one has, later calls ask for the whole
+// config directly.
+func (c *WSClient) GetConfigSection(ctx context.Context, keys ...string) (*SectionPayload, error) {
+	// Sections are named by dotted path, which a key containing a dot
+	// would make ambiguous.
+	dotted := strings.Join(keys, ".")
+	if c.noSections.Load() || len(keys) == 0 || strings.Count(dotted, ".") != len(keys)-1 {
+		cfg, err := c.GetConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return sectionOf(cfg, keys...)
+	}
+
+	resp, err := c.call(ctx, "config.get", map[string]any{"sections": []string{dotted}})
+	if err != nil {
+		return nil, err
+	}
+	if resp.OK == nil || !*resp.OK {
+		if invalidParams(resp.Error) {
+			c.noSections.Store(true)
+			return c.GetConfigSection(ctx, keys...)
+		}
+		return nil, c.failed("config.get", resp)
+	}
+
+	payload, _ := resp.Payload.(map[string]any)
+	sections, ok := payload["sections"].(map[string]any)
+	if !ok {
+		c.noSections.Store(true)
+		cfg, err := decodeConfigPayload(resp.Payload)
+		if err != nil {
+			return nil, err
+		}
+		return sectionOf(cfg, keys...)
+	}
+	hash, _ := payload["hash"].(string)
+	return &SectionPayload{Value: sections[dotted], Hash: hash}, nil
+}
+
+// invalidParams reports whether a response error says the request's
+// parameters were not understood.
+func invalidParams(payload any) bool {
+	e, ok := payload.(map[string]any)
+	if !ok {
+		return false
+	}
+	code, _ := e["code"].(string)
+	switch strings.ToUpper(code) {
+	case "INVALID_PARAMS", "INVALID_REQUEST":
+		return true
+	}
+	return false
+}
+
+// PatchConfig implements Client. When Terraform applies resources in
+// parallel, another resource's patch often lands between this resource's
+// read and write, and the gateway rejects the write for its stale baseHash.
+// Resources patch only their own sections, so the patch is re-sent against
+// the current hash, up to patchConflictRetries times. A patch holding a list
+// is not: lists such as agents.list and bindings are rebuilt whole from the
+// config the resource read, so re-sending would discard the other change.
 func (c *WSClient) PatchConfig(ctx context.Context, patch map[string]any, baseHash string) error {
+	defer c.startWrite()()
 	rawBytes, err := json.Marshal(patch)
 	if err != nil {
 		return fmt.Errorf("marshal patch: %w", err)
@@ -344,36 +824,153 @@ func (c *WSClient) PatchConfig(ctx context.Context, patch map[string]any, baseHa
 		"raw":      string(rawBytes),
 		"baseHash": baseHash,
 	}
-
-	resp, err := c.call(ctx, "config.patch", params)
-	if err != nil {
+	if err := c.validate(ctx, "config.patch", params); err != nil {
 		return err
 	}
-	if resp.OK == nil || !*resp.OK {
-		return fmt.Errorf("config.patch failed: %v", resp.Error)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.call(ctx, "config.patch", params)
+		if errors.Is(err, ErrConnectionLost) {
+			// The gateway went away before answering, typically to restart.
+			// If the config is unchanged once reconnected, the patch never
+			// landed and is sent again. Otherwise it may have landed, or
+			// someone else wrote in between; re-sending could then apply it
+			// twice or overwrite the other write.
+			cfg, gerr := c.GetConfig(ctx)
+			if gerr != nil {
+				return fmt.Errorf("config.patch: %w (%v)", err, gerr)
+			}
+			if cfg.Hash != params["baseHash"] {
+				return fmt.Errorf("config.patch: %w; the config has changed since, so the patch may have been applied: run terraform plan to check", err)
+			}
+			resp, err = c.call(ctx, "config.patch", params)
+		}
+		if err != nil {
+			return err
+		}
+		if resp.OK != nil && *resp.OK {
+			c.recordHash(responseHash(resp.Payload))
+			return nil
+		}
+		if !hashConflict(resp.Error) || attempt == patchConflictRetries || containsList(patch) {
+			return c.failed("config.patch", resp)
+		}
+
+		cfg, err := c.GetConfig(ctx)
+		if err != nil {
+			return fmt.Errorf("config.patch: re-reading config after hash conflict: %w", err)
+		}
+		params["baseHash"] = cfg.Hash
 	}
-	return nil
+}
+
+// containsList reports whether a patch sets any value to a list.
+func containsList(patch map[string]any) bool {
+	for _, v := range patch {
+		switch v := v.(type) {
+		case []any:
+			return true
+		case map[string]any:
+			if containsList(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hashConflict reports whether a response error payload means the write was
+// made against a config hash that is no longer current.
+func hashConflict(payload any) bool {
+	e, ok := payload.(map[string]any)
+	if !ok {
+		return false
+	}
+	code, _ := e["code"].(string)
+	msg, _ := e["message"].(string)
+	switch strings.ToUpper(code) {
+	case "CONFLICT", "HASH_MISMATCH", "STALE_HASH":
+		return true
+	}
+	return strings.Contains(strings.ToLower(msg), "hash mismatch")
 }
 
 // ApplyConfig implements Client.
 func (c *WSClient) ApplyConfig(ctx context.Context, raw string, baseHash string) error {
+	defer c.startWrite()()
 	params := map[string]any{
 		"raw": raw,
 	}
 	if baseHash != "" {
 		params["baseHash"] = baseHash
 	}
+	if err := c.validate(ctx, "config.apply", params); err != nil {
+		return err
+	}
 
 	resp, err := c.call(ctx, "config.apply", params)
 	if err != nil {
 		return err
 	}
 	if resp.OK == nil || !*resp.OK {
-		return fmt.Errorf("config.apply failed: %v", resp.Error)
+		return c.failed("config.apply", resp)
 	}
+	c.recordHash(responseHash(resp.Payload))
 	return nil
 }
 
+// ExportConfig implements Client with the gateway's config.export, which
+// unlike config.get returns secrets unredacted. Gateways without it fall
+// back to config.get.
+func (c *WSClient) ExportConfig(ctx context.Context) (*ConfigPayload, error) {
+	if !c.noExport.Load() {
+		resp, err := c.call(ctx, "config.export", map[string]any{})
+		if err != nil {
+			return nil, err
+		}
+		if resp.OK != nil && *resp.OK {
+			return decodeConfigPayload(resp.Payload)
+		}
+		if !unknownMethod(resp.Error) {
+			return nil, c.failed("config.export", resp)
+		}
+		c.noExport.Store(true)
+	}
+	return c.GetConfig(ctx)
+}
+
+// ImportConfig implements Client with the gateway's config.import. Gateways
+// without it fall back to config.apply.
+func (c *WSClient) ImportConfig(ctx context.Context, raw string, baseHash string) error {
+	if c.noImport.Load() {
+		return c.ApplyConfig(ctx, raw, baseHash)
+	}
+	defer c.startWrite()()
+	params := map[string]any{
+		"raw": raw,
+	}
+	if baseHash != "" {
+		params["baseHash"] = baseHash
+	}
+	if err := c.validate(ctx, "config.apply", params); err != nil {
+		return err
+	}
+
+	resp, err := c.call(ctx, "config.import", params)
+	if err != nil {
+		return err
+	}
+	if resp.OK != nil && *resp.OK {
+		c.recordHash(responseHash(resp.Payload))
+		return nil
+	}
+	if !unknownMethod(resp.Error) {
+		return c.failed("config.import", resp)
+	}
+	c.noImport.Store(true)
+	return c.ApplyConfig(ctx, raw, baseHash)
+}
+
 // Health implements Client.
 func (c *WSClient) Health(ctx context.Context) (*HealthPayload, error) {
 	resp, err := c.call(ctx, "health", map[string]any{})
@@ -381,7 +978,7 @@ func (c *WSClient) Health(ctx context.Context) (*HealthPayload, error) {
 		return nil, err
 	}
 	if resp.OK == nil || !*resp.OK {
-		return nil, fmt.Errorf("health failed: %v", resp.Error)
+		return nil, c.failed("health", resp)
 	}
 
 	payloadBytes, err := json.Marshal(resp.Payload)
@@ -397,7 +994,42 @@ func (c *WSClient) Health(ctx context.Context) (*HealthPayload, error) {
 	return &health, nil
 }
 
+// Call implements Client.
+func (c *WSClient) Call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	if params == nil {
+		params = map[string]any{}
+	}
+	write := !replayable(method)
+	if write {
+		// The RPC may change the config.
+		defer c.startWrite()()
+	}
+	resp, err := c.call(ctx, method, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.OK == nil || !*resp.OK {
+		return nil, c.failed(method, resp)
+	}
+	if write {
+		c.recordHash(responseHash(resp.Payload))
+	}
+	payload, err := json.Marshal(resp.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s payload: %w", method, err)
+	}
+	return payload, nil
+}
+
 // Close implements Client.
 func (c *WSClient) Close() error {
-	return c.conn.Close()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	c.closeSubscriptions()
+	err := c.sess.conn.Close()
+	if c.tunnel != nil {
+		c.tunnel.Close()
+	}
+	return err
 }
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redacted replaces secret values in traced frames.
+const redacted = "***"
+
+// traceFrame logs a WebSocket frame at TRACE level, so TF_LOG=TRACE shows the
+// full protocol exchange. Credentials are redacted first, including those
+// inside raw config documents. bytes is the size of the message as encoded,
+// which shows how much of an apply goes into transferring large configs.
+func traceFrame(ctx context.Context, direction string, frame wsFrame) {
+	data, err := json.Marshal(frame)
+	if err != nil {
+		return
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return
+	}
+	out, err := json.Marshal(redact(v))
+	if err != nil {
+		return
+	}
+	tflog.Trace(ctx, "gateway frame", map[string]any{
+		"direction": direction,
+		"method":    frame.Method,
+		"id":        frame.ID,
+		"bytes":     frame.size,
+		"frame":     string(out),
+	})
+}
+
+// redact returns v with the string values of secret-looking keys replaced.
+// Strings under a "raw" key hold whole config documents and are redacted as
+// JSON (or JSON5) in turn. Environment variables often hold secrets under
+// arbitrary names, so all values under env.vars are redacted, wherever the
+// env section is: at the top level or on an agents.list entry.
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			s, isString := val.(string)
+			switch {
+			case isString && isSecretKey(k):
+				out[k] = redacted
+			case isString && k == "raw":
+				out[k] = redactRaw(s)
+			case k == "env":
+				out[k] = redactEnv(val)
+			default:
+				out[k] = redact(val)
+			}
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = redact(val)
+		}
+		return out
+	}
+	return v
+}
+
+// redactEnv redacts an env section, replacing every string in its vars.
+func redactEnv(v any) any {
+	out := redact(v)
+	env, ok := out.(map[string]any)
+	if !ok {
+		return out
+	}
+	if vars, ok := env["vars"].(map[string]any); ok {
+		for name, val := range vars {
+			if _, isString := val.(string); isString {
+				vars[name] = redacted
+			}
+		}
+	}
+	return env
+}
+
+func redactRaw(s string) string {
+	parsed, err := parseRawJSON(s)
+	if err != nil {
+		// Unparseable config may still contain secrets; leave it out.
+		return redacted
+	}
+	out, err := json.Marshal(redact(parsed))
+	if err != nil {
+		return redacted
+	}
+	return string(out)
+}
+
+// isSecretKey reports whether a config or protocol key holds a credential,
+// such as token, botToken, apiKey, password or signingSecret.
+func isSecretKey(k string) bool {
+	k = strings.ToLower(k)
+	for _, s := range []string{"token", "apikey", "password", "secret", "signature", "privatekey"} {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
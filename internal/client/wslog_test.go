@@ -0,0 +1,166 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedact(t *testing.T) {
+	in := map[string]any{
+		"auth": map[string]any{"token": "s3cret", "password": "hunter2"},
+		"params": map[string]any{
+			"raw": `{channels: {telegram: {botToken: "123:abc", enabled: true}}, // json5
+  models: {providers: {openai: {apiKey: "sk-live"}}}, agents: {defaults: {maxTokens: 4096}}}`,
+		},
+		"list": []any{map[string]any{"signingSecret": "xyz", "name": "ok"}},
+	}
+	out := redact(in).(map[string]any)
+
+	auth := out["auth"].(map[string]any)
+	if auth["token"] != redacted || auth["password"] != redacted {
+		t.Errorf("auth not redacted: %v", auth)
+	}
+	raw := out["params"].(map[string]any)["raw"].(string)
+	for _, secret := range []string{"123:abc", "sk-live"} {
+		if strings.Contains(raw, secret) {
+			t.Errorf("raw config leaks %q: %s", secret, raw)
+		}
+	}
+	if !strings.Contains(raw, `"maxTokens":4096`) || !strings.Contains(raw, `"enabled":true`) {
+		t.Errorf("raw config lost non-secret values: %s", raw)
+	}
+	item := out["list"].([]any)[0].(map[string]any)
+	if item["signingSecret"] != redacted || item["name"] != "ok" {
+		t.Errorf("list item = %v", item)
+	}
+
+	if got := redactRaw("{not config"); got != redacted {
+		t.Errorf("unparseable raw = %q", got)
+	}
+}
+
+func TestRedactEnvVars(t *testing.T) {
+	in := map[string]any{
+		"method": "config.patch",
+		"params": map[string]any{"raw": `{"env":{"vars":{"BILLING_DSN":"postgres://u:pw@db","OLD":null},"shellEnv":{"enabled":true}}}`},
+	}
+	raw := redact(in).(map[string]any)["params"].(map[string]any)["raw"].(string)
+	if strings.Contains(raw, "postgres://") {
+		t.Errorf("env var value leaked: %s", raw)
+	}
+	if !strings.Contains(raw, `"OLD":null`) || !strings.Contains(raw, `"enabled":true`) {
+		t.Errorf("env section lost non-secret values: %s", raw)
+	}
+}
+
+func TestRedactAgentEnvVars(t *testing.T) {
+	in := map[string]any{
+		"method": "config.patch",
+		"params": map[string]any{"raw": `{"agents":{"list":[{"id":"main","env":{"vars":{"GH_PAT":"ghp_abc"}}},{"id":"ops"}]}}`},
+	}
+	raw := redact(in).(map[string]any)["params"].(map[string]any)["raw"].(string)
+	if strings.Contains(raw, "ghp_abc") {
+		t.Errorf("agent env var value leaked: %s", raw)
+	}
+	if !strings.Contains(raw, `"id":"ops"`) {
+		t.Errorf("agents list lost non-secret values: %s", raw)
+	}
+}
+
+func TestWSClient_TraceFrames(t *testing.T) {
+	g := newFakeGateway(t)
+	g.setRaw(`{"channels":{"telegram":{"botToken":"123:abc"}}}`)
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{URL: g.URL(), Token: "gateway-token"})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	defer c.Close()
+	if _, err := c.GetConfig(ctx); err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var frames []string
+	for _, e := range entries {
+		if e["@message"] != "gateway frame" {
+			continue
+		}
+		if e["@level"] != "trace" {
+			t.Errorf("frame logged at %v", e["@level"])
+		}
+		frames = append(frames, strings.TrimSpace(e["direction"].(string)+" "+e["method"].(string)))
+		frame := e["frame"].(string)
+		for _, secret := range []string{"gateway-token", "123:abc"} {
+			if strings.Contains(frame, secret) {
+				t.Errorf("frame leaks %q: %s", secret, frame)
+			}
+		}
+	}
+	// The challenge event, then each request and its response.
+	want := "recv,send connect,recv,send config.get,recv"
+	if got := strings.Join(frames, ","); got != want {
+		t.Errorf("traced frames = %q, want %q", got, want)
+	}
+}
+
+func TestWSClient_CompressesLargeConfigs(t *testing.T) {
+	g := newFakeGateway(t)
+	g.compress = true
+	big := `{"agents":{"list":[` + strings.Repeat(`{"id":"agent","model":"anthropic/claude"},`, 5000) + `{"id":"last"}]}}`
+	g.setRaw(big)
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	c, err := NewWSClient(ctx, WSClientConfig{URL: g.URL()})
+	if err != nil {
+		t.Fatalf("NewWSClient: %v", err)
+	}
+	defer c.Close()
+	cfg, err := c.GetConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetConfig: %v", err)
+	}
+	if cfg.Raw != big {
+		t.Fatalf("raw config changed in transit (%d bytes, want %d)", len(cfg.Raw), len(big))
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var negotiated bool
+	var received float64
+	for _, e := range entries {
+		switch e["@message"] {
+		case "gateway connection opened":
+			negotiated, _ = e["compression"].(bool)
+		case "gateway frame":
+			if e["direction"] == "recv" && e["method"] == "" && e["id"] != "" {
+				received, _ = e["bytes"].(float64)
+			}
+		}
+	}
+	if !negotiated {
+		t.Error("permessage-deflate was not negotiated")
+	}
+	if int(received) < len(big) {
+		t.Errorf("config.get response logged as %v bytes, want at least %d", received, len(big))
+	}
+}
@@ -0,0 +1,254 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &AgentDataSource{}
+
+type AgentDataSource struct {
+	gatewayTarget
+}
+
+type AgentDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	AgentID         types.String `tfsdk:"agent_id"`
+	IsDefault       types.Bool   `tfsdk:"is_default"`
+	Name            types.String `tfsdk:"name"`
+	Workspace       types.String `tfsdk:"workspace"`
+	Model           types.String `tfsdk:"model"`
+	Persona         types.String `tfsdk:"persona"`
+	IdentityName    types.String `tfsdk:"identity_name"`
+	IdentityEmoji   types.String `tfsdk:"identity_emoji"`
+	IdentityTheme   types.String `tfsdk:"identity_theme"`
+	MentionPatterns types.List   `tfsdk:"mention_patterns"`
+	SandboxMode     types.String `tfsdk:"sandbox_mode"`
+	SandboxScope    types.String `tfsdk:"sandbox_scope"`
+	ToolsProfile    types.String `tfsdk:"tools_profile"`
+	ToolsAllow      types.List   `tfsdk:"tools_allow"`
+	ToolsDeny       types.List   `tfsdk:"tools_deny"`
+	ConfigJSON      types.String `tfsdk:"config_json"`
+}
+
+func NewAgentDataSource() datasource.DataSource {
+	return &AgentDataSource{}
+}
+
+func (d *AgentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent"
+}
+
+func (d *AgentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a single agent entry from agents.list[] by ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"agent_id": schema.StringAttribute{
+				Description: "ID of the agent to read.",
+				Required:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default agent.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Agent display name.",
+				Computed:    true,
+			},
+			"workspace": schema.StringAttribute{
+				Description: "Workspace path for this agent.",
+				Computed:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "Model assigned to this agent.",
+				Computed:    true,
+			},
+			"persona": schema.StringAttribute{
+				Description: "Persona assigned to this agent.",
+				Computed:    true,
+			},
+			"identity_name": schema.StringAttribute{
+				Description: "Name from the agent identity.",
+				Computed:    true,
+			},
+			"identity_emoji": schema.StringAttribute{
+				Description: "Emoji from the agent identity.",
+				Computed:    true,
+			},
+			"identity_theme": schema.StringAttribute{
+				Description: "Theme from the agent identity.",
+				Computed:    true,
+			},
+			"mention_patterns": schema.ListAttribute{
+				Description: "Patterns that mention this agent in group chats.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"sandbox_mode": schema.StringAttribute{
+				Description: "Sandbox mode for this agent.",
+				Computed:    true,
+			},
+			"sandbox_scope": schema.StringAttribute{
+				Description: "Sandbox scope for this agent.",
+				Computed:    true,
+			},
+			"tools_profile": schema.StringAttribute{
+				Description: "Tools profile for this agent.",
+				Computed:    true,
+			},
+			"tools_allow": schema.ListAttribute{
+				Description: "Tools allowed for this agent.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"tools_deny": schema.ListAttribute{
+				Description: "Tools denied to this agent.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"config_json": schema.StringAttribute{
+				Description: "The full agent entry as JSON, without its environment variables.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AgentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *AgentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	var agentID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("agent_id"), &agentID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetSection(ctx, d.client, "agents")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agents config", err.Error())
+		return
+	}
+
+	var agent map[string]any
+	list, _ := section["list"].([]any)
+	for _, item := range list {
+		if m, ok := item.(map[string]any); ok && m["id"] == agentID.ValueString() {
+			agent = m
+			break
+		}
+	}
+	if agent == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("agent_id"), "Agent not found",
+			fmt.Sprintf("No agent with id %q in agents.list.", agentID.ValueString()))
+		return
+	}
+
+	state := AgentDataSourceModel{
+		ID:        types.StringValue(agentID.ValueString()),
+		Gateway:   gateway,
+		AgentID:   agentID,
+		IsDefault: types.BoolValue(agent["default"] == true),
+		Name:      stringField(agent, "name"),
+		Workspace: stringField(agent, "workspace"),
+		Model:     stringField(agent, "model"),
+		Persona:   stringField(agent, "persona"),
+	}
+
+	identity, _ := agent["identity"].(map[string]any)
+	state.IdentityName = stringField(identity, "name")
+	state.IdentityEmoji = stringField(identity, "emoji")
+	state.IdentityTheme = stringField(identity, "theme")
+
+	groupChat, _ := agent["groupChat"].(map[string]any)
+	state.MentionPatterns = stringListField(groupChat, "mentionPatterns", &resp.Diagnostics)
+
+	// Sandbox settings are flat on entries written by openclaw_agent, and
+	// nested under sandbox on entries written by hand.
+	state.SandboxMode = stringField(agent, "sandboxMode")
+	state.SandboxScope = stringField(agent, "sandboxScope")
+	if sb, ok := agent["sandbox"].(map[string]any); ok {
+		if state.SandboxMode.IsNull() {
+			state.SandboxMode = stringField(sb, "mode")
+		}
+		if state.SandboxScope.IsNull() {
+			state.SandboxScope = stringField(sb, "scope")
+		}
+	}
+
+	tools, _ := agent["tools"].(map[string]any)
+	state.ToolsProfile = stringField(tools, "profile")
+	state.ToolsAllow = stringListField(tools, "allow", &resp.Diagnostics)
+	state.ToolsDeny = stringListField(tools, "deny", &resp.Diagnostics)
+
+	// Environment variables are left out: they are often secrets, and
+	// openclaw_agent_env marks them sensitive.
+	entry := maps.Clone(agent)
+	delete(entry, "env")
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode agent entry", err.Error())
+		return
+	}
+	state.ConfigJSON = types.StringValue(string(raw))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// stringField returns m[key] if it is a non-empty string, or null.
+func stringField(m map[string]any, key string) types.String {
+	s, _ := m[key].(string)
+	return stringOrNull(s)
+}
+
+// stringListField returns m[key] as a list of strings, or null if it is not
+// set. Numbers are kept as their decimal form: numeric user IDs, as
+// Telegram uses, may have been written to allowFrom as numbers.
+func stringListField(m map[string]any, key string, diags *diag.Diagnostics) types.List {
+	items, ok := m[key].([]any)
+	if !ok {
+		return types.ListNull(types.StringType)
+	}
+	values := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case string:
+			values = append(values, types.StringValue(v))
+		case float64:
+			values = append(values, types.StringValue(strconv.FormatFloat(v, 'f', -1, 64)))
+		}
+	}
+	list, d := types.ListValue(types.StringType, values)
+	diags.Append(d...)
+	return list
+}
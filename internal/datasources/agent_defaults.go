@@ -15,11 +15,12 @@ import (
 var _ datasource.DataSource = &AgentDefaultsDataSource{}
 
 type AgentDefaultsDataSource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type AgentDefaultsDataSourceModel struct {
 	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
 	Workspace       types.String `tfsdk:"workspace"`
 	ModelPrimary    types.String `tfsdk:"model_primary"`
 	ThinkingDefault types.String `tfsdk:"thinking_default"`
@@ -47,6 +48,7 @@ func (d *AgentDefaultsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"gateway": gatewayAttribute(),
 			"workspace": schema.StringAttribute{
 				Description: "Default agent workspace path.",
 				Computed:    true,
@@ -100,10 +102,14 @@ func (d *AgentDefaultsDataSource) Configure(_ context.Context, req datasource.Co
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	d.client = pd.Client
+	d.setProviderData(pd)
 }
 
-func (d *AgentDefaultsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *AgentDefaultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, d.client, "agents", "defaults")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read agent defaults config", err.Error())
@@ -111,7 +117,8 @@ func (d *AgentDefaultsDataSource) Read(ctx context.Context, _ datasource.ReadReq
 	}
 
 	state := AgentDefaultsDataSourceModel{
-		ID: types.StringValue("agent_defaults"),
+		ID:      types.StringValue("agent_defaults"),
+		Gateway: gateway,
 	}
 
 	if section != nil {
@@ -16,11 +16,12 @@ import (
 var _ datasource.DataSource = &AgentsDataSource{}
 
 type AgentsDataSource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type AgentsDataSourceModel struct {
 	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
 	DefaultAgentID types.String `tfsdk:"default_agent_id"`
 	AgentIDs       types.List   `tfsdk:"agent_ids"`
 	Agents         types.List   `tfsdk:"agents"`
@@ -53,6 +54,7 @@ func (d *AgentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"gateway": gatewayAttribute(),
 			"default_agent_id": schema.StringAttribute{
 				Description: "The agent ID marked as default.",
 				Computed:    true,
@@ -111,10 +113,14 @@ func (d *AgentsDataSource) Configure(_ context.Context, req datasource.Configure
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	d.client = pd.Client
+	d.setProviderData(pd)
 }
 
-func (d *AgentsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *AgentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetSection(ctx, d.client, "agents")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read agents config", err.Error())
@@ -122,7 +128,8 @@ func (d *AgentsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, r
 	}
 
 	state := AgentsDataSourceModel{
-		ID: types.StringValue("agents"),
+		ID:      types.StringValue("agents"),
+		Gateway: gateway,
 	}
 
 	var agentIDs []string
@@ -0,0 +1,185 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &BindingsDataSource{}
+
+type BindingsDataSource struct {
+	gatewayTarget
+}
+
+type BindingsDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Bindings        types.List   `tfsdk:"bindings"`
+	UnboundChannels types.List   `tfsdk:"unbound_channels"`
+}
+
+var bindingObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"agent_id":   types.StringType,
+		"channel":    types.StringType,
+		"account_id": types.StringType,
+		"peer_kind":  types.StringType,
+		"peer_id":    types.StringType,
+	},
+}
+
+func NewBindingsDataSource() datasource.DataSource {
+	return &BindingsDataSource{}
+}
+
+func (d *BindingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bindings"
+}
+
+func (d *BindingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the agent bindings in the bindings array, in the order they are matched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"bindings": schema.ListNestedAttribute{
+				Description: "Bindings in config order. The first binding that matches a message routes it.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"agent_id": schema.StringAttribute{
+							Description: "Agent that matching messages are routed to.",
+							Computed:    true,
+						},
+						"channel": schema.StringAttribute{
+							Description: "Channel the binding matches.",
+							Computed:    true,
+						},
+						"account_id": schema.StringAttribute{
+							Description: "Channel account the binding matches. Null when it matches every account.",
+							Computed:    true,
+						},
+						"peer_kind": schema.StringAttribute{
+							Description: "Kind of peer the binding matches: dm or group. Null when it matches every peer.",
+							Computed:    true,
+						},
+						"peer_id": schema.StringAttribute{
+							Description: "ID of the peer the binding matches. Null when it matches every peer.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"unbound_channels": schema.ListAttribute{
+				Description: "Configured channels without a binding for every account and peer, sorted by name. " +
+					"Some or all of their messages go to the default agent.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *BindingsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *BindingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	sec, err := d.client.GetConfigSection(ctx, "bindings")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read bindings config", err.Error())
+		return
+	}
+	var list []any
+	if sec.Value != nil {
+		if list, ok = sec.Value.([]any); !ok {
+			resp.Diagnostics.AddError("Failed to read bindings config", "bindings is not an array")
+			return
+		}
+	}
+	channels, _, err := client.GetSection(ctx, d.client, "channels")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read channels config", err.Error())
+		return
+	}
+
+	// A channel is bound when a binding covers all of it: no account or
+	// peer narrows the match.
+	bound := map[string]bool{}
+	items := make([]attr.Value, 0, len(list))
+	for _, item := range list {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		agentID, _ := entry["agentId"].(string)
+		match, _ := entry["match"].(map[string]any)
+		channel, _ := match["channel"].(string)
+		accountID, _ := match["accountId"].(string)
+		var peerKind, peerID string
+		if peer, ok := match["peer"].(map[string]any); ok {
+			peerKind, _ = peer["kind"].(string)
+			peerID, _ = peer["id"].(string)
+		}
+		if accountID == "" && peerKind == "" && peerID == "" {
+			bound[channel] = true
+		}
+
+		obj, diags := types.ObjectValue(bindingObjectType.AttrTypes, map[string]attr.Value{
+			"agent_id":   stringOrNull(agentID),
+			"channel":    stringOrNull(channel),
+			"account_id": stringOrNull(accountID),
+			"peer_kind":  stringOrNull(peerKind),
+			"peer_id":    stringOrNull(peerID),
+		})
+		resp.Diagnostics.Append(diags...)
+		items = append(items, obj)
+	}
+
+	unbound := []string{}
+	for name, val := range channels {
+		if _, ok := val.(map[string]any); ok && !bound[name] {
+			unbound = append(unbound, name)
+		}
+	}
+	sort.Strings(unbound)
+
+	bindings, diags := types.ListValue(bindingObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	unboundList, diags := types.ListValueFrom(ctx, types.StringType, unbound)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := BindingsDataSourceModel{
+		ID:              types.StringValue("bindings"),
+		Gateway:         gateway,
+		Bindings:        bindings,
+		UnboundChannels: unboundList,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,139 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &ChannelDataSource{}
+
+type ChannelDataSource struct {
+	gatewayTarget
+}
+
+type ChannelDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Gateway    types.String `tfsdk:"gateway"`
+	Name       types.String `tfsdk:"name"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	DmPolicy   types.String `tfsdk:"dm_policy"`
+	AllowFrom  types.List   `tfsdk:"allow_from"`
+	ConfigJSON types.String `tfsdk:"config_json"`
+}
+
+func NewChannelDataSource() datasource.DataSource {
+	return &ChannelDataSource{}
+}
+
+func (d *ChannelDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel"
+}
+
+func (d *ChannelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the configuration of a single channel by name, whatever its type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Channel name, the key under channels (e.g. telegram, whatsapp, discord).",
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the channel is enabled. Channels without an explicit enabled field are considered enabled.",
+				Computed:    true,
+			},
+			"dm_policy": schema.StringAttribute{
+				Description: "DM policy for this channel.",
+				Computed:    true,
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "Senders allowed to DM the channel.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"config_json": schema.StringAttribute{
+				Description: "The full channel configuration as JSON. Sensitive, as it includes the channel's credentials.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (d *ChannelDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *ChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, d.client, "channels", name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read channel config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Channel not found",
+			fmt.Sprintf("No channel %q under channels.", name.ValueString()))
+		return
+	}
+
+	enabled := true
+	if v, ok := section["enabled"].(bool); ok {
+		enabled = v
+	}
+
+	state := ChannelDataSourceModel{
+		ID:        types.StringValue(name.ValueString()),
+		Gateway:   gateway,
+		Name:      name,
+		Enabled:   types.BoolValue(enabled),
+		DmPolicy:  stringField(section, "dmPolicy"),
+		AllowFrom: stringListField(section, "allowFrom", &resp.Diagnostics),
+	}
+	// Some channels keep their DM settings under dm instead.
+	if dm, ok := section["dm"].(map[string]any); ok {
+		if state.DmPolicy.IsNull() {
+			state.DmPolicy = stringField(dm, "policy")
+		}
+		if state.AllowFrom.IsNull() {
+			state.AllowFrom = stringListField(dm, "allowFrom", &resp.Diagnostics)
+		}
+	}
+
+	raw, err := json.Marshal(section)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode channel config", err.Error())
+		return
+	}
+	state.ConfigJSON = types.StringValue(string(raw))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
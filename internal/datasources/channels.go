@@ -16,11 +16,12 @@ import (
 var _ datasource.DataSource = &ChannelsDataSource{}
 
 type ChannelsDataSource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ChannelsDataSourceModel struct {
 	ID       types.String `tfsdk:"id"`
+	Gateway  types.String `tfsdk:"gateway"`
 	Names    types.List   `tfsdk:"names"`
 	Channels types.List   `tfsdk:"channels"`
 }
@@ -48,6 +49,7 @@ func (d *ChannelsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"gateway": gatewayAttribute(),
 			"names": schema.ListAttribute{
 				Description: "List of configured channel names (e.g. whatsapp, telegram, discord).",
 				Computed:    true,
@@ -86,10 +88,14 @@ func (d *ChannelsDataSource) Configure(_ context.Context, req datasource.Configu
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	d.client = pd.Client
+	d.setProviderData(pd)
 }
 
-func (d *ChannelsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *ChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetSection(ctx, d.client, "channels")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read channels config", err.Error())
@@ -97,7 +103,8 @@ func (d *ChannelsDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 	}
 
 	state := ChannelsDataSourceModel{
-		ID: types.StringValue("channels"),
+		ID:      types.StringValue("channels"),
+		Gateway: gateway,
 	}
 
 	var names []string
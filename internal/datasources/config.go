@@ -8,20 +8,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
 	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
 )
 
 var _ datasource.DataSource = &ConfigDataSource{}
 
 type ConfigDataSource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ConfigDataSourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Raw  types.String `tfsdk:"raw"`
-	Hash types.String `tfsdk:"hash"`
+	ID      types.String `tfsdk:"id"`
+	Gateway types.String `tfsdk:"gateway"`
+	Raw     types.String `tfsdk:"raw"`
+	Hash    types.String `tfsdk:"hash"`
 }
 
 func NewConfigDataSource() datasource.DataSource {
@@ -39,6 +39,7 @@ func (d *ConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"gateway": gatewayAttribute(),
 			"raw": schema.StringAttribute{
 				Description: "The raw JSON config string.",
 				Computed:    true,
@@ -60,10 +61,14 @@ func (d *ConfigDataSource) Configure(_ context.Context, req datasource.Configure
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	d.client = pd.Client
+	d.setProviderData(pd)
 }
 
-func (d *ConfigDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *ConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	cfg, err := d.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read OpenClaw config", err.Error())
@@ -71,9 +76,10 @@ func (d *ConfigDataSource) Read(ctx context.Context, _ datasource.ReadRequest, r
 	}
 
 	state := ConfigDataSourceModel{
-		ID:   types.StringValue("config"),
-		Raw:  types.StringValue(cfg.Raw),
-		Hash: types.StringValue(cfg.Hash),
+		ID:      types.StringValue("config"),
+		Gateway: gateway,
+		Raw:     types.StringValue(cfg.Raw),
+		Hash:    types.StringValue(cfg.Hash),
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
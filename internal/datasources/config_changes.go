@@ -0,0 +1,144 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &ConfigChangesDataSource{}
+
+// ConfigChangesDataSource reports the config changes the gateway announced
+// while the provider was connected, and warns about those Terraform did not
+// make, whose effect a plan may not show.
+type ConfigChangesDataSource struct {
+	gatewayTarget
+}
+
+type ConfigChangesDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Gateway   types.String `tfsdk:"gateway"`
+	OutOfBand types.Bool   `tfsdk:"out_of_band"`
+	Changes   types.List   `tfsdk:"changes"`
+}
+
+var configChangeObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"hash":        types.StringType,
+		"observed_at": types.StringType,
+		"out_of_band": types.BoolType,
+	},
+}
+
+func NewConfigChangesDataSource() datasource.DataSource {
+	return &ConfigChangesDataSource{}
+}
+
+func (d *ConfigChangesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_changes"
+}
+
+func (d *ConfigChangesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the config changes the gateway announced while the provider was connected during this run, " +
+			"and warns when any were not made by this provider. Changes from before the provider connected are not seen. " +
+			"Requires WebSocket mode.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"out_of_band": schema.BoolAttribute{
+				Description: "Whether any change was made by someone other than this provider, judged by comparing its hash with the hashes this provider's writes returned.",
+				Computed:    true,
+			},
+			"changes": schema.ListNestedAttribute{
+				Description: "Changes in the order they were announced.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hash": schema.StringAttribute{
+							Description: "Config hash after the change, if the gateway sent one.",
+							Computed:    true,
+						},
+						"observed_at": schema.StringAttribute{
+							Description: "When the provider received the announcement (RFC 3339).",
+							Computed:    true,
+						},
+						"out_of_band": schema.BoolAttribute{
+							Description: "Whether the change was made by someone other than this provider.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConfigChangesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *ConfigChangesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	changes, ok := client.ConfigChanges(d.client)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to read config changes",
+			"config change events are only available in WebSocket mode (no running gateway in file mode)")
+		return
+	}
+
+	outOfBand := 0
+	items := make([]attr.Value, 0, len(changes))
+	for _, ch := range changes {
+		if ch.OutOfBand {
+			outOfBand++
+		}
+		obj, diags := types.ObjectValue(configChangeObjectType.AttrTypes, map[string]attr.Value{
+			"hash":        types.StringValue(ch.Hash),
+			"observed_at": types.StringValue(ch.At.UTC().Format(time.RFC3339)),
+			"out_of_band": types.BoolValue(ch.OutOfBand),
+		})
+		resp.Diagnostics.Append(diags...)
+		items = append(items, obj)
+	}
+	list, diags := types.ListValue(configChangeObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if outOfBand > 0 {
+		resp.Diagnostics.AddWarning("Config changed outside Terraform",
+			fmt.Sprintf("The gateway reported %d config change(s) made by someone else while this run was in progress. "+
+				"Resources read before the change may not reflect it; run terraform plan again to review it.", outOfBand))
+	}
+
+	state := ConfigChangesDataSourceModel{
+		ID:        types.StringValue("config_changes"),
+		Gateway:   gateway,
+		OutOfBand: types.BoolValue(outOfBand > 0),
+		Changes:   list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,193 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &CronJobsDataSource{}
+
+type CronJobsDataSource struct {
+	gatewayTarget
+}
+
+type CronJobsDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Gateway types.String `tfsdk:"gateway"`
+	JobIDs  types.List   `tfsdk:"job_ids"`
+	Jobs    types.List   `tfsdk:"jobs"`
+}
+
+var cronJobObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"job_id":        types.StringType,
+		"name":          types.StringType,
+		"agent_id":      types.StringType,
+		"enabled":       types.BoolType,
+		"schedule_kind": types.StringType,
+		"schedule":      types.StringType,
+		"timezone":      types.StringType,
+		"last_run_at":   types.StringType,
+		"last_status":   types.StringType,
+		"next_run_at":   types.StringType,
+	},
+}
+
+func NewCronJobsDataSource() datasource.DataSource {
+	return &CronJobsDataSource{}
+}
+
+func (d *CronJobsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cron_jobs"
+}
+
+func (d *CronJobsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the cron jobs in the gateway's job store, including disabled jobs and jobs created from chat. Requires WebSocket mode.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"job_ids": schema.ListAttribute{
+				Description: "IDs of all jobs.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"jobs": schema.ListNestedAttribute{
+				Description: "Jobs, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"job_id": schema.StringAttribute{
+							Description: "Job identifier.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Job display name.",
+							Computed:    true,
+						},
+						"agent_id": schema.StringAttribute{
+							Description: "Agent the job runs as. Null for the default agent.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the job is enabled.",
+							Computed:    true,
+						},
+						"schedule_kind": schema.StringAttribute{
+							Description: "How the job is scheduled: cron, every or at.",
+							Computed:    true,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "The cron expression for cron, the interval (e.g. 15m0s) for every, or the run time (RFC 3339) for at.",
+							Computed:    true,
+						},
+						"timezone": schema.StringAttribute{
+							Description: "Timezone of a cron expression. Null for the gateway's timezone.",
+							Computed:    true,
+						},
+						"last_run_at": schema.StringAttribute{
+							Description: "When the job last ran (RFC 3339). Null if it has not run.",
+							Computed:    true,
+						},
+						"last_status": schema.StringAttribute{
+							Description: "Outcome of the last run (e.g. ok, error, skipped). Null if it has not run.",
+							Computed:    true,
+						},
+						"next_run_at": schema.StringAttribute{
+							Description: "When the job runs next (RFC 3339). Null if it is not scheduled to run again.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CronJobsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *CronJobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	jobs, err := client.ListCronJobs(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list cron jobs", err.Error())
+		return
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+
+	ids := make([]string, 0, len(jobs))
+	items := make([]attr.Value, 0, len(jobs))
+	for _, j := range jobs {
+		ids = append(ids, j.ID)
+		obj, diags := types.ObjectValue(cronJobObjectType.AttrTypes, map[string]attr.Value{
+			"job_id":        types.StringValue(j.ID),
+			"name":          stringOrNull(j.Name),
+			"agent_id":      stringOrNull(j.AgentID),
+			"enabled":       types.BoolValue(j.Enabled),
+			"schedule_kind": stringOrNull(j.Schedule.Kind),
+			"schedule":      cronScheduleString(j.Schedule),
+			"timezone":      stringOrNull(j.Schedule.TZ),
+			"last_run_at":   millisOrNull(j.State.LastRunAtMs),
+			"last_status":   stringOrNull(j.State.LastStatus),
+			"next_run_at":   millisOrNull(j.State.NextRunAtMs),
+		})
+		resp.Diagnostics.Append(diags...)
+		items = append(items, obj)
+	}
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	list, diags := types.ListValue(cronJobObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := CronJobsDataSourceModel{
+		ID:      types.StringValue("cron_jobs"),
+		Gateway: gateway,
+		JobIDs:  idList,
+		Jobs:    list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// cronScheduleString renders a schedule as a single string, in the form its
+// kind is usually written in.
+func cronScheduleString(s client.CronSchedule) types.String {
+	switch s.Kind {
+	case "cron":
+		return stringOrNull(s.Expr)
+	case "every":
+		if s.EveryMs > 0 {
+			return types.StringValue((time.Duration(s.EveryMs) * time.Millisecond).String())
+		}
+	case "at":
+		return millisOrNull(s.AtMs)
+	}
+	return types.StringNull()
+}
@@ -0,0 +1,186 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &DevicesDataSource{}
+
+type DevicesDataSource struct {
+	gatewayTarget
+}
+
+type DevicesDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Gateway   types.String `tfsdk:"gateway"`
+	DeviceIDs types.List   `tfsdk:"device_ids"`
+	Devices   types.List   `tfsdk:"devices"`
+}
+
+var deviceObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"device_id":      types.StringType,
+		"display_name":   types.StringType,
+		"public_key":     types.StringType,
+		"role":           types.StringType,
+		"scopes":         types.ListType{ElemType: types.StringType},
+		"last_seen_at":   types.StringType,
+		"client_id":      types.StringType,
+		"client_mode":    types.StringType,
+		"client_version": types.StringType,
+		"platform":       types.StringType,
+	},
+}
+
+func NewDevicesDataSource() datasource.DataSource {
+	return &DevicesDataSource{}
+}
+
+func (d *DevicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices"
+}
+
+func (d *DevicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the devices paired with the gateway and the access they hold. Requires WebSocket mode.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"device_ids": schema.ListAttribute{
+				Description: "IDs of all paired devices.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"devices": schema.ListNestedAttribute{
+				Description: "Paired devices, sorted by ID. Devices waiting for approval are not included.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.StringAttribute{
+							Description: "Device identifier.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "Name the device reported when pairing.",
+							Computed:    true,
+						},
+						"public_key": schema.StringAttribute{
+							Description: "Public key the device authenticates with.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role granted to the device (e.g. operator, node).",
+							Computed:    true,
+						},
+						"scopes": schema.ListAttribute{
+							Description: "Scopes granted to the device.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"last_seen_at": schema.StringAttribute{
+							Description: "When the device last connected (RFC 3339). Null if it has not connected since pairing.",
+							Computed:    true,
+						},
+						"client_id": schema.StringAttribute{
+							Description: "Client the device last connected with (e.g. openclaw-macos, cli).",
+							Computed:    true,
+						},
+						"client_mode": schema.StringAttribute{
+							Description: "Mode the client connected in (e.g. ui, cli, node).",
+							Computed:    true,
+						},
+						"client_version": schema.StringAttribute{
+							Description: "Version of the client.",
+							Computed:    true,
+						},
+						"platform": schema.StringAttribute{
+							Description: "Platform the client runs on (e.g. darwin, linux, ios).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DevicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	devices, err := client.ListDevices(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list paired devices", err.Error())
+		return
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].DeviceID < devices[j].DeviceID })
+
+	ids := make([]string, 0, len(devices))
+	items := make([]attr.Value, 0, len(devices))
+	for _, dev := range devices {
+		ids = append(ids, dev.DeviceID)
+		scopes, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(dev.Scopes))
+		resp.Diagnostics.Append(diags...)
+		obj, diags := types.ObjectValue(deviceObjectType.AttrTypes, map[string]attr.Value{
+			"device_id":      types.StringValue(dev.DeviceID),
+			"display_name":   stringOrNull(dev.DisplayName),
+			"public_key":     stringOrNull(dev.PublicKey),
+			"role":           stringOrNull(dev.Role),
+			"scopes":         scopes,
+			"last_seen_at":   millisOrNull(dev.LastSeenAtMs),
+			"client_id":      stringOrNull(dev.ClientID),
+			"client_mode":    stringOrNull(dev.ClientMode),
+			"client_version": stringOrNull(dev.ClientVersion),
+			"platform":       stringOrNull(dev.Platform),
+		})
+		resp.Diagnostics.Append(diags...)
+		items = append(items, obj)
+	}
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	list, diags := types.ListValue(deviceObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := DevicesDataSourceModel{
+		ID:        types.StringValue("devices"),
+		Gateway:   gateway,
+		DeviceIDs: idList,
+		Devices:   list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,164 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+)
+
+var _ datasource.DataSource = &DiscoveredGatewaysDataSource{}
+
+// DiscoveredGatewaysDataSource lists gateways found on the tailnet and LAN.
+// It does not use the provider connection, so it works in every mode.
+type DiscoveredGatewaysDataSource struct{}
+
+type DiscoveredGatewaysDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Sources  types.List   `tfsdk:"sources"`
+	Port     types.Int64  `tfsdk:"port"`
+	Timeout  types.String `tfsdk:"timeout"`
+	Gateways types.List   `tfsdk:"gateways"`
+}
+
+var discoveredGatewayObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":    types.StringType,
+		"host":    types.StringType,
+		"address": types.StringType,
+		"port":    types.Int64Type,
+		"url":     types.StringType,
+		"source":  types.StringType,
+	},
+}
+
+func NewDiscoveredGatewaysDataSource() datasource.DataSource {
+	return &DiscoveredGatewaysDataSource{}
+}
+
+func (d *DiscoveredGatewaysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discovered_gateways"
+}
+
+func (d *DiscoveredGatewaysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Finds OpenClaw gateways on the tailnet (online Tailscale peers listening on the gateway port) " +
+			"and the local network (mDNS _openclaw-gw._tcp advertisements).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"sources": schema.ListAttribute{
+				Description: "Sources to query, in order: tailscale, mdns. Default: both.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port probed on Tailscale peers. Default: 18789.",
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long each source may take, e.g. \"5s\". Default: 3s.",
+				Optional:    true,
+			},
+			"gateways": schema.ListNestedAttribute{
+				Description: "Gateways found, in source order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Tailscale hostname or mDNS instance name.",
+							Computed:    true,
+						},
+						"host": schema.StringAttribute{
+							Description: "DNS name of the machine (MagicDNS or .local name).",
+							Computed:    true,
+						},
+						"address": schema.StringAttribute{
+							Description: "IP address the gateway was found at.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Gateway port.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "WebSocket URL, usable as a provider gateway_url.",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "Where the gateway was found: tailscale or mdns.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DiscoveredGatewaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DiscoveredGatewaysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.DiscoverOptions{Port: int(state.Port.ValueInt64())}
+	if !state.Sources.IsNull() {
+		resp.Diagnostics.Append(state.Sources.ElementsAs(ctx, &opts.Sources, false)...)
+	}
+	for i, s := range opts.Sources {
+		if s != client.DiscoverTailscale && s != client.DiscoverMDNS {
+			resp.Diagnostics.AddAttributeError(path.Root("sources").AtListIndex(i), "Invalid discovery source",
+				fmt.Sprintf("%q is not a discovery source; use tailscale or mdns.", s))
+		}
+	}
+	if v := state.Timeout.ValueString(); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid timeout",
+				fmt.Sprintf("%q is not a positive duration such as \"5s\".", v))
+		}
+		opts.Timeout = timeout
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := client.DiscoverGateways(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to discover gateways", err.Error())
+		return
+	}
+
+	gateways := make([]attr.Value, 0, len(found))
+	for _, g := range found {
+		obj, diags := types.ObjectValue(discoveredGatewayObjectType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue(g.Name),
+			"host":    types.StringValue(g.Host),
+			"address": types.StringValue(g.Address),
+			"port":    types.Int64Value(int64(g.Port)),
+			"url":     types.StringValue(g.URL()),
+			"source":  types.StringValue(g.Source),
+		})
+		resp.Diagnostics.Append(diags...)
+		gateways = append(gateways, obj)
+	}
+	list, diags := types.ListValue(discoveredGatewayObjectType, gateways)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue("discovered_gateways")
+	state.Gateways = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -15,11 +15,12 @@ import (
 var _ datasource.DataSource = &GatewayDataSource{}
 
 type GatewayDataSource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type GatewayDataSourceModel struct {
 	ID            types.String `tfsdk:"id"`
+	Gateway       types.String `tfsdk:"gateway"`
 	Port          types.Int64  `tfsdk:"port"`
 	Bind          types.String `tfsdk:"bind"`
 	AuthMode      types.String `tfsdk:"auth_mode"`
@@ -43,6 +44,7 @@ func (d *GatewayDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"gateway": gatewayAttribute(),
 			"port": schema.Int64Attribute{
 				Description: "Gateway listen port.",
 				Computed:    true,
@@ -80,10 +82,14 @@ func (d *GatewayDataSource) Configure(_ context.Context, req datasource.Configur
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	d.client = pd.Client
+	d.setProviderData(pd)
 }
 
-func (d *GatewayDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *GatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetSection(ctx, d.client, "gateway")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read gateway config", err.Error())
@@ -91,7 +97,8 @@ func (d *GatewayDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 	}
 
 	state := GatewayDataSourceModel{
-		ID: types.StringValue("gateway"),
+		ID:      types.StringValue("gateway"),
+		Gateway: gateway,
 	}
 
 	if section != nil {
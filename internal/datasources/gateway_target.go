@@ -0,0 +1,54 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+// gatewayTarget is embedded in every data source. It keeps the provider data
+// and points client at the connection named by the gateway attribute.
+type gatewayTarget struct {
+	client client.Client
+	data   *shared.ProviderData
+}
+
+// gatewayAttribute is the schema for the gateway attribute shared by all data sources.
+func gatewayAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Name of a provider gateways block to read from. Defaults to the provider's primary connection.",
+		Optional:    true,
+	}
+}
+
+func (t *gatewayTarget) setProviderData(pd *shared.ProviderData) {
+	t.data = pd
+	t.client = pd.Client
+}
+
+// selectGateway points t.client at the gateway named in the data source
+// config and returns the attribute value so it can be copied into state.
+func (t *gatewayTarget) selectGateway(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) (types.String, bool) {
+	var name types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("gateway"), &name)...)
+	if diags.HasError() {
+		return name, false
+	}
+	if t.data == nil {
+		return name, true
+	}
+	c, err := t.data.ClientFor(ctx, name.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("gateway"), "Failed to select gateway", err.Error())
+		return name, false
+	}
+	t.client = c
+	return name, true
+}
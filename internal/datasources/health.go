@@ -8,18 +8,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
 	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
 )
 
 var _ datasource.DataSource = &HealthDataSource{}
 
 type HealthDataSource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type HealthDataSourceModel struct {
 	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
 	OK             types.Bool   `tfsdk:"ok"`
 	Timestamp      types.Int64  `tfsdk:"timestamp"`
 	DefaultAgentID types.String `tfsdk:"default_agent_id"`
@@ -41,6 +41,7 @@ func (d *HealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"gateway": gatewayAttribute(),
 			"ok": schema.BoolAttribute{
 				Description: "Whether the gateway health check passed.",
 				Computed:    true,
@@ -70,10 +71,14 @@ func (d *HealthDataSource) Configure(_ context.Context, req datasource.Configure
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	d.client = pd.Client
+	d.setProviderData(pd)
 }
 
-func (d *HealthDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	health, err := d.client.Health(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read Gateway health", err.Error())
@@ -82,6 +87,7 @@ func (d *HealthDataSource) Read(ctx context.Context, _ datasource.ReadRequest, r
 
 	state := HealthDataSourceModel{
 		ID:             types.StringValue("health"),
+		Gateway:        gateway,
 		OK:             types.BoolValue(health.OK),
 		Timestamp:      types.Int64Value(health.Timestamp),
 		DefaultAgentID: types.StringValue(health.DefaultAgentID),
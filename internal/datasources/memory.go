@@ -0,0 +1,130 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &MemoryDataSource{}
+
+type MemoryDataSource struct {
+	gatewayTarget
+}
+
+type MemoryDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Backend        types.String `tfsdk:"backend"`
+	EmbeddingModel types.String `tfsdk:"embedding_model"`
+	MaxEntries     types.Int64  `tfsdk:"max_entries"`
+	Retention      types.String `tfsdk:"retention"`
+	Scope          types.String `tfsdk:"scope"`
+}
+
+func NewMemoryDataSource() datasource.DataSource {
+	return &MemoryDataSource{}
+}
+
+func (d *MemoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_memory"
+}
+
+func (d *MemoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the current OpenClaw memory configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Whether long-term memory is enabled.",
+				Computed:    true,
+			},
+			"backend": schema.StringAttribute{
+				Description: "Storage backend for memories.",
+				Computed:    true,
+			},
+			"embedding_model": schema.StringAttribute{
+				Description: "Embedding model in provider/model format.",
+				Computed:    true,
+			},
+			"max_entries": schema.Int64Attribute{
+				Description: "Maximum number of stored memories.",
+				Computed:    true,
+			},
+			"retention": schema.StringAttribute{
+				Description: "How long memories are kept.",
+				Computed:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Memory scope: agent or shared.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *MemoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *MemoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, d.client, "memory")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read memory config", err.Error())
+		return
+	}
+
+	state := MemoryDataSourceModel{
+		ID:      types.StringValue("memory"),
+		Gateway: gateway,
+	}
+
+	if section != nil {
+		if v, ok := section["enabled"].(bool); ok {
+			state.Enabled = types.BoolValue(v)
+		}
+		if v, ok := section["backend"].(string); ok {
+			state.Backend = types.StringValue(v)
+		}
+		if v, ok := section["maxEntries"].(float64); ok {
+			state.MaxEntries = types.Int64Value(int64(v))
+		}
+		if v, ok := section["retention"].(string); ok {
+			state.Retention = types.StringValue(v)
+		}
+		if v, ok := section["scope"].(string); ok {
+			state.Scope = types.StringValue(v)
+		}
+
+		// Embedding settings are nested under memory.embedding
+		if embedding, ok := section["embedding"].(map[string]any); ok {
+			if v, ok := embedding["model"].(string); ok {
+				state.EmbeddingModel = types.StringValue(v)
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
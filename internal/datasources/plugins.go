@@ -0,0 +1,179 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &PluginsDataSource{}
+
+// PluginsDataSource lists the plugin entries in the config. With a gateway
+// connection it adds the plugins the gateway reports as installed, and their
+// versions.
+type PluginsDataSource struct {
+	gatewayTarget
+}
+
+type PluginsDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Gateway   types.String `tfsdk:"gateway"`
+	PluginIDs types.List   `tfsdk:"plugin_ids"`
+	Plugins   types.List   `tfsdk:"plugins"`
+}
+
+var pluginObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"plugin_id":  types.StringType,
+		"enabled":    types.BoolType,
+		"configured": types.BoolType,
+		"version":    types.StringType,
+		"source":     types.StringType,
+	},
+}
+
+func NewPluginsDataSource() datasource.DataSource {
+	return &PluginsDataSource{}
+}
+
+func (d *PluginsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugins"
+}
+
+func (d *PluginsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the plugin entries in the config, whether or not Terraform manages them. In WebSocket mode it also " +
+			"lists the plugins installed on the gateway, with their versions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"plugin_ids": schema.ListAttribute{
+				Description: "IDs of all plugins.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"plugins": schema.ListNestedAttribute{
+				Description: "Plugins, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"plugin_id": schema.StringAttribute{
+							Description: "Plugin identifier, the key under plugins.entries.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the plugin is enabled. Entries without an explicit enabled field are considered enabled.",
+							Computed:    true,
+						},
+						"configured": schema.BoolAttribute{
+							Description: "Whether the plugin has an entry under plugins.entries. False for a plugin that is installed but not configured.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Installed version, as reported by the gateway. Null in file mode or when the plugin is not installed.",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "Where the plugin was installed from, as reported by the gateway. Null in file mode or when unknown.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PluginsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *PluginsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	entries, _, err := client.GetNestedSection(ctx, d.client, "plugins", "entries")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read plugins config", err.Error())
+		return
+	}
+	installed := map[string]client.Plugin{}
+	if !client.IsFileMode(d.client) {
+		plugins, err := client.ListPlugins(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list installed plugins", err.Error())
+			return
+		}
+		for _, p := range plugins {
+			installed[p.ID] = p
+		}
+	}
+
+	var ids []string
+	for id := range entries {
+		ids = append(ids, id)
+	}
+	for id := range installed {
+		if _, ok := entries[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	items := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		entry, configured := entries[id].(map[string]any)
+		plugin, isInstalled := installed[id]
+
+		enabled := true
+		if v, ok := entry["enabled"].(bool); ok {
+			enabled = v
+		} else if isInstalled {
+			enabled = plugin.Enabled
+		}
+
+		obj, diags := types.ObjectValue(pluginObjectType.AttrTypes, map[string]attr.Value{
+			"plugin_id":  types.StringValue(id),
+			"enabled":    types.BoolValue(enabled),
+			"configured": types.BoolValue(configured),
+			"version":    stringOrNull(plugin.Version),
+			"source":     stringOrNull(plugin.Source),
+		})
+		resp.Diagnostics.Append(diags...)
+		items = append(items, obj)
+	}
+	idList, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(ids))
+	resp.Diagnostics.Append(diags...)
+	list, diags := types.ListValue(pluginObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := PluginsDataSourceModel{
+		ID:        types.StringValue("plugins"),
+		Gateway:   gateway,
+		PluginIDs: idList,
+		Plugins:   list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
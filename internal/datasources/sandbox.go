@@ -0,0 +1,165 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &SandboxDataSource{}
+
+type SandboxDataSource struct {
+	gatewayTarget
+}
+
+type SandboxDataSourceModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Gateway        types.String  `tfsdk:"gateway"`
+	Mode           types.String  `tfsdk:"mode"`
+	Scope          types.String  `tfsdk:"scope"`
+	Image          types.String  `tfsdk:"image"`
+	CPUs           types.Float64 `tfsdk:"cpus"`
+	Memory         types.String  `tfsdk:"memory"`
+	Network        types.String  `tfsdk:"network"`
+	MountAllowlist types.List    `tfsdk:"mount_allowlist"`
+	IdleTeardown   types.String  `tfsdk:"idle_teardown"`
+}
+
+func NewSandboxDataSource() datasource.DataSource {
+	return &SandboxDataSource{}
+}
+
+func (d *SandboxDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sandbox"
+}
+
+func (d *SandboxDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the effective OpenClaw sandbox configuration: the default mode and scope from agent defaults, and the sandbox runtime settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"mode": schema.StringAttribute{
+				Description: "Default sandbox mode: off, non-main, all.",
+				Computed:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Default sandbox scope: session, agent, shared.",
+				Computed:    true,
+			},
+			"image": schema.StringAttribute{
+				Description: "Container image sandboxes run in.",
+				Computed:    true,
+			},
+			"cpus": schema.Float64Attribute{
+				Description: "CPU limit per sandbox, in cores.",
+				Computed:    true,
+			},
+			"memory": schema.StringAttribute{
+				Description: "Memory limit per sandbox.",
+				Computed:    true,
+			},
+			"network": schema.StringAttribute{
+				Description: "Network access for sandboxes.",
+				Computed:    true,
+			},
+			"mount_allowlist": schema.ListAttribute{
+				Description: "Host paths that may be mounted into sandboxes.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"idle_teardown": schema.StringAttribute{
+				Description: "Idle time after which a sandbox is torn down.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SandboxDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *SandboxDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, d.client, "sandbox")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read sandbox config", err.Error())
+		return
+	}
+	defaults, _, err := client.GetNestedSection(ctx, d.client, "agents", "defaults", "sandbox")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agent defaults config", err.Error())
+		return
+	}
+
+	state := SandboxDataSourceModel{
+		ID:             types.StringValue("sandbox"),
+		Gateway:        gateway,
+		MountAllowlist: types.ListNull(types.StringType),
+	}
+
+	// Mode and scope are set per agent, with defaults under agents.defaults.sandbox
+	if defaults != nil {
+		if v, ok := defaults["mode"].(string); ok {
+			state.Mode = types.StringValue(v)
+		}
+		if v, ok := defaults["scope"].(string); ok {
+			state.Scope = types.StringValue(v)
+		}
+	}
+
+	if section != nil {
+		if v, ok := section["image"].(string); ok {
+			state.Image = types.StringValue(v)
+		}
+		if v, ok := section["network"].(string); ok {
+			state.Network = types.StringValue(v)
+		}
+		if v, ok := section["idleTeardown"].(string); ok {
+			state.IdleTeardown = types.StringValue(v)
+		}
+		if v, ok := section["mountAllowlist"].([]any); ok {
+			paths := make([]string, 0, len(v))
+			for _, p := range v {
+				if s, ok := p.(string); ok {
+					paths = append(paths, s)
+				}
+			}
+			list, diags := types.ListValueFrom(ctx, types.StringType, paths)
+			resp.Diagnostics.Append(diags...)
+			state.MountAllowlist = list
+		}
+
+		// Resource limits are nested under sandbox.limits
+		if limits, ok := section["limits"].(map[string]any); ok {
+			if v, ok := limits["cpus"].(float64); ok {
+				state.CPUs = types.Float64Value(v)
+			}
+			if v, ok := limits["memory"].(string); ok {
+				state.Memory = types.StringValue(v)
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,89 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &SecretsDataSource{}
+
+type SecretsDataSource struct {
+	gatewayTarget
+}
+
+type SecretsDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Gateway types.String `tfsdk:"gateway"`
+	Names   types.List   `tfsdk:"names"`
+}
+
+func NewSecretsDataSource() datasource.DataSource {
+	return &SecretsDataSource{}
+}
+
+func (d *SecretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+func (d *SecretsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the names of the secrets under env.vars. Values are never read.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"names": schema.ListAttribute{
+				Description: "Secret names, sorted.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SecretsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *SecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	vars, _, err := client.GetNestedSection(ctx, d.client, "env", "vars")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read secrets", err.Error())
+		return
+	}
+
+	keys := slices.Sorted(maps.Keys(vars))
+	if keys == nil {
+		keys = []string{}
+	}
+	names, diags := types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	state := SecretsDataSourceModel{
+		ID:      types.StringValue("secrets"),
+		Gateway: gateway,
+		Names:   names,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
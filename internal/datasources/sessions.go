@@ -0,0 +1,180 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &SessionsDataSource{}
+
+type SessionsDataSource struct {
+	gatewayTarget
+}
+
+type SessionsDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Gateway  types.String `tfsdk:"gateway"`
+	Keys     types.List   `tfsdk:"keys"`
+	Sessions types.List   `tfsdk:"sessions"`
+}
+
+var sessionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"key":            types.StringType,
+		"agent_id":       types.StringType,
+		"channel":        types.StringType,
+		"peer_kind":      types.StringType,
+		"peer_id":        types.StringType,
+		"created_at":     types.StringType,
+		"last_active_at": types.StringType,
+		"message_count":  types.Int64Type,
+	},
+}
+
+func NewSessionsDataSource() datasource.DataSource {
+	return &SessionsDataSource{}
+}
+
+func (d *SessionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sessions"
+}
+
+func (d *SessionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the live sessions held by the gateway. Requires WebSocket mode.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"keys": schema.ListAttribute{
+				Description: "Keys of all sessions.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"sessions": schema.ListNestedAttribute{
+				Description: "Sessions, most recently active first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "Session key (e.g. agent:main:telegram:dm:42).",
+							Computed:    true,
+						},
+						"agent_id": schema.StringAttribute{
+							Description: "Agent the session belongs to.",
+							Computed:    true,
+						},
+						"channel": schema.StringAttribute{
+							Description: "Channel the session is on. Null for sessions not tied to a channel.",
+							Computed:    true,
+						},
+						"peer_kind": schema.StringAttribute{
+							Description: "Kind of peer the session is with: dm or group. Null for sessions without a peer.",
+							Computed:    true,
+						},
+						"peer_id": schema.StringAttribute{
+							Description: "ID of the peer the session is with. Null for sessions without a peer.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the session was created (RFC 3339).",
+							Computed:    true,
+						},
+						"last_active_at": schema.StringAttribute{
+							Description: "When the session last received or sent a message (RFC 3339).",
+							Computed:    true,
+						},
+						"message_count": schema.Int64Attribute{
+							Description: "Number of messages in the session.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SessionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *SessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	sessions, err := client.ListSessions(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list sessions", err.Error())
+		return
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].LastActiveAt > sessions[j].LastActiveAt
+	})
+
+	keys := make([]string, 0, len(sessions))
+	items := make([]attr.Value, 0, len(sessions))
+	for _, s := range sessions {
+		keys = append(keys, s.Key)
+		var peerKind, peerID string
+		if s.Peer != nil {
+			peerKind, peerID = s.Peer.Kind, s.Peer.ID
+		}
+		obj, diags := types.ObjectValue(sessionObjectType.AttrTypes, map[string]attr.Value{
+			"key":            types.StringValue(s.Key),
+			"agent_id":       stringOrNull(s.AgentID),
+			"channel":        stringOrNull(s.Channel),
+			"peer_kind":      stringOrNull(peerKind),
+			"peer_id":        stringOrNull(peerID),
+			"created_at":     millisOrNull(s.CreatedAt),
+			"last_active_at": millisOrNull(s.LastActiveAt),
+			"message_count":  types.Int64Value(s.MessageCount),
+		})
+		resp.Diagnostics.Append(diags...)
+		items = append(items, obj)
+	}
+	keyList, diags := types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	list, diags := types.ListValue(sessionObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := SessionsDataSourceModel{
+		ID:       types.StringValue("sessions"),
+		Gateway:  gateway,
+		Keys:     keyList,
+		Sessions: list,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// millisOrNull formats a gateway timestamp in milliseconds since the Unix
+// epoch as RFC 3339, or null when the gateway did not report it.
+func millisOrNull(ms int64) types.String {
+	if ms == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.UnixMilli(ms).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,144 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ datasource.DataSource = &UsageDataSource{}
+
+type UsageDataSource struct {
+	gatewayTarget
+}
+
+type UsageDataSourceModel struct {
+	ID           types.String  `tfsdk:"id"`
+	Gateway      types.String  `tfsdk:"gateway"`
+	Period       types.String  `tfsdk:"period"`
+	TotalUSD     types.Float64 `tfsdk:"total_usd"`
+	Agents       types.Map     `tfsdk:"agents"`
+	Channels     types.Map     `tfsdk:"channels"`
+	MonthlyUSD   types.Float64 `tfsdk:"monthly_usd"`
+	RemainingUSD types.Float64 `tfsdk:"remaining_usd"`
+	UsedFraction types.Float64 `tfsdk:"used_fraction"`
+}
+
+func NewUsageDataSource() datasource.DataSource {
+	return &UsageDataSource{}
+}
+
+func (d *UsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+func (d *UsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the spend recorded for the current budget period, and how much of the monthly cap it uses. Requires WebSocket mode.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": gatewayAttribute(),
+			"period": schema.StringAttribute{
+				Description: "Budget period the spend is for (e.g. 2026-10).",
+				Computed:    true,
+			},
+			"total_usd": schema.Float64Attribute{
+				Description: "Total spend in the period, in USD.",
+				Computed:    true,
+			},
+			"agents": schema.MapAttribute{
+				Description: "Spend in the period per agent, in USD.",
+				Computed:    true,
+				ElementType: types.Float64Type,
+			},
+			"channels": schema.MapAttribute{
+				Description: "Spend in the period per channel, in USD.",
+				Computed:    true,
+				ElementType: types.Float64Type,
+			},
+			"monthly_usd": schema.Float64Attribute{
+				Description: "Monthly cap from the budget config. Null when no cap is set.",
+				Computed:    true,
+			},
+			"remaining_usd": schema.Float64Attribute{
+				Description: "Spend left before the monthly cap, never below 0. Null when no cap is set.",
+				Computed:    true,
+			},
+			"used_fraction": schema.Float64Attribute{
+				Description: "Fraction of the monthly cap spent (1 means the cap is reached). Null when no cap is set.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *UsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.setProviderData(pd)
+}
+
+func (d *UsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	gateway, ok := d.selectGateway(ctx, req.Config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	usage, err := client.GetUsage(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read usage", err.Error())
+		return
+	}
+	budget, _, err := client.GetSection(ctx, d.client, "budget")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read budget config", err.Error())
+		return
+	}
+
+	state := UsageDataSourceModel{
+		ID:       types.StringValue("usage"),
+		Gateway:  gateway,
+		Period:   types.StringValue(usage.Period),
+		TotalUSD: types.Float64Value(usage.TotalUSD),
+	}
+	agents, diags := types.MapValueFrom(ctx, types.Float64Type, nonNil(usage.Agents))
+	resp.Diagnostics.Append(diags...)
+	state.Agents = agents
+	channels, diags := types.MapValueFrom(ctx, types.Float64Type, nonNil(usage.Channels))
+	resp.Diagnostics.Append(diags...)
+	state.Channels = channels
+
+	// The gateway reports spend only; the cap it is measured against comes
+	// from the budget section.
+	if limit, ok := budget["monthlyUsd"].(float64); ok {
+		state.MonthlyUSD = types.Float64Value(limit)
+		state.RemainingUSD = types.Float64Value(max(limit-usage.TotalUSD, 0))
+		if limit > 0 {
+			state.UsedFraction = types.Float64Value(usage.TotalUSD / limit)
+		} else {
+			state.UsedFraction = types.Float64Value(1)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func nonNil(m map[string]float64) map[string]float64 {
+	if m == nil {
+		return map[string]float64{}
+	}
+	return m
+}
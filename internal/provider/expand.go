@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// expandValue resolves $VAR and ${VAR} references and a leading ~ in a path
+// or URL taken from the provider configuration. "$$" produces a literal "$".
+// Referencing an unset variable is an error rather than silently expanding to
+// an empty string.
+func expandValue(s string) (string, error) {
+	var missing []string
+	s = os.Expand(s, func(name string) string {
+		if name == "$" {
+			return "$"
+		}
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("environment variable %s is not set", strings.Join(missing, ", "))
+	}
+
+	if s == "~" || strings.HasPrefix(s, "~/") || strings.HasPrefix(s, "~"+string(filepath.Separator)) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("expanding ~: %w", err)
+		}
+		s = home + s[1:]
+	}
+	return s, nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandValue(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory: %v", err)
+	}
+	t.Setenv("OPENCLAW_TEST_HOST", "gw.example.com")
+	t.Setenv("OPENCLAW_TEST_DIR", "/srv/openclaw")
+
+	cases := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "~/.openclaw/openclaw.json", want: filepath.Join(home, ".openclaw", "openclaw.json")},
+		{in: "~", want: home},
+		{in: "$HOME/openclaw.json", want: home + "/openclaw.json"},
+		{in: "${OPENCLAW_TEST_DIR}/openclaw.json", want: "/srv/openclaw/openclaw.json"},
+		{in: "wss://${OPENCLAW_TEST_HOST}:18789", want: "wss://gw.example.com:18789"},
+		{in: "/tmp/$$literal", want: "/tmp/$literal"},
+		{in: "/etc/~user/file", want: "/etc/~user/file"},
+		{in: "~user/file", want: "~user/file"},
+		{in: "ws://127.0.0.1:18789", want: "ws://127.0.0.1:18789"},
+		{in: "$OPENCLAW_TEST_UNSET/openclaw.json", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := expandValue(tc.in)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expandValue(%q) = %q, want error", tc.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expandValue(%q): %v", tc.in, err)
+			}
+			if got != tc.want {
+				t.Fatalf("expandValue(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
@@ -3,9 +3,17 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,9 +35,56 @@ type OpenClawProvider struct {
 
 // OpenClawProviderModel describes the provider HCL configuration.
 type OpenClawProviderModel struct {
-	GatewayURL types.String `tfsdk:"gateway_url"`
-	Token      types.String `tfsdk:"token"`
+	GatewayURL         types.String        `tfsdk:"gateway_url"`
+	Token              types.String        `tfsdk:"token"`
+	TokenFile          types.String        `tfsdk:"token_file"`
+	TokenCommand       types.String        `tfsdk:"token_command"`
+	Password           types.String        `tfsdk:"password"`
+	ConfigPath         types.String        `tfsdk:"config_path"`
+	DockerContainer    types.String        `tfsdk:"docker_container"`
+	DockerHost         types.String        `tfsdk:"docker_host"`
+	CACertPEM          types.String        `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String        `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool          `tfsdk:"insecure_skip_verify"`
+	ClientCertPEM      types.String        `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String        `tfsdk:"client_key_pem"`
+	SSHHost            types.String        `tfsdk:"ssh_host"`
+	SSHUser            types.String        `tfsdk:"ssh_user"`
+	SSHPrivateKey      types.String        `tfsdk:"ssh_private_key"`
+	SSHHostKey         types.String        `tfsdk:"ssh_host_key"`
+	ProxyURL           types.String        `tfsdk:"proxy_url"`
+	ConnectRetries     types.Int64         `tfsdk:"connect_retries"`
+	ConnectTimeout     types.String        `tfsdk:"connect_timeout"`
+	MaxBackoff         types.String        `tfsdk:"max_backoff"`
+	RPCTimeout         types.String        `tfsdk:"rpc_timeout"`
+	ApplyTimeout       types.String        `tfsdk:"apply_timeout"`
+	WaitForGateway     types.String        `tfsdk:"wait_for_gateway"`
+	WaitAfterWrite     types.String        `tfsdk:"wait_after_write"`
+	MaxInFlight        types.Int64         `tfsdk:"max_in_flight"`
+	RequestsPerSecond  types.Int64         `tfsdk:"requests_per_second"`
+	BreakerThreshold   types.Int64         `tfsdk:"circuit_breaker_threshold"`
+	BreakerCooldown    types.String        `tfsdk:"circuit_breaker_cooldown"`
+	DeviceIdentityPath types.String        `tfsdk:"device_identity_path"`
+	Role               types.String        `tfsdk:"role"`
+	Scopes             types.List          `tfsdk:"scopes"`
+	ReadOnly           types.Bool          `tfsdk:"read_only"`
+	BatchWrites        types.Bool          `tfsdk:"batch_writes"`
+	ConfigCacheTTL     types.String        `tfsdk:"config_cache_ttl"`
+	BackupOnWrite      types.Bool          `tfsdk:"backup_on_write"`
+	StrictConcurrency  types.Bool          `tfsdk:"strict_concurrency"`
+	BackupRetention    types.Int64         `tfsdk:"backup_retention"`
+	Discover           types.Bool          `tfsdk:"discover"`
+	Gateways           []NamedGatewayModel `tfsdk:"gateways"`
+}
+
+// NamedGatewayModel describes one entry of the gateways block list.
+type NamedGatewayModel struct {
+	Name       types.String `tfsdk:"name"`
+	URL        types.String `tfsdk:"url"`
 	ConfigPath types.String `tfsdk:"config_path"`
+	Token      types.String `tfsdk:"token"`
+	Password   types.String `tfsdk:"password"`
+	CACertPEM  types.String `tfsdk:"ca_cert_pem"`
 }
 
 // New returns a provider.Provider constructor for the given version string.
@@ -61,12 +116,244 @@ func (p *OpenClawProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:  true,
 				Sensitive: true,
 			},
+			"token_file": schema.StringAttribute{
+				Description: "Path to a file containing the gateway token (surrounding whitespace is trimmed). " +
+					"Conflicts with token and token_command. Can also be set via OPENCLAW_GATEWAY_TOKEN_FILE.",
+				Optional: true,
+			},
+			"token_command": schema.StringAttribute{
+				Description: "Shell command that prints the gateway token on stdout, e.g. \"op read op://vault/openclaw/token\". " +
+					"Run once at configure time. Conflicts with token and token_file.",
+				Optional: true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password for gateways with auth.mode = \"password\". Conflicts with the token settings. " +
+					"Can also be set via OPENCLAW_GATEWAY_PASSWORD.",
+				Optional:  true,
+				Sensitive: true,
+			},
 			"config_path": schema.StringAttribute{
 				Description: "Path to the openclaw.json config file for local/file-based management. " +
-					"Used when no gateway_url is set. Defaults to ~/.openclaw/openclaw.json. " +
+					"Used when no gateway_url is set. Defaults to ~/.openclaw/openclaw.json, or to " +
+					"/home/node/.openclaw/openclaw.json inside the container when docker_container is set. " +
 					"Can also be set via OPENCLAW_CONFIG_PATH.",
 				Optional: true,
 			},
+			"docker_container": schema.StringAttribute{
+				Description: "Name or ID of a running container whose config file (config_path, an absolute path inside " +
+					"the container) is read and written through the Docker Engine API. Used when no gateway_url is set. " +
+					"Can also be set via OPENCLAW_DOCKER_CONTAINER.",
+				Optional: true,
+			},
+			"docker_host": schema.StringAttribute{
+				Description: "Docker Engine endpoint for docker_container, as unix:///path or tcp://host:port. " +
+					"Defaults to DOCKER_HOST, then unix:///var/run/docker.sock.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate(s) trusted when connecting to a wss:// gateway, " +
+					"in addition to the system roots. Conflicts with ca_cert_file.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM CA bundle trusted when connecting to a wss:// gateway. " +
+					"Can also be set via OPENCLAW_CA_CERT_FILE.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip TLS certificate verification for wss:// gateways. Only use for local testing. " +
+					"Can also be set via OPENCLAW_INSECURE_SKIP_VERIFY.",
+				Optional: true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded TLS client certificate presented to mTLS-enforcing gateways or proxies. " +
+					"Requires client_key_pem.",
+				Optional: true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM-encoded private key for client_cert_pem.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ssh_host": schema.StringAttribute{
+				Description: "SSH server (host or host:port) to tunnel the gateway connection through. " +
+					"gateway_url is then dialed from that host, so a loopback URL reaches its local gateway. " +
+					"Can also be set via OPENCLAW_SSH_HOST.",
+				Optional: true,
+			},
+			"ssh_user": schema.StringAttribute{
+				Description: "SSH login user. Can also be set via OPENCLAW_SSH_USER.",
+				Optional:    true,
+			},
+			"ssh_private_key": schema.StringAttribute{
+				Description: "PEM-encoded private key used to authenticate to ssh_host.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ssh_host_key": schema.StringAttribute{
+				Description: "Expected SSH host key in authorized_keys format (e.g. \"ssh-ed25519 AAAA...\"). " +
+					"When unset, ~/.ssh/known_hosts is used to verify the server.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "HTTP (CONNECT) or SOCKS5 proxy for gateway connections, e.g. http://proxy:3128 or " +
+					"socks5://bastion:1080. When unset, HTTPS_PROXY, HTTP_PROXY, ALL_PROXY and NO_PROXY are honoured.",
+				Optional: true,
+			},
+			"connect_retries": schema.Int64Attribute{
+				Description: "How many times a failed gateway connection is retried before giving up. Default: 5. " +
+					"Can also be set via OPENCLAW_CONNECT_RETRIES.",
+				Optional: true,
+			},
+			"connect_timeout": schema.StringAttribute{
+				Description: "Timeout for each connection attempt (dial and handshake) as a Go duration, e.g. \"30s\". " +
+					"Default: 10s. Can also be set via OPENCLAW_CONNECT_TIMEOUT.",
+				Optional: true,
+			},
+			"max_backoff": schema.StringAttribute{
+				Description: "Upper bound for the exponential delay between connection attempts, e.g. \"1m\". " +
+					"Default: 10s. Can also be set via OPENCLAW_MAX_BACKOFF.",
+				Optional: true,
+			},
+			"rpc_timeout": schema.StringAttribute{
+				Description: "Timeout for each gateway RPC call, e.g. \"30s\". Default: 30s. " +
+					"Can also be set via OPENCLAW_RPC_TIMEOUT.",
+				Optional: true,
+			},
+			"apply_timeout": schema.StringAttribute{
+				Description: "Timeout for config.apply calls, which may restart the gateway. Default: 2m. " +
+					"Can also be set via OPENCLAW_APPLY_TIMEOUT.",
+				Optional: true,
+			},
+			"wait_for_gateway": schema.StringAttribute{
+				Description: "In WebSocket mode, poll the gateway's health for up to this long, e.g. \"2m\", " +
+					"and only continue once it reports ok. Useful right after the gateway starts. " +
+					"Can also be set via OPENCLAW_WAIT_FOR_GATEWAY.",
+				Optional: true,
+			},
+			"max_in_flight": schema.Int64Attribute{
+				Description: "Maximum number of gateway RPCs awaiting a reply at once. Default: no limit. " +
+					"Can also be set via OPENCLAW_MAX_IN_FLIGHT.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Int64Attribute{
+				Description: "Maximum rate at which gateway RPCs are sent, for gateways that rate-limit clients. " +
+					"Default: no limit. Can also be set via OPENCLAW_REQUESTS_PER_SECOND.",
+				Optional: true,
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				Description: "After this many gateway RPCs fail in a row (connection errors, timeouts, or the gateway " +
+					"reporting itself unavailable), stop sending any for circuit_breaker_cooldown and fail fast. " +
+					"0 disables the breaker. Default: 5. Can also be set via OPENCLAW_CIRCUIT_BREAKER_THRESHOLD.",
+				Optional: true,
+			},
+			"circuit_breaker_cooldown": schema.StringAttribute{
+				Description: "How long the circuit breaker stays open before RPCs are tried again, e.g. \"1m\". " +
+					"Default: 30s. Can also be set via OPENCLAW_CIRCUIT_BREAKER_COOLDOWN.",
+				Optional: true,
+			},
+			"wait_after_write": schema.StringAttribute{
+				Description: "In WebSocket mode, after each config write poll the gateway's health for up to this long, " +
+					"e.g. \"30s\", and hold back further calls until it reports ok. Use with reload_mode = \"restart\", " +
+					"where every write restarts the gateway. Can also be set via OPENCLAW_WAIT_AFTER_WRITE.",
+				Optional: true,
+			},
+			"device_identity_path": schema.StringAttribute{
+				Description: "File holding the Ed25519 device identity used in the gateway handshake. " +
+					"Created on first use and reused afterwards, so the gateway sees one paired device " +
+					"instead of a new one per run. When unset, an ephemeral identity is generated per connection. " +
+					"Can also be set via OPENCLAW_DEVICE_IDENTITY_PATH.",
+				Optional: true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Role requested in the gateway handshake. Default: operator. " +
+					"Can also be set via OPENCLAW_GATEWAY_ROLE.",
+				Optional: true,
+			},
+			"scopes": schema.ListAttribute{
+				Description: "Scopes requested in the gateway handshake. Default: operator.read, operator.write " +
+					"and operator.admin. Use [\"operator.read\"] for plan-only pipelines. " +
+					"Can also be set via OPENCLAW_GATEWAY_SCOPES as a comma-separated list.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"discover": schema.BoolAttribute{
+				Description: "When no gateway_url is set, look for a gateway on the tailnet (online Tailscale peers " +
+					"listening on port 18789) and then the local network (mDNS), and connect to the first one found. " +
+					"See the openclaw_discovered_gateways data source. Can also be set via OPENCLAW_DISCOVER.",
+				Optional: true,
+			},
+			"batch_writes": schema.BoolAttribute{
+				Description: "Combine config patches from resources applied in parallel into one write, so the gateway " +
+					"reloads once per batch instead of once per resource. Can also be set via OPENCLAW_BATCH_WRITES.",
+				Optional: true,
+			},
+			"config_cache_ttl": schema.StringAttribute{
+				Description: "How long a fetched config is shared between resources and data sources, so a plan " +
+					"reads it once rather than once per resource. Writes through the provider always invalidate it. " +
+					"\"0s\" disables the cache. Default: 5s. Can also be set via OPENCLAW_CONFIG_CACHE_TTL.",
+				Optional: true,
+			},
+			"backup_on_write": schema.BoolAttribute{
+				Description: "Save a copy of the config before every write, as <file>.tfbackup-<timestamp>. " +
+					"In file mode backups go next to the config file; otherwise under ~/.openclaw/backups. " +
+					"Default: false. Can also be set via OPENCLAW_BACKUP_ON_WRITE.",
+				Optional: true,
+			},
+			"backup_retention": schema.Int64Attribute{
+				Description: "How many backups backup_on_write keeps per config; older ones are deleted. 0 keeps all. " +
+					"Default: 10. Can also be set via OPENCLAW_BACKUP_RETENTION.",
+				Optional: true,
+			},
+			"strict_concurrency": schema.BoolAttribute{
+				Description: "In file mode, fail a write when the config file was changed outside Terraform since " +
+					"the resource read it, instead of merging over the change. Default: false. " +
+					"Can also be set via OPENCLAW_STRICT_CONCURRENCY.",
+				Optional: true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Refuse to create, update or delete resources. Refresh and data sources still work, " +
+					"so plans can run without any risk of config being written. " +
+					"Can also be set via OPENCLAW_READ_ONLY.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"gateways": schema.ListNestedBlock{
+				Description: "Additional named gateway connections. Resources and data sources select one " +
+					"with their gateway attribute; those without it use the connection configured above. " +
+					"Named gateways connect on first use and inherit insecure_skip_verify, the client certificate and proxy_url.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name referenced by the gateway attribute of resources and data sources.",
+							Required:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "WebSocket URL of the gateway. Conflicts with config_path.",
+							Optional:    true,
+						},
+						"config_path": schema.StringAttribute{
+							Description: "Path to an openclaw.json file to manage directly instead of a running gateway.",
+							Optional:    true,
+						},
+						"token": schema.StringAttribute{
+							Description: "Authentication token for this gateway.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"password": schema.StringAttribute{
+							Description: "Password for this gateway when it uses auth.mode = \"password\". Conflicts with token.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"ca_cert_pem": schema.StringAttribute{
+							Description: "PEM-encoded CA certificate(s) for this gateway. Defaults to the provider-level CA.",
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -80,17 +367,257 @@ func (p *OpenClawProvider) Configure(ctx context.Context, req provider.Configure
 
 	// Resolve values: HCL > env > defaults.
 	gatewayURL := stringValueOrEnv(config.GatewayURL, "OPENCLAW_GATEWAY_URL", "")
-	token := stringValueOrEnv(config.Token, "OPENCLAW_GATEWAY_TOKEN", "")
-	configPath := stringValueOrEnv(config.ConfigPath, "OPENCLAW_CONFIG_PATH", "~/.openclaw/openclaw.json")
+	dockerContainer := stringValueOrEnv(config.DockerContainer, "OPENCLAW_DOCKER_CONTAINER", "")
+	dockerHost := stringValueOrEnv(config.DockerHost, "DOCKER_HOST", client.DefaultDockerHost)
+	defaultConfigPath := "~/.openclaw/openclaw.json"
+	if dockerContainer != "" {
+		defaultConfigPath = client.DefaultContainerConfigPath
+	}
+	configPath := stringValueOrEnv(config.ConfigPath, "OPENCLAW_CONFIG_PATH", defaultConfigPath)
+	caCertFile := stringValueOrEnv(config.CACertFile, "OPENCLAW_CA_CERT_FILE", "")
+	insecureSkipVerify := boolValueOrEnv(config.InsecureSkipVerify, "OPENCLAW_INSECURE_SKIP_VERIFY", false)
+	sshHost := stringValueOrEnv(config.SSHHost, "OPENCLAW_SSH_HOST", "")
+	sshUser := stringValueOrEnv(config.SSHUser, "OPENCLAW_SSH_USER", "")
+	connectRetries := int64ValueOrEnv(config.ConnectRetries, "OPENCLAW_CONNECT_RETRIES", client.DefaultConnectRetries)
+	connectTimeout := stringValueOrEnv(config.ConnectTimeout, "OPENCLAW_CONNECT_TIMEOUT", client.DefaultConnectTimeout.String())
+	maxBackoff := stringValueOrEnv(config.MaxBackoff, "OPENCLAW_MAX_BACKOFF", client.DefaultMaxBackoff.String())
+	rpcTimeout := stringValueOrEnv(config.RPCTimeout, "OPENCLAW_RPC_TIMEOUT", client.DefaultRPCTimeout.String())
+	applyTimeout := stringValueOrEnv(config.ApplyTimeout, "OPENCLAW_APPLY_TIMEOUT", client.DefaultApplyTimeout.String())
+	waitForGateway := stringValueOrEnv(config.WaitForGateway, "OPENCLAW_WAIT_FOR_GATEWAY", "")
+	waitAfterWrite := stringValueOrEnv(config.WaitAfterWrite, "OPENCLAW_WAIT_AFTER_WRITE", "")
+	deviceIdentityPath := stringValueOrEnv(config.DeviceIdentityPath, "OPENCLAW_DEVICE_IDENTITY_PATH", "")
+
+	if dockerContainer != "" && gatewayURL == "" && strings.HasPrefix(configPath, "~") {
+		// ~ would expand to the home directory on this machine, not in the container.
+		resp.Diagnostics.AddAttributeError(path.Root("config_path"), "Invalid config_path",
+			"config_path must be an absolute path inside the container when docker_container is set.")
+		return
+	}
+
+	// Expand ~ and environment variable references in locations, whether they
+	// came from HCL, the environment or a default.
+	for _, loc := range []struct {
+		attr string
+		v    *string
+	}{
+		{"gateway_url", &gatewayURL},
+		{"config_path", &configPath},
+		{"ca_cert_file", &caCertFile},
+		{"device_identity_path", &deviceIdentityPath},
+	} {
+		expanded, err := expandValue(*loc.v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(loc.attr), "Invalid "+loc.attr, err.Error())
+			continue
+		}
+		*loc.v = expanded
+	}
+	if gatewayURL != "" {
+		// Also covers values that only became a URL once expanded.
+		if _, err := parseGatewayURL(gatewayURL); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("gateway_url"), "Invalid gateway URL", err.Error())
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caCertPEM := config.CACertPEM.ValueString()
+	if caCertFile != "" {
+		if caCertPEM != "" {
+			resp.Diagnostics.AddError(
+				"Conflicting CA certificate settings",
+				"Only one of ca_cert_pem and ca_cert_file (OPENCLAW_CA_CERT_FILE) may be set.",
+			)
+			return
+		}
+		data, err := os.ReadFile(caCertFile)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read CA certificate file",
+				"Could not read "+caCertFile+": "+err.Error(),
+			)
+			return
+		}
+		caCertPEM = string(data)
+	}
+
+	clientCertPEM := config.ClientCertPEM.ValueString()
+	clientKeyPEM := config.ClientKeyPEM.ValueString()
+	if (clientCertPEM == "") != (clientKeyPEM == "") {
+		resp.Diagnostics.AddError(
+			"Incomplete client certificate settings",
+			"client_cert_pem and client_key_pem must be set together.",
+		)
+		return
+	}
+
+	if connectRetries < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("connect_retries"), "Invalid connect_retries",
+			"connect_retries must not be negative.")
+	}
+	connectTimeoutDur, err := parsePositiveDuration(connectTimeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("connect_timeout"), "Invalid connect_timeout", err.Error())
+	}
+	maxBackoffDur, err := parsePositiveDuration(maxBackoff)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("max_backoff"), "Invalid max_backoff", err.Error())
+	}
+	rpcTimeoutDur, err := parsePositiveDuration(rpcTimeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rpc_timeout"), "Invalid rpc_timeout", err.Error())
+	}
+	applyTimeoutDur, err := parsePositiveDuration(applyTimeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("apply_timeout"), "Invalid apply_timeout", err.Error())
+	}
+	configCacheTTL, err := time.ParseDuration(stringValueOrEnv(config.ConfigCacheTTL, "OPENCLAW_CONFIG_CACHE_TTL", client.DefaultConfigCacheTTL.String()))
+	if err != nil || configCacheTTL < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("config_cache_ttl"), "Invalid config_cache_ttl",
+			"config_cache_ttl must be a duration such as \"5s\", or \"0s\" to disable the cache.")
+	}
+	backupRetention := int64ValueOrEnv(config.BackupRetention, "OPENCLAW_BACKUP_RETENTION", client.DefaultBackupRetention)
+	if backupRetention < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("backup_retention"), "Invalid backup_retention",
+			"backup_retention must not be negative.")
+	}
+	var waitForGatewayDur time.Duration
+	if waitForGateway != "" {
+		waitForGatewayDur, err = parsePositiveDuration(waitForGateway)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("wait_for_gateway"), "Invalid wait_for_gateway", err.Error())
+		}
+	}
+	maxInFlight := int64ValueOrEnv(config.MaxInFlight, "OPENCLAW_MAX_IN_FLIGHT", 0)
+	if maxInFlight < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_in_flight"), "Invalid max_in_flight",
+			"max_in_flight must not be negative.")
+	}
+	requestsPerSecond := int64ValueOrEnv(config.RequestsPerSecond, "OPENCLAW_REQUESTS_PER_SECOND", 0)
+	if requestsPerSecond < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("requests_per_second"), "Invalid requests_per_second",
+			"requests_per_second must not be negative.")
+	}
+	breakerThreshold := int64ValueOrEnv(config.BreakerThreshold, "OPENCLAW_CIRCUIT_BREAKER_THRESHOLD", client.DefaultBreakerThreshold)
+	if breakerThreshold < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("circuit_breaker_threshold"), "Invalid circuit_breaker_threshold",
+			"circuit_breaker_threshold must not be negative.")
+	}
+	breakerCooldown, err := parsePositiveDuration(stringValueOrEnv(config.BreakerCooldown, "OPENCLAW_CIRCUIT_BREAKER_COOLDOWN", client.DefaultBreakerCooldown.String()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("circuit_breaker_cooldown"), "Invalid circuit_breaker_cooldown", err.Error())
+	}
+	var waitAfterWriteDur time.Duration
+	if waitAfterWrite != "" {
+		waitAfterWriteDur, err = parsePositiveDuration(waitAfterWrite)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("wait_after_write"), "Invalid wait_after_write", err.Error())
+		}
+	}
+	scopes, diags := resolveScopes(ctx, config.Scopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	retries := int(connectRetries)
+
+	if gatewayURL == "" && boolValueOrEnv(config.Discover, "OPENCLAW_DISCOVER", false) {
+		found, err := client.DiscoverGateways(ctx, client.DiscoverOptions{})
+		if err != nil {
+			resp.Diagnostics.AddError("Gateway discovery failed", err.Error())
+			return
+		}
+		if len(found) == 0 {
+			resp.Diagnostics.AddError("No OpenClaw gateway discovered",
+				"discover is enabled but no gateway was found on the tailnet or local network. "+
+					"Set gateway_url to connect to a known address.")
+			return
+		}
+		gatewayURL = found[0].URL()
+	}
+
+	var identity *client.DeviceIdentity
+	if deviceIdentityPath != "" && (gatewayURL != "" || len(config.Gateways) > 0) {
+		identity, err = client.LoadOrCreateDeviceIdentity(deviceIdentityPath)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to load device identity",
+				"Could not load or create the device identity at "+deviceIdentityPath+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Connection settings shared by the default connection and named gateways.
+	wsBase := client.WSClientConfig{
+		CACertPEM:          caCertPEM,
+		InsecureSkipVerify: insecureSkipVerify,
+		ClientCertPEM:      clientCertPEM,
+		ClientKeyPEM:       clientKeyPEM,
+		ProxyURL:           config.ProxyURL.ValueString(),
+		ConnectRetries:     &retries,
+		ConnectTimeout:     connectTimeoutDur,
+		MaxBackoff:         maxBackoffDur,
+		RPCTimeout:         rpcTimeoutDur,
+		ApplyTimeout:       applyTimeoutDur,
+		DeviceIdentity:     identity,
+		Role:               stringValueOrEnv(config.Role, "OPENCLAW_GATEWAY_ROLE", client.DefaultRole),
+		Scopes:             scopes,
+		MaxInFlight:        int(maxInFlight),
+		RequestsPerSecond:  int(requestsPerSecond),
+		BreakerThreshold:   int(breakerThreshold),
+		BreakerCooldown:    breakerCooldown,
+	}
 
 	var c client.Client
-	var err error
 
 	if gatewayURL != "" {
-		c, err = client.NewWSClient(ctx, client.WSClientConfig{
-			URL:   gatewayURL,
-			Token: token,
-		})
+		var sshTunnel *client.SSHTunnelConfig
+		if sshHost != "" {
+			if sshUser == "" || config.SSHPrivateKey.ValueString() == "" {
+				resp.Diagnostics.AddError(
+					"Incomplete SSH tunnel settings",
+					"ssh_user and ssh_private_key are required when ssh_host is set.",
+				)
+				return
+			}
+			sshTunnel = &client.SSHTunnelConfig{
+				Host:       sshHost,
+				User:       sshUser,
+				PrivateKey: config.SSHPrivateKey.ValueString(),
+				HostKey:    config.SSHHostKey.ValueString(),
+			}
+		}
+
+		token, err := resolveToken(ctx, config)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to resolve gateway token", err.Error())
+			return
+		}
+		password := stringValueOrEnv(config.Password, "OPENCLAW_GATEWAY_PASSWORD", "")
+		if token != "" && password != "" {
+			resp.Diagnostics.AddError(
+				"Conflicting gateway credentials",
+				"Set either a token (token, token_file, token_command or OPENCLAW_GATEWAY_TOKEN) or a password, not both.",
+			)
+			return
+		}
+
+		wsConfig := wsBase
+		wsConfig.URL = gatewayURL
+		wsConfig.Token = token
+		wsConfig.Password = password
+		wsConfig.SSH = sshTunnel
+		c, err = client.NewWSClient(ctx, wsConfig)
+		var scopeErr *client.ScopeError
+		if errors.As(err, &scopeErr) {
+			resp.Diagnostics.AddError(
+				"Gateway rejected the requested role or scopes",
+				err.Error()+". Set role and scopes to what these credentials are allowed, e.g. scopes = [\"operator.read\"] for read-only access.",
+			)
+			return
+		}
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to connect to OpenClaw Gateway",
@@ -98,6 +625,26 @@ func (p *OpenClawProvider) Configure(ctx context.Context, req provider.Configure
 			)
 			return
 		}
+		if waitForGatewayDur > 0 {
+			if err := client.WaitHealthy(ctx, c, waitForGatewayDur); err != nil {
+				c.Close()
+				resp.Diagnostics.AddError("OpenClaw Gateway is not healthy", err.Error())
+				return
+			}
+		}
+	} else if dockerContainer != "" {
+		c, err = client.NewDockerFileClient(ctx, client.DockerConfig{
+			Host:      dockerHost,
+			Container: dockerContainer,
+			Path:      configPath,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to connect to Docker",
+				"Could not reach the config file in container "+dockerContainer+": "+err.Error(),
+			)
+			return
+		}
 	} else {
 		c, err = client.NewFileClient(configPath)
 		if err != nil {
@@ -109,7 +656,80 @@ func (p *OpenClawProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
-	pd := &shared.ProviderData{Client: c}
+	// wrap adds the provider-wide layers to a connection. backupPath is
+	// where its backups are saved.
+	batchWrites := boolValueOrEnv(config.BatchWrites, "OPENCLAW_BATCH_WRITES", false)
+	backupOnWrite := boolValueOrEnv(config.BackupOnWrite, "OPENCLAW_BACKUP_ON_WRITE", false)
+	strictConcurrency := boolValueOrEnv(config.StrictConcurrency, "OPENCLAW_STRICT_CONCURRENCY", false)
+	wrap := func(c client.Client, backupPath string) client.Client {
+		// The file client already writes under a mutex; a gateway has to
+		// be sent one write at a time.
+		switch cc := c.(type) {
+		case *client.WSClient:
+			if waitAfterWriteDur > 0 {
+				c = client.NewReloadWaitClient(c, waitAfterWriteDur)
+			}
+			c = client.NewSerialClient(c)
+		case *client.FileClient:
+			cc.Strict = strictConcurrency
+		}
+		if backupOnWrite {
+			c = client.NewBackupClient(c, backupPath, int(backupRetention))
+		}
+		if batchWrites {
+			c = client.NewBatchClient(c, client.DefaultBatchWindow)
+		}
+		if configCacheTTL > 0 {
+			c = client.NewCachedClient(c, configCacheTTL)
+		}
+		return c
+	}
+	if gatewayURL != "" || dockerContainer != "" {
+		c = wrap(c, filepath.Join(backupDir, "openclaw.json"))
+	} else {
+		c = wrap(c, configPath)
+	}
+
+	gateways := make(map[string]*shared.Gateway, len(config.Gateways))
+	for i, g := range config.Gateways {
+		name := g.Name.ValueString()
+		at := path.Root("gateways").AtListIndex(i)
+		if _, dup := gateways[name]; dup {
+			resp.Diagnostics.AddAttributeError(at.AtName("name"), "Duplicate gateway name",
+				"Each gateways block must have a unique name; "+name+" is used more than once.")
+			continue
+		}
+		if (g.URL.ValueString() == "") == (g.ConfigPath.ValueString() == "") {
+			resp.Diagnostics.AddAttributeError(at, "Invalid gateway connection",
+				"Exactly one of url and config_path must be set for gateway "+name+".")
+			continue
+		}
+		if g.Token.ValueString() != "" && g.Password.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(at, "Conflicting gateway credentials",
+				"Set either token or password for gateway "+name+", not both.")
+			continue
+		}
+		if !expandGatewayAttr(&g.URL, at.AtName("url"), &resp.Diagnostics) ||
+			!expandGatewayAttr(&g.ConfigPath, at.AtName("config_path"), &resp.Diagnostics) {
+			continue
+		}
+		if u := g.URL.ValueString(); u != "" {
+			if _, err := parseGatewayURL(u); err != nil {
+				resp.Diagnostics.AddAttributeError(at.AtName("url"), "Invalid gateway URL", err.Error())
+				continue
+			}
+		}
+		gateways[name] = namedGateway(g, wsBase, wrap)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pd := &shared.ProviderData{
+		Client:   c,
+		Gateways: gateways,
+		ReadOnly: boolValueOrEnv(config.ReadOnly, "OPENCLAW_READ_ONLY", false),
+	}
 	resp.DataSourceData = pd
 	resp.ResourceData = pd
 }
@@ -118,11 +738,43 @@ func (p *OpenClawProvider) Resources(_ context.Context) []func() resource.Resour
 	return []func() resource.Resource{
 		// Core
 		resources.NewGatewayResource,
+		resources.NewGatewayAuthResource,
+		resources.NewTailscaleResource,
 		resources.NewAgentDefaultsResource,
+		resources.NewHeartbeatResource,
 		resources.NewAgentResource,
+		resources.NewAgentIdentityResource,
+		resources.NewAgentEnvResource,
+		resources.NewSystemPromptResource,
+		resources.NewPersonaResource,
+		resources.NewWorkspaceFileResource,
+		resources.NewModelAliasResource,
 		resources.NewBindingResource,
+		resources.NewBindingSetResource,
 		resources.NewSessionResource,
+		resources.NewSessionOverrideResource,
 		resources.NewMessagesResource,
+		resources.NewVoiceResource,
+		resources.NewMemoryResource,
+		resources.NewCompactionResource,
+		resources.NewLoggingResource,
+		resources.NewObservabilityResource,
+		resources.NewSandboxResource,
+		resources.NewSecurityResource,
+		resources.NewPrivacyResource,
+		resources.NewContentFilterResource,
+		resources.NewHandoffResource,
+		resources.NewQuietHoursResource,
+		resources.NewAutoreplyResource,
+		resources.NewPairedDeviceResource,
+		resources.NewDMPairingPolicyResource,
+		resources.NewBudgetResource,
+		resources.NewUsageExportResource,
+		resources.NewRateLimitResource,
+		resources.NewUpdatePolicyResource,
+		resources.NewSecretResource,
+		resources.NewConfigRawResource,
+		resources.NewConfigSectionResource,
 
 		// Channels
 		resources.NewChannelWhatsAppResource,
@@ -132,13 +784,36 @@ func (p *OpenClawProvider) Resources(_ context.Context) []func() resource.Resour
 		resources.NewChannelSignalResource,
 		resources.NewChannelIMessageResource,
 		resources.NewChannelGoogleChatResource,
+		resources.NewChannelVoiceResource,
+		resources.NewChannelMatrixResource,
+		resources.NewChannelEmailResource,
+		resources.NewChannelMSTeamsResource,
+		resources.NewChannelMattermostResource,
+		resources.NewChannelIRCResource,
+		resources.NewChannelSMSTwilioResource,
+		resources.NewChannelLINEResource,
+		resources.NewChannelWebChatResource,
+		resources.NewChannelXMPPResource,
+		resources.NewGroupResource,
+		resources.NewAllowlistEntryResource,
 
 		// Automation & tools
 		resources.NewPluginResource,
+		resources.NewPluginInstallResource,
+		resources.NewMCPServerResource,
 		resources.NewSkillResource,
+		resources.NewSkillRepositoryResource,
 		resources.NewHookResource,
+		resources.NewHookEndpointResource,
+		resources.NewWebhookSubscriptionResource,
+		resources.NewNotificationRuleResource,
+		resources.NewSlashCommandResource,
 		resources.NewCronResource,
+		resources.NewCronGlobalWindowResource,
 		resources.NewToolsResource,
+		resources.NewToolProfileResource,
+		resources.NewBrowserResource,
+		resources.NewImageGenerationResource,
 	}
 }
 
@@ -146,11 +821,96 @@ func (p *OpenClawProvider) DataSources(_ context.Context) []func() datasource.Da
 	return []func() datasource.DataSource{
 		datasources.NewConfigDataSource,
 		datasources.NewHealthDataSource,
+		datasources.NewUsageDataSource,
+		datasources.NewSessionsDataSource,
+		datasources.NewCronJobsDataSource,
+		datasources.NewDevicesDataSource,
+		datasources.NewPluginsDataSource,
 		datasources.NewGatewayDataSource,
 		datasources.NewAgentDefaultsDataSource,
+		datasources.NewAgentDataSource,
 		datasources.NewAgentsDataSource,
+		datasources.NewChannelDataSource,
 		datasources.NewChannelsDataSource,
+		datasources.NewBindingsDataSource,
+		datasources.NewMemoryDataSource,
+		datasources.NewSandboxDataSource,
+		datasources.NewSecretsDataSource,
+		datasources.NewDiscoveredGatewaysDataSource,
+		datasources.NewConfigChangesDataSource,
+	}
+}
+
+// backupDir holds backup_on_write backups of configs that are not local
+// files.
+const backupDir = "~/.openclaw/backups"
+
+// namedGateway returns a lazily connected client for one gateways block.
+func namedGateway(g NamedGatewayModel, wsBase client.WSClientConfig, wrap func(client.Client, string) client.Client) *shared.Gateway {
+	backupPath := filepath.Join(backupDir, g.Name.ValueString()+".json")
+	if configPath := g.ConfigPath.ValueString(); configPath != "" {
+		backupPath = configPath
+	}
+	connect := func(c client.Client, err error) (client.Client, error) {
+		if err != nil {
+			return nil, err
+		}
+		return wrap(c, backupPath), nil
+	}
+	if configPath := g.ConfigPath.ValueString(); configPath != "" {
+		return shared.NewGateway(func(context.Context) (client.Client, error) {
+			return connect(client.NewFileClient(configPath))
+		})
+	}
+	wsConfig := wsBase
+	wsConfig.URL = g.URL.ValueString()
+	wsConfig.Token = g.Token.ValueString()
+	wsConfig.Password = g.Password.ValueString()
+	if v := g.CACertPEM.ValueString(); v != "" {
+		wsConfig.CACertPEM = v
+	}
+	return shared.NewGateway(func(ctx context.Context) (client.Client, error) {
+		return connect(client.NewWSClient(ctx, wsConfig))
+	})
+}
+
+// expandGatewayAttr expands a gateways block location in place.
+func expandGatewayAttr(v *types.String, at path.Path, diags *diag.Diagnostics) bool {
+	if v.ValueString() == "" {
+		return true
+	}
+	expanded, err := expandValue(v.ValueString())
+	if err != nil {
+		diags.AddAttributeError(at, "Invalid gateway location", err.Error())
+		return false
+	}
+	*v = types.StringValue(expanded)
+	return true
+}
+
+// resolveScopes returns the scopes attribute, falling back to the
+// comma-separated OPENCLAW_GATEWAY_SCOPES and then client.DefaultScopes.
+func resolveScopes(ctx context.Context, val types.List) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var scopes []string
+	if !val.IsNull() && !val.IsUnknown() {
+		diags.Append(val.ElementsAs(ctx, &scopes, false)...)
+	} else if v := os.Getenv("OPENCLAW_GATEWAY_SCOPES"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			scopes = append(scopes, strings.TrimSpace(s))
+		}
+	} else {
+		return client.DefaultScopes, nil
+	}
+	if len(scopes) == 0 {
+		diags.AddAttributeError(path.Root("scopes"), "Invalid scopes", "scopes must not be empty.")
+	}
+	for i, s := range scopes {
+		if s == "" {
+			diags.AddAttributeError(path.Root("scopes").AtListIndex(i), "Invalid scopes", "Scopes must not be empty strings.")
+		}
 	}
+	return scopes, diags
 }
 
 func stringValueOrEnv(val types.String, envKey, fallback string) string {
@@ -162,3 +922,39 @@ func stringValueOrEnv(val types.String, envKey, fallback string) string {
 	}
 	return fallback
 }
+
+func boolValueOrEnv(val types.Bool, envKey string, fallback bool) bool {
+	if !val.IsNull() && !val.IsUnknown() {
+		return val.ValueBool()
+	}
+	if v := os.Getenv(envKey); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return fallback
+}
+
+func int64ValueOrEnv(val types.Int64, envKey string, fallback int64) int64 {
+	if !val.IsNull() && !val.IsUnknown() {
+		return val.ValueInt64()
+	}
+	if v := os.Getenv(envKey); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	}
+	return fallback
+}
+
+// parsePositiveDuration parses a Go duration string such as "30s" or "2m".
+func parsePositiveDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid duration (e.g. \"30s\", \"2m\"): %w", s, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("%q must be greater than zero", s)
+	}
+	return d, nil
+}
@@ -1,14 +1,22 @@
 package provider_test
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
 	"github.com/kylemclaren/terraform-provider-openclaw/internal/provider"
 )
 
@@ -92,47 +100,94 @@ resource "openclaw_gateway" "test" {
 					resource.TestCheckResourceAttr("openclaw_gateway.test", "reload_mode", "restart"),
 				),
 			},
+			// Deprecated attributes
+			{
+				Config: providerBlock + `
+resource "openclaw_gateway" "test" {
+  port           = 19001
+  auth_mode      = "none"
+  tailscale_mode = "serve"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_gateway.test", "auth_mode", "none"),
+					resource.TestCheckResourceAttr("openclaw_gateway.test", "tailscale_mode", "serve"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_gateway.test",
+				ImportState:       true,
+				ImportStateId:     "gateway",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func TestAccFileMode_AgentDefaultsResource(t *testing.T) {
-	_, providerBlock := testConfigDir(t)
+func TestAccFileMode_GatewayAuthResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+	os.WriteFile(cfgPath, []byte(`{"gateway":{"auth":{"mode":"token","token":"plaintext"}}}`), 0o644)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
+				// openclaw_gateway leaves auth alone when auth_mode is unset.
 				Config: providerBlock + `
-resource "openclaw_agent_defaults" "test" {
-  workspace        = "~/.openclaw/workspace-test"
-  model_primary    = "anthropic/claude-opus-4-6"
-  thinking_default = "low"
-  timeout_seconds  = 300
-  max_concurrent   = 2
-
-  heartbeat_every  = "15m"
-  heartbeat_target = "none"
+resource "openclaw_gateway" "test" {
+  port = 19000
+}
 
-  sandbox_mode  = "non-main"
-  sandbox_scope = "agent"
+resource "openclaw_gateway_auth" "test" {
+  mode = "none"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "workspace", "~/.openclaw/workspace-test"),
-					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "model_primary", "anthropic/claude-opus-4-6"),
-					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "thinking_default", "low"),
-					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "timeout_seconds", "300"),
-					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "max_concurrent", "2"),
-					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "heartbeat_every", "15m"),
-					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "sandbox_mode", "non-main"),
+					resource.TestCheckResourceAttr("openclaw_gateway_auth.test", "id", "gateway_auth"),
+					resource.TestCheckResourceAttr("openclaw_gateway_auth.test", "mode", "none"),
+					resource.TestCheckNoResourceAttr("openclaw_gateway_auth.test", "hash"),
+					resource.TestCheckNoResourceAttr("openclaw_gateway.test", "auth_mode"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(raw), "plaintext") {
+							return fmt.Errorf("plaintext token left in config: %s", raw)
+						}
+						return nil
+					},
 				),
 			},
+			{
+				ResourceName:      "openclaw_gateway_auth.test",
+				ImportState:       true,
+				ImportStateId:     "gateway_auth",
+				ImportStateVerify: true,
+			},
+			{
+				// Destroying openclaw_gateway must keep the auth it does not own.
+				Config: providerBlock + `
+resource "openclaw_gateway_auth" "test" {
+  mode = "none"
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					if !strings.Contains(string(raw), `"auth"`) || strings.Contains(string(raw), "19000") {
+						return fmt.Errorf("unexpected config after destroying openclaw_gateway: %s", raw)
+					}
+					return nil
+				},
+			},
 		},
 	})
 }
 
-func TestAccFileMode_ChannelWhatsApp(t *testing.T) {
+func TestAccFileMode_GatewayAuthResource_MissingToken(t *testing.T) {
 	_, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
@@ -140,58 +195,110 @@ func TestAccFileMode_ChannelWhatsApp(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-resource "openclaw_channel_whatsapp" "test" {
-  dm_policy          = "allowlist"
-  allow_from         = ["+15555550123", "+447700900123"]
-  text_chunk_limit   = 3000
-  send_read_receipts = false
-  group_policy       = "open"
+resource "openclaw_gateway_auth" "test" {
+  mode = "token"
 }
 `,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "dm_policy", "allowlist"),
-					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "allow_from.#", "2"),
-					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "allow_from.0", "+15555550123"),
-					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "text_chunk_limit", "3000"),
-					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "send_read_receipts", "false"),
-					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "group_policy", "open"),
-				),
+				ExpectError: regexp.MustCompile(`Missing credential`),
 			},
 		},
 	})
 }
 
-func TestAccFileMode_ChannelTelegram(t *testing.T) {
-	_, providerBlock := testConfigDir(t)
+func TestAccFileMode_TailscaleResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-resource "openclaw_channel_telegram" "test" {
-  enabled       = true
-  bot_token     = "123456:ABCDEF"
-  dm_policy     = "open"
-  allow_from    = ["tg:999"]
-  stream_mode   = "block"
-  reply_to_mode = "all"
-  history_limit = 25
-  media_max_mb  = 10
+resource "openclaw_gateway" "test" {
+  port = 19000
+}
+
+resource "openclaw_tailscale" "test" {
+  hostname   = "openclaw"
+  auth_key   = "tskey-auth-test"
+  serve_path = "/openclaw"
+  funnel     = true
+  acl_tags   = ["tag:openclaw"]
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_channel_telegram.test", "dm_policy", "open"),
-					resource.TestCheckResourceAttr("openclaw_channel_telegram.test", "stream_mode", "block"),
-					resource.TestCheckResourceAttr("openclaw_channel_telegram.test", "reply_to_mode", "all"),
-					resource.TestCheckResourceAttr("openclaw_channel_telegram.test", "history_limit", "25"),
+					resource.TestCheckResourceAttr("openclaw_tailscale.test", "id", "tailscale"),
+					resource.TestCheckNoResourceAttr("openclaw_gateway.test", "tailscale_mode"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						for _, want := range []string{`"mode": "funnel"`, `"authKey": "tskey-auth-test"`, `"tag:openclaw"`} {
+							if !strings.Contains(string(raw), want) {
+								return fmt.Errorf("config missing %s: %s", want, raw)
+							}
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Unsetting attributes removes them from the config.
+				Config: providerBlock + `
+resource "openclaw_gateway" "test" {
+  port = 19000
+}
+
+resource "openclaw_tailscale" "test" {
+  hostname        = "openclaw"
+  https_cert_mode = "off"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("openclaw_tailscale.test", "funnel"),
+					resource.TestCheckNoResourceAttr("openclaw_tailscale.test", "acl_tags"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if !strings.Contains(string(raw), `"mode": "serve"`) || strings.Contains(string(raw), "tskey") {
+							return fmt.Errorf("unexpected config: %s", raw)
+						}
+						return nil
+					},
 				),
 			},
+			{
+				ResourceName:      "openclaw_tailscale.test",
+				ImportState:       true,
+				ImportStateId:     "tailscale",
+				ImportStateVerify: true,
+			},
+			{
+				// Destroying openclaw_gateway must keep the Tailscale settings.
+				Config: providerBlock + `
+resource "openclaw_tailscale" "test" {
+  hostname        = "openclaw"
+  https_cert_mode = "off"
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					if !strings.Contains(string(raw), `"mode": "serve"`) || strings.Contains(string(raw), "19000") {
+						return fmt.Errorf("unexpected config after destroying openclaw_gateway: %s", raw)
+					}
+					return nil
+				},
+			},
 		},
 	})
 }
 
-func TestAccFileMode_ChannelDiscord(t *testing.T) {
+func TestAccFileMode_TailscaleResource_FunnelWithoutHTTPS(t *testing.T) {
 	_, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
@@ -199,60 +306,80 @@ func TestAccFileMode_ChannelDiscord(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-resource "openclaw_channel_discord" "test" {
-  enabled           = true
-  token             = "test-discord-token"
-  dm_policy         = "allowlist"
-  allow_from        = ["user1", "user2"]
-  history_limit     = 30
-  reply_to_mode     = "first"
-  actions_reactions = true
-  actions_messages  = true
-  actions_search    = false
+resource "openclaw_tailscale" "test" {
+  funnel          = true
+  https_cert_mode = "off"
 }
 `,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "dm_policy", "allowlist"),
-					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "allow_from.#", "2"),
-					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "history_limit", "30"),
-					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "reply_to_mode", "first"),
-					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "actions_reactions", "true"),
-					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "actions_search", "false"),
-				),
+				ExpectError: regexp.MustCompile(`Funnel requires HTTPS`),
 			},
 		},
 	})
 }
 
-func TestAccFileMode_SessionResource(t *testing.T) {
-	_, providerBlock := testConfigDir(t)
+func TestAccFileMode_VoiceResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-resource "openclaw_session" "test" {
-  dm_scope           = "per-channel-peer"
-  reset_mode         = "daily"
-  reset_at_hour      = 4
-  reset_idle_minutes = 60
-  reset_triggers     = ["/new", "/reset"]
+resource "openclaw_voice" "test" {
+  stt_provider = "openai"
+  stt_model    = "whisper-1"
+  tts_provider = "elevenlabs"
+  tts_voice    = "rachel"
+  tts_speed    = 1.25
+  auto_transcribe = {
+    telegram = true
+    discord  = false
+  }
+  max_audio_duration_seconds = 300
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_session.test", "dm_scope", "per-channel-peer"),
-					resource.TestCheckResourceAttr("openclaw_session.test", "reset_mode", "daily"),
-					resource.TestCheckResourceAttr("openclaw_session.test", "reset_at_hour", "4"),
-					resource.TestCheckResourceAttr("openclaw_session.test", "reset_idle_minutes", "60"),
-					resource.TestCheckResourceAttr("openclaw_session.test", "reset_triggers.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_voice.test", "id", "voice"),
+					resource.TestCheckResourceAttr("openclaw_voice.test", "tts_speed", "1.25"),
+					resource.TestCheckResourceAttr("openclaw_voice.test", "auto_transcribe.discord", "false"),
+				),
+			},
+			{
+				// Dropped settings and channels are removed from the config.
+				Config: providerBlock + `
+resource "openclaw_voice" "test" {
+  stt_provider = "openai"
+  stt_model    = "whisper-1"
+  auto_transcribe = {
+    telegram = true
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_voice.test", "auto_transcribe.%", "1"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(raw), "discord") || strings.Contains(string(raw), "tts") {
+							return fmt.Errorf("dropped settings left in config: %s", raw)
+						}
+						return nil
+					},
 				),
 			},
+			{
+				ResourceName:      "openclaw_voice.test",
+				ImportState:       true,
+				ImportStateId:     "voice",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func TestAccFileMode_MessagesResource(t *testing.T) {
+func TestAccFileMode_VoiceResource_InvalidSpeed(t *testing.T) {
 	_, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
@@ -260,53 +387,86 @@ func TestAccFileMode_MessagesResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-resource "openclaw_messages" "test" {
-  response_prefix     = "[Bot]"
-  ack_reaction        = "👀"
-  ack_reaction_scope  = "all"
-  queue_mode          = "collect"
-  queue_debounce_ms   = 500
-  queue_cap           = 10
-  inbound_debounce_ms = 3000
+resource "openclaw_voice" "test" {
+  tts_speed = 10
 }
 `,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_messages.test", "response_prefix", "[Bot]"),
-					resource.TestCheckResourceAttr("openclaw_messages.test", "ack_reaction", "👀"),
-					resource.TestCheckResourceAttr("openclaw_messages.test", "queue_mode", "collect"),
-					resource.TestCheckResourceAttr("openclaw_messages.test", "queue_cap", "10"),
-					resource.TestCheckResourceAttr("openclaw_messages.test", "inbound_debounce_ms", "3000"),
-				),
+				ExpectError: regexp.MustCompile(`Invalid speed`),
 			},
 		},
 	})
 }
 
-func TestAccFileMode_CronResource(t *testing.T) {
-	_, providerBlock := testConfigDir(t)
+func TestAccFileMode_ImageGenerationResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+	os.WriteFile(cfgPath, []byte(`{"tools":{"imageGeneration":{"enabled":true}}}`), 0o644)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-resource "openclaw_cron" "test" {
-  enabled             = true
-  max_concurrent_runs = 3
-  session_retention   = "48h"
+resource "openclaw_image_generation" "test" {
+  image_provider  = "openai"
+  model           = "gpt-image-1"
+  default_size    = "1024x1536"
+  default_quality = "high"
+  channels = {
+    discord  = false
+    telegram = true
+  }
+  daily_limit = 50
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_cron.test", "enabled", "true"),
-					resource.TestCheckResourceAttr("openclaw_cron.test", "max_concurrent_runs", "3"),
-					resource.TestCheckResourceAttr("openclaw_cron.test", "session_retention", "48h"),
+					resource.TestCheckResourceAttr("openclaw_image_generation.test", "id", "image_generation"),
+					resource.TestCheckResourceAttr("openclaw_image_generation.test", "channels.discord", "false"),
 				),
 			},
+			{
+				Config: providerBlock + `
+resource "openclaw_image_generation" "test" {
+  model = "gpt-image-1"
+  channels = {
+    telegram = true
+  }
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					for _, gone := range []string{"discord", "openai", "1024x1536", "dailyLimit"} {
+						if strings.Contains(string(raw), gone) {
+							return fmt.Errorf("%s left in config: %s", gone, raw)
+						}
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_image_generation.test",
+				ImportState:       true,
+				ImportStateId:     "image_generation",
+				ImportStateVerify: true,
+			},
+		},
+		// Keys the resource does not manage survive its deletion.
+		CheckDestroy: func(*terraform.State) error {
+			raw, err := os.ReadFile(cfgPath)
+			if err != nil {
+				return err
+			}
+			if !strings.Contains(string(raw), `"enabled"`) || strings.Contains(string(raw), "gpt-image-1") {
+				return fmt.Errorf("unexpected config after destroy: %s", raw)
+			}
+			return nil
 		},
 	})
 }
 
-func TestAccFileMode_HookResource(t *testing.T) {
+func TestAccFileMode_ImageGenerationResource_InvalidSize(t *testing.T) {
 	_, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
@@ -314,209 +474,4393 @@ func TestAccFileMode_HookResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-resource "openclaw_hook" "test" {
-  enabled             = true
-  token               = "test-hook-secret"
-  path                = "/webhooks"
-  default_session_key = "hook:test"
+resource "openclaw_image_generation" "test" {
+  default_size = "large"
 }
 `,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_hook.test", "enabled", "true"),
-					resource.TestCheckResourceAttr("openclaw_hook.test", "path", "/webhooks"),
-					resource.TestCheckResourceAttr("openclaw_hook.test", "default_session_key", "hook:test"),
-				),
+				ExpectError: regexp.MustCompile(`Invalid size`),
 			},
 		},
 	})
 }
 
-func TestAccFileMode_ToolsResource(t *testing.T) {
-	_, providerBlock := testConfigDir(t)
+func TestAccFileMode_WebhookSubscriptionResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-resource "openclaw_tools" "test" {
-  profile          = "coding"
-  deny             = ["canvas", "browser"]
-  elevated_enabled = true
-  browser_enabled  = false
+resource "openclaw_webhook_subscription" "test" {
+  name   = "pagerduty"
+  url    = "https://events.example.com/openclaw"
+  secret = "s3cret"
+  events = ["run.error", "heartbeat.missed"]
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_tools.test", "profile", "coding"),
-					resource.TestCheckResourceAttr("openclaw_tools.test", "deny.#", "2"),
-					resource.TestCheckResourceAttr("openclaw_tools.test", "elevated_enabled", "true"),
-					resource.TestCheckResourceAttr("openclaw_tools.test", "browser_enabled", "false"),
+					resource.TestCheckResourceAttr("openclaw_webhook_subscription.test", "id", "pagerduty"),
+					resource.TestCheckResourceAttr("openclaw_webhook_subscription.test", "events.#", "2"),
+				),
+			},
+			{
+				// Dropping events subscribes to everything again.
+				Config: providerBlock + `
+resource "openclaw_webhook_subscription" "test" {
+  name    = "pagerduty"
+  url     = "https://events.example.com/openclaw"
+  enabled = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("openclaw_webhook_subscription.test", "events"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(raw), "run.error") || strings.Contains(string(raw), "s3cret") {
+							return fmt.Errorf("removed settings left in config: %s", raw)
+						}
+						return nil
+					},
 				),
 			},
+			{
+				ResourceName:      "openclaw_webhook_subscription.test",
+				ImportState:       true,
+				ImportStateId:     "pagerduty",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func TestAccFileMode_ConfigDataSource(t *testing.T) {
-	cfgPath, providerBlock := testConfigDir(t)
-
-	// Seed the config with some data
-	os.WriteFile(cfgPath, []byte(`{"gateway":{"port":18789}}`), 0o644)
+func TestAccFileMode_WebhookSubscriptionResource_DuplicateEvent(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-data "openclaw_config" "test" {}
+resource "openclaw_webhook_subscription" "test" {
+  name   = "ops"
+  url    = "https://events.example.com/openclaw"
+  events = ["run.error", "run.error"]
+}
 `,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("data.openclaw_config.test", "raw"),
-					resource.TestCheckResourceAttrSet("data.openclaw_config.test", "hash"),
-				),
+				ExpectError: regexp.MustCompile(`Duplicate event`),
 			},
 		},
 	})
 }
 
-func TestAccFileMode_MultiResourceComposition(t *testing.T) {
-	_, providerBlock := testConfigDir(t)
+func TestAccFileMode_NotificationRuleResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-resource "openclaw_gateway" "test" {
-  port        = 19000
-  bind        = "loopback"
-  reload_mode = "hybrid"
-}
-
-resource "openclaw_agent_defaults" "test" {
-  workspace       = "~/.openclaw/workspace-multi"
-  model_primary   = "anthropic/claude-sonnet-4-5"
-  timeout_seconds = 300
+resource "openclaw_notification_rule" "test" {
+  name           = "run-failures"
+  event          = "run.error"
+  target_channel = "telegram"
+  target_peer    = "123456789"
+  template       = "Run failed on {{agentId}}"
 }
-
-resource "openclaw_channel_whatsapp" "test" {
-  dm_policy  = "pairing"
-  allow_from = ["+15555550123"]
-}
-
-resource "openclaw_session" "test" {
-  dm_scope   = "per-peer"
-  reset_mode = "idle"
-  reset_idle_minutes = 30
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_notification_rule.test", "id", "run-failures"),
+					resource.TestCheckResourceAttr("openclaw_notification_rule.test", "target_peer", "123456789"),
+				),
+			},
+			{
+				// Switching to a webhook drops the peer from the config.
+				Config: providerBlock + `
+resource "openclaw_webhook_subscription" "ops" {
+  name = "ops"
+  url  = "https://events.example.com/openclaw"
 }
 
-resource "openclaw_cron" "test" {
-  enabled = true
+resource "openclaw_notification_rule" "test" {
+  name           = "run-failures"
+  event          = "run.error"
+  target_webhook = openclaw_webhook_subscription.ops.name
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("openclaw_gateway.test", "port", "19000"),
-					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "model_primary", "anthropic/claude-sonnet-4-5"),
-					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "dm_policy", "pairing"),
-					resource.TestCheckResourceAttr("openclaw_session.test", "dm_scope", "per-peer"),
-					resource.TestCheckResourceAttr("openclaw_cron.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("openclaw_notification_rule.test", "target_webhook", "ops"),
+					resource.TestCheckNoResourceAttr("openclaw_notification_rule.test", "target_channel"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(raw), "123456789") || strings.Contains(string(raw), "agentId") {
+							return fmt.Errorf("stale settings left in config: %s", raw)
+						}
+						return nil
+					},
 				),
 			},
+			{
+				ResourceName:      "openclaw_notification_rule.test",
+				ImportState:       true,
+				ImportStateId:     "run-failures",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func TestAccFileMode_GatewayDataSource(t *testing.T) {
-	cfgPath, providerBlock := testConfigDir(t)
-
-	// Pre-populate config with gateway section
-	os.WriteFile(cfgPath,
-		[]byte(`{"gateway":{"port":19999,"bind":"loopback","mode":"local","auth":{"mode":"token"},"reload":{"mode":"hybrid"}}}`), 0o644)
+func TestAccFileMode_NotificationRuleResource_MissingPeer(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-data "openclaw_gateway" "test" {}
+resource "openclaw_notification_rule" "test" {
+  name           = "budget"
+  event          = "budget.threshold"
+  target_channel = "slack"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing target peer`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_CompactionResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_compaction" "test" {
+  max_context_tokens = 150000
+  strategy           = "summarize"
+  summary_model      = "anthropic/claude-haiku-4-5"
+  trigger_ratio      = 0.8
+  trigger_messages   = 200
+}
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "port", "19999"),
-					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "bind", "loopback"),
-					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "auth_mode", "token"),
-					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "reload_mode", "hybrid"),
-					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "mode", "local"),
+					resource.TestCheckResourceAttr("openclaw_compaction.test", "id", "compaction"),
+					resource.TestCheckResourceAttr("openclaw_compaction.test", "trigger_ratio", "0.8"),
 				),
 			},
+			{
+				Config: providerBlock + `
+resource "openclaw_compaction" "test" {
+  strategy      = "truncate"
+  trigger_ratio = 0.9
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					for _, gone := range []string{"summaryModel", "maxContextTokens", "messages"} {
+						if strings.Contains(string(raw), gone) {
+							return fmt.Errorf("%s left in config: %s", gone, raw)
+						}
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_compaction.test",
+				ImportState:       true,
+				ImportStateId:     "compaction",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func TestAccFileMode_AgentDefaultsDataSource(t *testing.T) {
+func TestAccFileMode_CompactionResource_UnusedSummaryModel(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_compaction" "test" {
+  strategy      = "sliding-window"
+  summary_model = "anthropic/claude-haiku-4-5"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unused summary model`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_PrivacyResource(t *testing.T) {
 	cfgPath, providerBlock := testConfigDir(t)
 
-	os.WriteFile(cfgPath,
-		[]byte(`{"agents":{"defaults":{"workspace":"~/ws","timeout":300,"heartbeat":{"every":"15m","target":"last"}}}}`), 0o644)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_privacy" "test" {
+  redaction_patterns   = ["sk-[A-Za-z0-9]{20,}", "\\b\\d{3}-\\d{2}-\\d{4}\\b"]
+  redaction_targets    = ["logs"]
+  mask_pii             = true
+  transcript_retention = "30d"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_privacy.test", "id", "privacy"),
+					resource.TestCheckResourceAttr("openclaw_privacy.test", "redaction_patterns.1", `\b\d{3}-\d{2}-\d{4}\b`),
+				),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_privacy" "test" {
+  mask_pii = true
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					if strings.Contains(string(raw), "redact") || strings.Contains(string(raw), "30d") {
+						return fmt.Errorf("removed settings left in config: %s", raw)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_privacy.test",
+				ImportState:       true,
+				ImportStateId:     "privacy",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_PrivacyResource_InvalidPattern(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-data "openclaw_agent_defaults" "test" {}
+resource "openclaw_privacy" "test" {
+  redaction_patterns = ["token=([a-z"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid pattern`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ContentFilterResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_content_filter" "test" {
+  direction                = "inbound"
+  blocked_phrases          = ["wire transfer", "gift card"]
+  blocked_domains          = ["bit.ly", "tinyurl.com"]
+  blocked_attachment_types = ["application/x-msdownload", "video/*"]
+  action                   = "require-approval"
+}
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.openclaw_agent_defaults.test", "workspace", "~/ws"),
-					resource.TestCheckResourceAttr("data.openclaw_agent_defaults.test", "timeout_seconds", "300"),
-					resource.TestCheckResourceAttr("data.openclaw_agent_defaults.test", "heartbeat_every", "15m"),
-					resource.TestCheckResourceAttr("data.openclaw_agent_defaults.test", "heartbeat_target", "last"),
+					resource.TestCheckResourceAttr("openclaw_content_filter.test", "id", "content_filter"),
+					resource.TestCheckResourceAttr("openclaw_content_filter.test", "blocked_domains.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_content_filter.test", "blocked_attachment_types.1", "video/*"),
 				),
 			},
+			{
+				Config: providerBlock + `
+resource "openclaw_content_filter" "test" {
+  blocked_phrases = ["wire transfer"]
+  action          = "drop"
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					if strings.Contains(string(raw), "bit.ly") || strings.Contains(string(raw), "video/*") || strings.Contains(string(raw), "inbound") {
+						return fmt.Errorf("removed settings left in config: %s", raw)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_content_filter.test",
+				ImportState:       true,
+				ImportStateId:     "content_filter",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func TestAccFileMode_AgentsDataSource(t *testing.T) {
+func TestAccFileMode_ContentFilterResource_InvalidLists(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_content_filter" "test" {
+  blocked_phrases = ["Gift card", "gift card"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Duplicate value`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_content_filter" "test" {
+  blocked_domains = ["example.com"]
+  allowed_domains = ["docs.openclaw.ai", "Example.com"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Conflicting domain`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_content_filter" "test" {
+  blocked_domains = ["https://bit.ly/x"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid value`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_HandoffResource(t *testing.T) {
 	cfgPath, providerBlock := testConfigDir(t)
 
-	os.WriteFile(cfgPath,
-		[]byte(`{"agents":{"list":[{"id":"main","default":true,"name":"Main Agent","model":"anthropic/claude-sonnet-4-20250514"},{"id":"research","name":"Research","model":"openai/gpt-4.1"}]}}`), 0o644)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_handoff" "test" {
+  trigger_phrases      = ["talk to a human", "speak to someone"]
+  operator_channel     = "slack"
+  operator_peer        = "C0123456789"
+  office_hours         = "09:00-17:30"
+  office_days          = ["mon", "tue", "wed", "thu", "fri"]
+  office_timezone      = "Europe/London"
+  auto_resume          = true
+  resume_after_minutes = 45
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_handoff.test", "id", "handoff"),
+					resource.TestCheckResourceAttr("openclaw_handoff.test", "office_days.#", "5"),
+					resource.TestCheckResourceAttr("openclaw_handoff.test", "resume_after_minutes", "45"),
+				),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_handoff" "test" {
+  trigger_phrases  = ["talk to a human"]
+  operator_channel = "telegram"
+  operator_peer    = "123456789"
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					if strings.Contains(string(raw), "officeHours") || strings.Contains(string(raw), "resumeAfterMinutes") {
+						return fmt.Errorf("removed settings left in config: %s", raw)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_handoff.test",
+				ImportState:       true,
+				ImportStateId:     "handoff",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_HandoffResource_InvalidOfficeHours(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-data "openclaw_agents" "test" {}
+resource "openclaw_handoff" "test" {
+  operator_channel = "slack"
+  operator_peer    = "C0123456789"
+  office_hours     = "9am-5pm"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid window`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_handoff" "test" {
+  operator_channel = "slack"
+  operator_peer    = "C0123456789"
+  office_days      = ["mon"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing office hours`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_handoff" "test" {
+  operator_channel = "slack"
+  operator_peer    = "C0123456789"
+  office_hours     = "09:00-17:00"
+  office_days      = ["mon", "monday"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid value`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_QuietHoursResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_quiet_hours" "night" {
+  name             = "night"
+  window           = "22:00-07:00"
+  timezone         = "America/New_York"
+  channels         = ["whatsapp", "sms"]
+  defer_heartbeats = true
+}
+
+resource "openclaw_quiet_hours" "weekend" {
+  name   = "weekend"
+  window = "00:00-23:59"
+  days   = ["sat", "sun"]
+  action = "drop"
+}
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.openclaw_agents.test", "default_agent_id", "main"),
-					resource.TestCheckResourceAttr("data.openclaw_agents.test", "agent_ids.#", "2"),
-					resource.TestCheckResourceAttr("data.openclaw_agents.test", "agents.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_quiet_hours.night", "id", "night"),
+					resource.TestCheckResourceAttr("openclaw_quiet_hours.night", "channels.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_quiet_hours.weekend", "days.1", "sun"),
 				),
 			},
+			{
+				Config: providerBlock + `
+resource "openclaw_quiet_hours" "night" {
+  name   = "night"
+  window = "23:00-06:00"
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					if strings.Contains(string(raw), "weekend") || strings.Contains(string(raw), "whatsapp") {
+						return fmt.Errorf("removed settings left in config: %s", raw)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_quiet_hours.night",
+				ImportState:       true,
+				ImportStateId:     "night",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func TestAccFileMode_ChannelsDataSource(t *testing.T) {
+func TestAccFileMode_QuietHoursResource_InvalidWindow(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_quiet_hours" "test" {
+  name   = "night"
+  window = "22:00"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid window`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_quiet_hours" "test" {
+  name   = "night"
+  window = "22:00-07:00"
+  action = "defer"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid action`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AutoreplyResource(t *testing.T) {
 	cfgPath, providerBlock := testConfigDir(t)
 
-	os.WriteFile(cfgPath,
-		[]byte(`{"channels":{"whatsapp":{"dmPolicy":"pairing"},"telegram":{"enabled":true,"dmPolicy":"allowlist"},"discord":{"enabled":false}}}`), 0o644)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_autoreply" "test" {
+  template         = "Hi {{peerName}}, we're closed until 2 January."
+  channels         = ["whatsapp", "telegram"]
+  cooldown_minutes = 720
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_autoreply.test", "id", "autoreply"),
+					resource.TestCheckResourceAttr("openclaw_autoreply.test", "channels.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_autoreply.test", "cooldown_minutes", "720"),
+				),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_autoreply" "test" {
+  enabled  = false
+  template = "Hi {{peerName}}, we're closed until 2 January."
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					if strings.Contains(string(raw), "whatsapp") || strings.Contains(string(raw), "720") {
+						return fmt.Errorf("removed settings left in config: %s", raw)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_autoreply.test",
+				ImportState:       true,
+				ImportStateId:     "autoreply",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AutoreplyResource_DuplicateChannel(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: providerBlock + `
-data "openclaw_channels" "test" {}
+resource "openclaw_autoreply" "test" {
+  template = "Back soon."
+  channels = ["sms", "whatsapp", "sms"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Duplicate value`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SlashCommandResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_slash_command" "test" {
+  name             = "standup"
+  description      = "Summarize yesterday's work"
+  prompt_template  = "Write a standup update for {{peerName}}. Notes: {{args}}"
+  allowed_channels = ["slack"]
+  required_role    = "allowlisted"
+}
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.openclaw_channels.test", "names.#", "3"),
-					resource.TestCheckResourceAttr("data.openclaw_channels.test", "channels.#", "3"),
+					resource.TestCheckResourceAttr("openclaw_slash_command.test", "id", "standup"),
+					resource.TestCheckResourceAttr("openclaw_slash_command.test", "allowed_channels.0", "slack"),
 				),
 			},
+			{
+				Config: providerBlock + `
+resource "openclaw_slash_command" "test" {
+  name            = "standup"
+  prompt_template = "Write a standup update. Notes: {{args}}"
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					if strings.Contains(string(raw), "allowlisted") || strings.Contains(string(raw), "slack") {
+						return fmt.Errorf("removed settings left in config: %s", raw)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_slash_command.test",
+				ImportState:       true,
+				ImportStateId:     "standup",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SlashCommandResource_InvalidName(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_slash_command" "test" {
+  name            = "/Standup"
+  prompt_template = "Write a standup update."
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid command name`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SessionOverrideResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_session" "test" {
+  dm_scope   = "per-peer"
+  reset_mode = "daily"
+}
+
+resource "openclaw_session_override" "whatsapp" {
+  channel            = "whatsapp"
+  reset_mode         = "idle"
+  reset_idle_minutes = 240
+}
+
+resource "openclaw_session_override" "support" {
+  agent_id = "support"
+  dm_scope = "per-channel-peer"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_session_override.whatsapp", "id", "channel:whatsapp"),
+					resource.TestCheckResourceAttr("openclaw_session_override.support", "id", "agent:support"),
+					resource.TestCheckResourceAttr("openclaw_session_override.whatsapp", "reset_idle_minutes", "240"),
+				),
+			},
+			{
+				// Destroying openclaw_session leaves the overrides in place.
+				Config: providerBlock + `
+resource "openclaw_session_override" "whatsapp" {
+  channel  = "whatsapp"
+  dm_scope = "main"
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					s := string(raw)
+					if strings.Contains(s, "agent:support") || strings.Contains(s, "240") || strings.Contains(s, "per-peer") {
+						return fmt.Errorf("removed settings left in config: %s", raw)
+					}
+					if !strings.Contains(s, "channel:whatsapp") {
+						return fmt.Errorf("override removed with session config: %s", raw)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_session_override.whatsapp",
+				ImportState:       true,
+				ImportStateId:     "channel:whatsapp",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SessionOverrideResource_InvalidScope(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_session_override" "test" {
+  channel  = "discord"
+  agent_id = "support"
+}
+`,
+				ExpectError: regexp.MustCompile(`Conflicting scope`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_session_override" "test" {
+  reset_mode = "idle"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing scope`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_HeartbeatResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_heartbeat" "defaults" {
+  every              = "30m"
+  target             = "last"
+  prompt             = "Check the inbox and summarize anything urgent."
+  active_hours_start = "08:00"
+  active_hours_end   = "22:00"
+}
+
+resource "openclaw_agent" "test" {
+  agent_id = "ops"
+  model    = "anthropic/claude-sonnet-4-5"
+}
+
+resource "openclaw_heartbeat" "ops" {
+  agent_id = openclaw_agent.test.agent_id
+  every    = "1h30m"
+  target   = "slack"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_heartbeat.defaults", "id", "defaults"),
+					resource.TestCheckResourceAttr("openclaw_heartbeat.ops", "id", "agent:ops"),
+					resource.TestCheckResourceAttr("openclaw_heartbeat.ops", "every", "1h30m"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_heartbeat.ops",
+				ImportState:       true,
+				ImportStateId:     "agent:ops",
+				ImportStateVerify: true,
+			},
+			{
+				// Rewriting the agent entry keeps its heartbeat.
+				Config: providerBlock + `
+resource "openclaw_heartbeat" "defaults" {
+  every              = "30m"
+  target             = "last"
+  prompt             = "Check the inbox and summarize anything urgent."
+  active_hours_start = "08:00"
+  active_hours_end   = "22:00"
+}
+
+resource "openclaw_agent" "test" {
+  agent_id = "ops"
+  model    = "anthropic/claude-opus-4-6"
+}
+
+resource "openclaw_heartbeat" "ops" {
+  agent_id = openclaw_agent.test.agent_id
+  every    = "1h30m"
+  target   = "slack"
+}
+`,
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_heartbeat" "defaults" {
+  every = "2h"
+}
+
+resource "openclaw_agent" "test" {
+  agent_id = "ops"
+  model    = "anthropic/claude-sonnet-4-5"
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					s := string(raw)
+					if strings.Contains(s, "activeHours") || strings.Contains(s, "1h30m") || strings.Contains(s, "inbox") {
+						return fmt.Errorf("removed settings left in config: %s", raw)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_heartbeat.defaults",
+				ImportState:       true,
+				ImportStateId:     "defaults",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_HeartbeatResource_AgentDefaultsDestroy(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	heartbeat := `
+resource "openclaw_heartbeat" "defaults" {
+  every  = "45m"
+  target = "none"
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + heartbeat + `
+resource "openclaw_agent_defaults" "test" {
+  workspace     = "~/.openclaw/workspace-test"
+  model_primary = "anthropic/claude-opus-4-6"
+
+  depends_on = [openclaw_heartbeat.defaults]
+}
+`,
+			},
+			{
+				// Destroying openclaw_agent_defaults must keep the heartbeat.
+				Config: providerBlock + heartbeat,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_heartbeat.defaults", "every", "45m"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if !strings.Contains(string(raw), `"every": "45m"`) || strings.Contains(string(raw), "workspace-test") {
+							return fmt.Errorf("unexpected config after destroying openclaw_agent_defaults: %s", raw)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_HeartbeatResource_InvalidDuration(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_heartbeat" "test" {
+  every = "30 minutes"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid duration`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_heartbeat" "test" {
+  every              = "30m"
+  active_hours_start = "08:00"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing active hours end`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_BindingSetResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_binding_set" "test" {
+  bindings = [
+    {
+      agent_id = "oncall"
+      match    = { channel = "discord", peer_kind = "group", peer_id = "incidents" }
+    },
+    {
+      agent_id = "support"
+      match    = { channel = "whatsapp" }
+    },
+    {
+      agent_id = "main"
+      match    = { channel = "discord" }
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_binding_set.test", "id", "bindings"),
+					resource.TestCheckResourceAttr("openclaw_binding_set.test", "bindings.#", "3"),
+					resource.TestCheckResourceAttr("openclaw_binding_set.test", "bindings.0.agent_id", "oncall"),
+					resource.TestCheckResourceAttr("openclaw_binding_set.test", "bindings.0.match.peer_id", "incidents"),
+					resource.TestCheckResourceAttr("openclaw_binding_set.test", "bindings.2.agent_id", "main"),
+				),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_binding_set" "test" {
+  bindings = [
+    {
+      agent_id = "main"
+      match    = { channel = "discord" }
+    },
+    {
+      agent_id = "oncall"
+      match    = { channel = "discord", peer_kind = "group", peer_id = "incidents" }
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_binding_set.test", "bindings.#", "2"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(raw), "support") {
+							return fmt.Errorf("removed binding left in config: %s", raw)
+						}
+						if strings.Index(string(raw), "main") > strings.Index(string(raw), "oncall") {
+							return fmt.Errorf("bindings not written in order: %s", raw)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "openclaw_binding_set.test",
+				ImportState:       true,
+				ImportStateId:     "bindings",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_BindingSetResource_Unreachable(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_binding_set" "test" {
+  bindings = [
+    {
+      agent_id = "main"
+      match    = { channel = "telegram" }
+    },
+    {
+      agent_id = "support"
+      match    = { channel = "telegram" }
+    },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`Unreachable binding`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AgentEnvResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_agent" "test" {
+  agent_id = "research"
+  model    = "anthropic/claude-sonnet-4-20250514"
+}
+
+resource "openclaw_agent_env" "test" {
+  agent_id = openclaw_agent.test.agent_id
+  vars = {
+    WORKSPACE_REGION = "eu-west-1"
+  }
+  sensitive_vars = {
+    SEARCH_API_KEY = "sk-test-123"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_agent_env.test", "id", "research"),
+					resource.TestCheckResourceAttr("openclaw_agent_env.test", "vars.WORKSPACE_REGION", "eu-west-1"),
+					resource.TestCheckResourceAttr("openclaw_agent_env.test", "sensitive_vars.SEARCH_API_KEY", "sk-test-123"),
+				),
+			},
+			{
+				// Rewriting the agent entry must keep the environment.
+				Config: providerBlock + `
+resource "openclaw_agent" "test" {
+  agent_id = "research"
+  model    = "openai/gpt-4.1"
+}
+
+resource "openclaw_agent_env" "test" {
+  agent_id = openclaw_agent.test.agent_id
+  sensitive_vars = {
+    SEARCH_API_KEY = "sk-test-456"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_agent.test", "model", "openai/gpt-4.1"),
+					resource.TestCheckNoResourceAttr("openclaw_agent_env.test", "vars"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(raw), "eu-west-1") || strings.Contains(string(raw), "sk-test-123") {
+							return fmt.Errorf("removed variables left in config: %s", raw)
+						}
+						if !strings.Contains(string(raw), "sk-test-456") {
+							return fmt.Errorf("agent rewrite dropped env.vars: %s", raw)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "openclaw_agent_env.test",
+				ImportState:       true,
+				ImportStateId:     "research",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AgentEnvResource_Invalid(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_agent_env" "test" {
+  agent_id = "main"
+  vars = {
+    "1BAD-NAME" = "x"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid variable name`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_agent_env" "test" {
+  agent_id = "main"
+  vars = {
+    API_KEY = "x"
+  }
+  sensitive_vars = {
+    API_KEY = "y"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Conflicting variable`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SkillRepositoryResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_skill_repository" "test" {
+  name        = "internal"
+  url         = "https://git.example.com/platform/skills.git"
+  auth_token  = "ghp-test-token"
+  auto_update = true
+  ref         = "v2.3.0"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_skill_repository.test", "id", "internal"),
+					resource.TestCheckResourceAttr("openclaw_skill_repository.test", "auto_update", "true"),
+					resource.TestCheckResourceAttr("openclaw_skill_repository.test", "ref", "v2.3.0"),
+				),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_skill_repository" "test" {
+  name = "internal"
+  url  = "git@git.example.com:platform/skills.git"
+}
+`,
+				Check: func(*terraform.State) error {
+					raw, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					if strings.Contains(string(raw), "ghp-test-token") || strings.Contains(string(raw), "v2.3.0") {
+						return fmt.Errorf("removed settings left in config: %s", raw)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_skill_repository.test",
+				ImportState:       true,
+				ImportStateId:     "internal",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SkillRepositoryResource_Invalid(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_skill_repository" "test" {
+  name = "internal"
+  url  = "ftp://git.example.com/skills.git"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid URL`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_skill_repository" "test" {
+  name       = "internal"
+  url        = "git@github.com:example/skills.git"
+  auth_token = "ghp-test-token"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unused auth token`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_CronGlobalWindowResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_cron" "test" {
+  enabled = true
+}
+
+resource "openclaw_cron_global_window" "test" {
+  windows = [
+    {
+      days     = ["fri"]
+      start    = "16:00"
+      end      = "02:00"
+      timezone = "Europe/Berlin"
+    },
+    {
+      days     = ["sat"]
+      start    = "02:00"
+      end      = "06:00"
+      timezone = "Europe/Berlin"
+    },
+    {
+      start = "03:00"
+      end   = "03:30"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_cron_global_window.test", "id", "blackoutWindows"),
+					resource.TestCheckResourceAttr("openclaw_cron_global_window.test", "windows.#", "3"),
+					resource.TestCheckResourceAttr("openclaw_cron_global_window.test", "windows.0.end", "02:00"),
+					resource.TestCheckNoResourceAttr("openclaw_cron_global_window.test", "windows.2.days"),
+				),
+			},
+			{
+				// Destroying openclaw_cron must leave the windows in place.
+				Config: providerBlock + `
+resource "openclaw_cron_global_window" "test" {
+  windows = [
+    {
+      days  = ["mon", "tue", "wed", "thu", "fri"]
+      start = "03:00"
+      end   = "03:30"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_cron_global_window.test", "windows.#", "1"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(raw), "Europe/Berlin") {
+							return fmt.Errorf("removed windows left in config: %s", raw)
+						}
+						if !strings.Contains(string(raw), "03:30") {
+							return fmt.Errorf("windows removed with openclaw_cron: %s", raw)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "openclaw_cron_global_window.test",
+				ImportState:       true,
+				ImportStateId:     "blackoutWindows",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_CronGlobalWindowResource_Invalid(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_cron_global_window" "test" {
+  windows = [
+    {
+      days  = ["sun"]
+      start = "22:00"
+      end   = "01:00"
+    },
+    {
+      days  = ["mon"]
+      start = "00:30"
+      end   = "04:00"
+    },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`Overlapping windows`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_cron_global_window" "test" {
+  windows = [
+    {
+      days     = ["someday"]
+      start    = "25:00"
+      end      = "03:00"
+      timezone = "Mars/Olympus"
+    },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid value(.|\n)*Invalid timezone(.|\n)*Invalid time`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_DMPairingPolicyResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	config := func(dmPolicy, policies string) string {
+		return providerBlock + `
+resource "openclaw_channel_telegram" "test" {
+  bot_token = "123456:ABCDEF"
+  dm_policy = "` + dmPolicy + `"
+}
+` + policies
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("pairing", `
+resource "openclaw_dm_pairing_policy" "global" {
+  code_ttl         = "15m"
+  max_pending      = 5
+  approval_channel = "slack"
+}
+
+resource "openclaw_dm_pairing_policy" "telegram" {
+  channel     = "telegram"
+  code_ttl    = "5m"
+  max_pending = 2
+
+  depends_on = [openclaw_channel_telegram.test]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_dm_pairing_policy.global", "id", "global"),
+					resource.TestCheckResourceAttr("openclaw_dm_pairing_policy.global", "max_pending", "5"),
+					resource.TestCheckResourceAttr("openclaw_dm_pairing_policy.telegram", "id", "channel:telegram"),
+					resource.TestCheckResourceAttr("openclaw_dm_pairing_policy.telegram", "code_ttl", "5m"),
+				),
+			},
+			{
+				// Rewriting the channel must keep its pairing policy.
+				Config: config("allowlist", `
+resource "openclaw_dm_pairing_policy" "global" {
+  code_ttl = "10m"
+}
+
+resource "openclaw_dm_pairing_policy" "telegram" {
+  channel  = "telegram"
+  code_ttl = "5m"
+
+  depends_on = [openclaw_channel_telegram.test]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_telegram.test", "dm_policy", "allowlist"),
+					resource.TestCheckResourceAttr("openclaw_dm_pairing_policy.telegram", "code_ttl", "5m"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(raw), "slack") || strings.Contains(string(raw), "maxPending") {
+							return fmt.Errorf("removed settings left in config: %s", raw)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "openclaw_dm_pairing_policy.telegram",
+				ImportState:       true,
+				ImportStateId:     "channel:telegram",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "openclaw_dm_pairing_policy.global",
+				ImportState:       true,
+				ImportStateId:     "global",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_DMPairingPolicyResource_Invalid(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_dm_pairing_policy" "test" {
+  code_ttl    = "0s"
+  max_pending = 0
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid duration(.|\n)*Invalid limit`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_dm_pairing_policy" "test" {
+  channel  = "signal"
+  code_ttl = "5m"
+}
+`,
+				ExpectError: regexp.MustCompile(`Channel not configured`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_UsageExportResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_usage_export" "test" {
+  destination = "s3"
+  s3_bucket   = "acme-usage"
+  s3_prefix   = "openclaw/"
+  s3_region   = "eu-west-1"
+  schedule    = "hourly"
+  format      = "csv"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_usage_export.test", "id", "export"),
+					resource.TestCheckResourceAttr("openclaw_usage_export.test", "s3_bucket", "acme-usage"),
+					resource.TestCheckResourceAttr("openclaw_usage_export.test", "schedule", "hourly"),
+				),
+			},
+			{
+				// Switching destination must drop the s3 settings.
+				Config: providerBlock + `
+resource "openclaw_usage_export" "test" {
+  destination = "webhook"
+  webhook_url = "https://billing.example.com/openclaw"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_usage_export.test", "destination", "webhook"),
+					resource.TestCheckNoResourceAttr("openclaw_usage_export.test", "s3_bucket"),
+					func(*terraform.State) error {
+						raw, err := os.ReadFile(cfgPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(raw), "acme-usage") || strings.Contains(string(raw), "hourly") {
+							return fmt.Errorf("removed settings left in config: %s", raw)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "openclaw_usage_export.test",
+				ImportState:       true,
+				ImportStateId:     "export",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_UsageExportResource_Invalid(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_usage_export" "test" {
+  destination = "ftp"
+  schedule    = "every-minute"
+  format      = "xml"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid destination(.|\n)*Invalid schedule(.|\n)*Invalid format`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_usage_export" "test" {
+  destination = "file"
+  s3_bucket   = "acme-usage"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing destination setting(.|\n)*Unused destination setting`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_usage_export" "test" {
+  destination = "webhook"
+  webhook_url = "ftp://billing.example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid URL`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AgentDefaultsResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_agent_defaults" "test" {
+  workspace        = "~/.openclaw/workspace-test"
+  model_primary    = "anthropic/claude-opus-4-6"
+  thinking_default = "low"
+  timeout_seconds  = 300
+  max_concurrent   = 2
+
+  heartbeat_every  = "15m"
+  heartbeat_target = "none"
+
+  sandbox_mode  = "non-main"
+  sandbox_scope = "agent"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "workspace", "~/.openclaw/workspace-test"),
+					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "model_primary", "anthropic/claude-opus-4-6"),
+					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "thinking_default", "low"),
+					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "timeout_seconds", "300"),
+					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "max_concurrent", "2"),
+					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "heartbeat_every", "15m"),
+					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "sandbox_mode", "non-main"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelWhatsApp(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_whatsapp" "test" {
+  dm_policy          = "allowlist"
+  allow_from         = ["+15555550123", "+447700900123"]
+  text_chunk_limit   = 3000
+  send_read_receipts = false
+  group_policy       = "open"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "dm_policy", "allowlist"),
+					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "allow_from.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "allow_from.0", "+15555550123"),
+					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "text_chunk_limit", "3000"),
+					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "send_read_receipts", "false"),
+					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "group_policy", "open"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelTelegram(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_telegram" "test" {
+  enabled       = true
+  bot_token     = "123456:ABCDEF"
+  dm_policy     = "open"
+  allow_from    = ["tg:999"]
+  stream_mode   = "block"
+  reply_to_mode = "all"
+  history_limit = 25
+  media_max_mb  = 10
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_telegram.test", "dm_policy", "open"),
+					resource.TestCheckResourceAttr("openclaw_channel_telegram.test", "stream_mode", "block"),
+					resource.TestCheckResourceAttr("openclaw_channel_telegram.test", "reply_to_mode", "all"),
+					resource.TestCheckResourceAttr("openclaw_channel_telegram.test", "history_limit", "25"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelDiscord(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_discord" "test" {
+  enabled           = true
+  token             = "test-discord-token"
+  dm_policy         = "allowlist"
+  allow_from        = ["user1", "user2"]
+  history_limit     = 30
+  reply_to_mode     = "first"
+  actions_reactions = true
+  actions_messages  = true
+  actions_search    = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "dm_policy", "allowlist"),
+					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "allow_from.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "history_limit", "30"),
+					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "reply_to_mode", "first"),
+					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "actions_reactions", "true"),
+					resource.TestCheckResourceAttr("openclaw_channel_discord.test", "actions_search", "false"),
+				),
+			},
+			{
+				// Import reads allow_from in full, with no state to go by.
+				ResourceName:            "openclaw_channel_discord.test",
+				ImportState:             true,
+				ImportStateId:           "channel_discord",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SessionResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_session" "test" {
+  dm_scope           = "per-channel-peer"
+  reset_mode         = "daily"
+  reset_at_hour      = 4
+  reset_idle_minutes = 60
+  reset_triggers     = ["/new", "/reset"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_session.test", "dm_scope", "per-channel-peer"),
+					resource.TestCheckResourceAttr("openclaw_session.test", "reset_mode", "daily"),
+					resource.TestCheckResourceAttr("openclaw_session.test", "reset_at_hour", "4"),
+					resource.TestCheckResourceAttr("openclaw_session.test", "reset_idle_minutes", "60"),
+					resource.TestCheckResourceAttr("openclaw_session.test", "reset_triggers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_MessagesResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_messages" "test" {
+  response_prefix     = "[Bot]"
+  ack_reaction        = "👀"
+  ack_reaction_scope  = "all"
+  queue_mode          = "collect"
+  queue_debounce_ms   = 500
+  queue_cap           = 10
+  inbound_debounce_ms = 3000
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_messages.test", "response_prefix", "[Bot]"),
+					resource.TestCheckResourceAttr("openclaw_messages.test", "ack_reaction", "👀"),
+					resource.TestCheckResourceAttr("openclaw_messages.test", "queue_mode", "collect"),
+					resource.TestCheckResourceAttr("openclaw_messages.test", "queue_cap", "10"),
+					resource.TestCheckResourceAttr("openclaw_messages.test", "inbound_debounce_ms", "3000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_CronResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_cron" "test" {
+  enabled             = true
+  max_concurrent_runs = 3
+  session_retention   = "48h"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_cron.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("openclaw_cron.test", "max_concurrent_runs", "3"),
+					resource.TestCheckResourceAttr("openclaw_cron.test", "session_retention", "48h"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_HookResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_hook" "test" {
+  enabled             = true
+  token               = "test-hook-secret"
+  path                = "/webhooks"
+  default_session_key = "hook:test"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_hook.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("openclaw_hook.test", "path", "/webhooks"),
+					resource.TestCheckResourceAttr("openclaw_hook.test", "default_session_key", "hook:test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ToolsResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_tools" "test" {
+  profile          = "coding"
+  deny             = ["canvas", "browser"]
+  elevated_enabled = true
+  browser_enabled  = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_tools.test", "profile", "coding"),
+					resource.TestCheckResourceAttr("openclaw_tools.test", "deny.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_tools.test", "elevated_enabled", "true"),
+					resource.TestCheckResourceAttr("openclaw_tools.test", "browser_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ConfigDataSource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	// Seed the config with some data
+	os.WriteFile(cfgPath, []byte(`{"gateway":{"port":18789}}`), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+data "openclaw_config" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openclaw_config.test", "raw"),
+					resource.TestCheckResourceAttrSet("data.openclaw_config.test", "hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_MultiResourceComposition(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_gateway" "test" {
+  port        = 19000
+  bind        = "loopback"
+  reload_mode = "hybrid"
+}
+
+resource "openclaw_agent_defaults" "test" {
+  workspace       = "~/.openclaw/workspace-multi"
+  model_primary   = "anthropic/claude-sonnet-4-5"
+  timeout_seconds = 300
+}
+
+resource "openclaw_channel_whatsapp" "test" {
+  dm_policy  = "pairing"
+  allow_from = ["+15555550123"]
+}
+
+resource "openclaw_session" "test" {
+  dm_scope   = "per-peer"
+  reset_mode = "idle"
+  reset_idle_minutes = 30
+}
+
+resource "openclaw_cron" "test" {
+  enabled = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_gateway.test", "port", "19000"),
+					resource.TestCheckResourceAttr("openclaw_agent_defaults.test", "model_primary", "anthropic/claude-sonnet-4-5"),
+					resource.TestCheckResourceAttr("openclaw_channel_whatsapp.test", "dm_policy", "pairing"),
+					resource.TestCheckResourceAttr("openclaw_session.test", "dm_scope", "per-peer"),
+					resource.TestCheckResourceAttr("openclaw_cron.test", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_GatewayDataSource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	// Pre-populate config with gateway section
+	os.WriteFile(cfgPath,
+		[]byte(`{"gateway":{"port":19999,"bind":"loopback","mode":"local","auth":{"mode":"token"},"reload":{"mode":"hybrid"}}}`), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+data "openclaw_gateway" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "port", "19999"),
+					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "bind", "loopback"),
+					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "auth_mode", "token"),
+					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "reload_mode", "hybrid"),
+					resource.TestCheckResourceAttr("data.openclaw_gateway.test", "mode", "local"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AgentDefaultsDataSource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	os.WriteFile(cfgPath,
+		[]byte(`{"agents":{"defaults":{"workspace":"~/ws","timeout":300,"heartbeat":{"every":"15m","target":"last"}}}}`), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+data "openclaw_agent_defaults" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openclaw_agent_defaults.test", "workspace", "~/ws"),
+					resource.TestCheckResourceAttr("data.openclaw_agent_defaults.test", "timeout_seconds", "300"),
+					resource.TestCheckResourceAttr("data.openclaw_agent_defaults.test", "heartbeat_every", "15m"),
+					resource.TestCheckResourceAttr("data.openclaw_agent_defaults.test", "heartbeat_target", "last"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AgentIdentityResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	config := func(model string) string {
+		return providerBlock + `
+resource "openclaw_agent" "test" {
+  agent_id = "research"
+  model    = "` + model + `"
+}
+
+resource "openclaw_agent_identity" "test" {
+  agent_id = openclaw_agent.test.agent_id
+  name     = "Researcher"
+  emoji    = "🔬"
+  avatar   = "https://example.com/research.png"
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("anthropic/claude-sonnet-4-20250514"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_agent_identity.test", "id", "research"),
+					resource.TestCheckResourceAttr("openclaw_agent_identity.test", "name", "Researcher"),
+					resource.TestCheckResourceAttr("openclaw_agent_identity.test", "avatar", "https://example.com/research.png"),
+					resource.TestCheckNoResourceAttr("openclaw_agent.test", "identity_name"),
+				),
+			},
+			{
+				// Rewriting the agent entry must keep the identity.
+				Config: config("openai/gpt-4.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_agent.test", "model", "openai/gpt-4.1"),
+					resource.TestCheckResourceAttr("openclaw_agent_identity.test", "emoji", "🔬"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_agent_identity.test",
+				ImportState:       true,
+				ImportStateId:     "research",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AgentsDataSource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	os.WriteFile(cfgPath,
+		[]byte(`{"agents":{"list":[{"id":"main","default":true,"name":"Main Agent","model":"anthropic/claude-sonnet-4-20250514"},{"id":"research","name":"Research","model":"openai/gpt-4.1"}]}}`), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+data "openclaw_agents" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openclaw_agents.test", "default_agent_id", "main"),
+					resource.TestCheckResourceAttr("data.openclaw_agents.test", "agent_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.openclaw_agents.test", "agents.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AgentDataSource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	os.WriteFile(cfgPath, []byte(`{"agents":{"list":[
+  {"id":"main","default":true,"model":"anthropic/claude-sonnet-4-20250514"},
+  {"id":"research","name":"Research","workspace":"~/research","identity":{"name":"Ada","emoji":"🔬"},
+   "groupChat":{"mentionPatterns":["@ada"]},"sandbox":{"mode":"all"},"tools":{"profile":"coding","deny":["browser"]},
+   "env":{"vars":{"API_KEY":"secret-value"}}}
+]}}`), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+data "openclaw_agent" "test" {
+  agent_id = "research"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openclaw_agent.test", "id", "research"),
+					resource.TestCheckResourceAttr("data.openclaw_agent.test", "is_default", "false"),
+					resource.TestCheckResourceAttr("data.openclaw_agent.test", "workspace", "~/research"),
+					resource.TestCheckNoResourceAttr("data.openclaw_agent.test", "model"),
+					resource.TestCheckResourceAttr("data.openclaw_agent.test", "identity_name", "Ada"),
+					resource.TestCheckResourceAttr("data.openclaw_agent.test", "mention_patterns.0", "@ada"),
+					resource.TestCheckResourceAttr("data.openclaw_agent.test", "sandbox_mode", "all"),
+					resource.TestCheckResourceAttr("data.openclaw_agent.test", "tools_profile", "coding"),
+					resource.TestCheckResourceAttr("data.openclaw_agent.test", "tools_deny.#", "1"),
+					resource.TestCheckNoResourceAttr("data.openclaw_agent.test", "tools_allow"),
+					resource.TestCheckResourceAttrWith("data.openclaw_agent.test", "config_json", func(v string) error {
+						if !strings.Contains(v, `"groupChat"`) || strings.Contains(v, "secret-value") {
+							return fmt.Errorf("unexpected config_json: %s", v)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: providerBlock + `
+data "openclaw_agent" "test" {
+  agent_id = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`Agent not found`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelDataSource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	os.WriteFile(cfgPath, []byte(`{"channels":{
+  "telegram":{"enabled":false,"botToken":"123456:ABCDEF","dmPolicy":"allowlist","allowFrom":["42",1234567890]},
+  "googlechat":{"dm":{"policy":"pairing","allowFrom":["users/1"]}}
+}}`), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+data "openclaw_channel" "telegram" {
+  name = "telegram"
+}
+
+data "openclaw_channel" "googlechat" {
+  name = "googlechat"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openclaw_channel.telegram", "id", "telegram"),
+					resource.TestCheckResourceAttr("data.openclaw_channel.telegram", "enabled", "false"),
+					resource.TestCheckResourceAttr("data.openclaw_channel.telegram", "dm_policy", "allowlist"),
+					resource.TestCheckResourceAttr("data.openclaw_channel.telegram", "allow_from.0", "42"),
+					resource.TestCheckResourceAttr("data.openclaw_channel.telegram", "allow_from.1", "1234567890"),
+					resource.TestCheckResourceAttrWith("data.openclaw_channel.telegram", "config_json", func(v string) error {
+						if !strings.Contains(v, `"botToken":"123456:ABCDEF"`) {
+							return fmt.Errorf("unexpected config_json: %s", v)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("data.openclaw_channel.googlechat", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.openclaw_channel.googlechat", "dm_policy", "pairing"),
+					resource.TestCheckResourceAttr("data.openclaw_channel.googlechat", "allow_from.0", "users/1"),
+				),
+			},
+			{
+				Config: providerBlock + `
+data "openclaw_channel" "test" {
+  name = "signal"
+}
+`,
+				ExpectError: regexp.MustCompile(`Channel not found`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelsDataSource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	os.WriteFile(cfgPath,
+		[]byte(`{"channels":{"whatsapp":{"dmPolicy":"pairing"},"telegram":{"enabled":true,"dmPolicy":"allowlist"},"discord":{"enabled":false}}}`), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+data "openclaw_channels" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openclaw_channels.test", "names.#", "3"),
+					resource.TestCheckResourceAttr("data.openclaw_channels.test", "channels.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_BindingsDataSource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	os.WriteFile(cfgPath, []byte(`{
+  "channels": {"telegram": {"enabled": true}, "discord": {"enabled": true}, "whatsapp": {"dmPolicy": "pairing"}},
+  "bindings": [
+    {"agentId": "ops", "match": {"channel": "discord", "peer": {"kind": "group", "id": "123"}}},
+    {"agentId": "support", "match": {"channel": "telegram"}}
+  ]
+}`), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+data "openclaw_bindings" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openclaw_bindings.test", "bindings.#", "2"),
+					resource.TestCheckResourceAttr("data.openclaw_bindings.test", "bindings.0.agent_id", "ops"),
+					resource.TestCheckResourceAttr("data.openclaw_bindings.test", "bindings.0.peer_kind", "group"),
+					resource.TestCheckResourceAttr("data.openclaw_bindings.test", "bindings.1.channel", "telegram"),
+					resource.TestCheckNoResourceAttr("data.openclaw_bindings.test", "bindings.1.peer_id"),
+					// discord is only bound for one group, so its other messages fall through.
+					resource.TestCheckResourceAttr("data.openclaw_bindings.test", "unbound_channels.#", "2"),
+					resource.TestCheckResourceAttr("data.openclaw_bindings.test", "unbound_channels.0", "discord"),
+					resource.TestCheckResourceAttr("data.openclaw_bindings.test", "unbound_channels.1", "whatsapp"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_PluginsDataSource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	// Entries added outside Terraform are listed alongside managed ones.
+	os.WriteFile(cfgPath,
+		[]byte(`{"plugins":{"entries":{"web_search":{"enabled":false},"voice-call":{"config":{"provider":"twilio"}}}}}`), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_plugin" "matrix" {
+  plugin_id = "matrix"
+  enabled   = true
+}
+
+data "openclaw_plugins" "test" {
+  depends_on = [openclaw_plugin.matrix]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openclaw_plugins.test", "plugin_ids.#", "3"),
+					resource.TestCheckResourceAttr("data.openclaw_plugins.test", "plugins.0.plugin_id", "matrix"),
+					resource.TestCheckResourceAttr("data.openclaw_plugins.test", "plugins.1.plugin_id", "voice-call"),
+					resource.TestCheckResourceAttr("data.openclaw_plugins.test", "plugins.1.enabled", "true"),
+					resource.TestCheckResourceAttr("data.openclaw_plugins.test", "plugins.1.configured", "true"),
+					resource.TestCheckNoResourceAttr("data.openclaw_plugins.test", "plugins.1.version"),
+					resource.TestCheckResourceAttr("data.openclaw_plugins.test", "plugins.2.plugin_id", "web_search"),
+					resource.TestCheckResourceAttr("data.openclaw_plugins.test", "plugins.2.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelVoice(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_voice" "test" {
+  enabled              = true
+  telephony_provider   = "twilio"
+  phone_number         = "+15555550100"
+  tts_voice            = "alloy"
+  max_duration_seconds = 600
+  dm_policy            = "allowlist"
+  allow_from           = ["+15555550123"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_voice.test", "telephony_provider", "twilio"),
+					resource.TestCheckResourceAttr("openclaw_channel_voice.test", "phone_number", "+15555550100"),
+					resource.TestCheckResourceAttr("openclaw_channel_voice.test", "tts_voice", "alloy"),
+					resource.TestCheckResourceAttr("openclaw_channel_voice.test", "max_duration_seconds", "600"),
+					resource.TestCheckResourceAttr("openclaw_channel_voice.test", "allow_from.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelMatrix(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_matrix" "test" {
+  enabled        = true
+  homeserver_url = "https://matrix.example.org"
+  access_token   = "syt_test_token"
+  user_id        = "@openclaw:example.org"
+  dm_policy      = "allowlist"
+  allow_from     = ["@alice:example.org"]
+  rooms          = ["#ops:example.org", "!abc123:example.org"]
+  encryption     = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_matrix.test", "homeserver_url", "https://matrix.example.org"),
+					resource.TestCheckResourceAttr("openclaw_channel_matrix.test", "user_id", "@openclaw:example.org"),
+					resource.TestCheckResourceAttr("openclaw_channel_matrix.test", "rooms.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_channel_matrix.test", "encryption", "true"),
+					resource.TestCheckResourceAttr("openclaw_channel_matrix.test", "history_limit", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelEmail(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_email" "test" {
+  enabled        = true
+  address        = "agent@example.com"
+  imap_host      = "imap.example.com"
+  imap_password  = "imap-secret"
+  smtp_host      = "smtp.example.com"
+  smtp_port      = 465
+  smtp_password  = "smtp-secret"
+  allow_from     = ["alice@example.com", "@example.org"]
+  subject_prefix = "[OpenClaw]"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_email.test", "address", "agent@example.com"),
+					resource.TestCheckResourceAttr("openclaw_channel_email.test", "imap_port", "993"),
+					resource.TestCheckResourceAttr("openclaw_channel_email.test", "smtp_port", "465"),
+					resource.TestCheckResourceAttr("openclaw_channel_email.test", "poll_interval", "1m"),
+					resource.TestCheckResourceAttr("openclaw_channel_email.test", "allow_from.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_channel_email.test", "attachment_max_mb", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelMSTeams(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_msteams" "test" {
+  enabled         = true
+  app_id          = "00000000-0000-0000-0000-000000000000"
+  app_password    = "test-secret"
+  allowed_tenants = ["11111111-1111-1111-1111-111111111111"]
+  dm_policy       = "allowlist"
+  allow_from      = ["alice@example.com"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_msteams.test", "app_id", "00000000-0000-0000-0000-000000000000"),
+					resource.TestCheckResourceAttr("openclaw_channel_msteams.test", "allowed_tenants.#", "1"),
+					resource.TestCheckResourceAttr("openclaw_channel_msteams.test", "dm_policy", "allowlist"),
+					resource.TestCheckResourceAttr("openclaw_channel_msteams.test", "history_limit", "50"),
+					resource.TestCheckResourceAttr("openclaw_channel_msteams.test", "reply_style", "thread"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelMattermost(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_mattermost" "test" {
+  enabled    = true
+  server_url = "https://chat.example.com"
+  bot_token  = "mm-test-token"
+  teams      = ["engineering"]
+  channels   = ["ops", "incidents"]
+  allow_from = ["alice"]
+  chunk_mode = "newline"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_mattermost.test", "server_url", "https://chat.example.com"),
+					resource.TestCheckResourceAttr("openclaw_channel_mattermost.test", "channels.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_channel_mattermost.test", "dm_policy", "pairing"),
+					resource.TestCheckResourceAttr("openclaw_channel_mattermost.test", "chunk_mode", "newline"),
+					resource.TestCheckResourceAttr("openclaw_channel_mattermost.test", "text_chunk_limit", "4000"),
+					resource.TestCheckResourceAttr("openclaw_channel_mattermost.test", "reply_to_mode", "off"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelIRC(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_irc" "test" {
+  enabled           = true
+  server            = "irc.example.org"
+  nick              = "openclaw"
+  nickserv_password = "test-password"
+  channels          = ["#ops", "#alerts"]
+  allow_from        = ["alice"]
+  line_delay_ms     = 1500
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_irc.test", "server", "irc.example.org"),
+					resource.TestCheckResourceAttr("openclaw_channel_irc.test", "port", "6697"),
+					resource.TestCheckResourceAttr("openclaw_channel_irc.test", "tls", "true"),
+					resource.TestCheckResourceAttr("openclaw_channel_irc.test", "channels.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_channel_irc.test", "line_delay_ms", "1500"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelSMSTwilio(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_sms_twilio" "test" {
+  enabled       = true
+  account_sid   = "AC00000000000000000000000000000000"
+  auth_token    = "test-token"
+  from_number   = "+15550100000"
+  allow_to      = ["+15550100001"]
+  segment_limit = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_sms_twilio.test", "from_number", "+15550100000"),
+					resource.TestCheckResourceAttr("openclaw_channel_sms_twilio.test", "allow_to.#", "1"),
+					resource.TestCheckResourceAttr("openclaw_channel_sms_twilio.test", "segment_limit", "5"),
+					resource.TestCheckResourceAttr("openclaw_channel_sms_twilio.test", "webhook_path", "/sms/twilio"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelLINE(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_line" "test" {
+  enabled              = true
+  channel_access_token = "test-token"
+  channel_secret       = "test-secret"
+  allow_from           = ["U0000000000000000000000000000000"]
+  rich_messages        = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_line.test", "dm_policy", "pairing"),
+					resource.TestCheckResourceAttr("openclaw_channel_line.test", "allow_from.#", "1"),
+					resource.TestCheckResourceAttr("openclaw_channel_line.test", "rich_messages", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelWebChat(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_webchat" "test" {
+  enabled          = true
+  allowed_origins  = ["https://www.example.com", "https://docs.example.com"]
+  theme            = "dark"
+  anonymous_access = "pairing"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_webchat.test", "path", "/chat"),
+					resource.TestCheckResourceAttr("openclaw_channel_webchat.test", "allowed_origins.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_channel_webchat.test", "session_cookie_secure", "true"),
+					resource.TestCheckResourceAttr("openclaw_channel_webchat.test", "session_cookie_same_site", "lax"),
+					resource.TestCheckResourceAttr("openclaw_channel_webchat.test", "theme", "dark"),
+					resource.TestCheckResourceAttr("openclaw_channel_webchat.test", "anonymous_access", "pairing"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelWebChat_InsecureCrossSiteCookie(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_webchat" "test" {
+  session_cookie_same_site = "none"
+  session_cookie_secure    = false
+}
+`,
+				ExpectError: regexp.MustCompile(`Insecure cross-site session cookie`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ChannelXMPP(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_channel_xmpp" "test" {
+  enabled    = true
+  jid        = "openclaw@example.org"
+  password   = "test-password"
+  server     = "xmpp.example.org:5222"
+  muc_rooms  = ["ops@conference.example.org"]
+  allow_from = ["alice@example.org"]
+  direct_tls = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_channel_xmpp.test", "jid", "openclaw@example.org"),
+					resource.TestCheckResourceAttr("openclaw_channel_xmpp.test", "muc_rooms.#", "1"),
+					resource.TestCheckResourceAttr("openclaw_channel_xmpp.test", "require_tls", "true"),
+					resource.TestCheckResourceAttr("openclaw_channel_xmpp.test", "direct_tls", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_GroupResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_group" "family" {
+  channel         = "whatsapp"
+  group_id        = "120363000000000000@g.us"
+  require_mention = true
+  allow_from      = ["+15555550123"]
+}
+
+resource "openclaw_group" "ops" {
+  channel        = "telegram"
+  group_id       = "-1001234567890"
+  tools_deny     = ["bash", "write"]
+  response_style = "concise"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_group.family", "id", "whatsapp/120363000000000000@g.us"),
+					resource.TestCheckResourceAttr("openclaw_group.family", "require_mention", "true"),
+					resource.TestCheckResourceAttr("openclaw_group.ops", "tools_deny.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_group.ops", "response_style", "concise"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_group.ops",
+				ImportState:       true,
+				ImportStateId:     "telegram/-1001234567890",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_AllowlistEntryResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	entries := func(ids ...string) string {
+		out := providerBlock + `
+resource "openclaw_channel_whatsapp" "test" {
+  dm_policy = "allowlist"
+}
+`
+		for i, id := range ids {
+			out += fmt.Sprintf(`
+resource "openclaw_allowlist_entry" "e%d" {
+  channel    = "whatsapp"
+  identifier = %q
+  depends_on = [openclaw_channel_whatsapp.test]
+}
+`, i, id)
+		}
+		return out
+	}
+	allowFrom := func(want ...string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			raw, err := os.ReadFile(cfgPath)
+			if err != nil {
+				return err
+			}
+			var cfg struct {
+				Channels struct {
+					WhatsApp struct {
+						AllowFrom []string `json:"allowFrom"`
+					} `json:"whatsapp"`
+				} `json:"channels"`
+			}
+			if err := json.Unmarshal(raw, &cfg); err != nil {
+				return err
+			}
+			got := cfg.Channels.WhatsApp.AllowFrom
+			slices.Sort(got)
+			if !slices.Equal(got, want) {
+				return fmt.Errorf("allowFrom = %v, want %v", got, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: entries("+15555550123", "+15555550456", "+15555550789"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_allowlist_entry.e0", "id", "whatsapp/+15555550123"),
+					resource.TestCheckNoResourceAttr("openclaw_channel_whatsapp.test", "allow_from"),
+					allowFrom("+15555550123", "+15555550456", "+15555550789"),
+				),
+			},
+			{
+				Config: entries("+15555550123", "+15555550456"),
+				Check:  allowFrom("+15555550123", "+15555550456"),
+			},
+			{
+				ResourceName:      "openclaw_allowlist_entry.e1",
+				ImportState:       true,
+				ImportStateId:     "whatsapp/+15555550456",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_PairedDeviceResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_paired_device" "test" {
+  role = "operator"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing device`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_paired_device" "test" {
+  public_key = "pk-laptop"
+}
+`,
+				ExpectError: regexp.MustCompile(`not available in file mode`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_PluginInstallResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_plugin_install" "test" {
+  source  = "https://github.com/example/openclaw-plugin.git#v1.2.0"
+  version = "^1.2.0"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unused version`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_plugin_install" "test" {
+  source   = "@openclaw/voice-call"
+  checksum = "md5:abc"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid checksum`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_plugin_install" "test" {
+  source  = "@openclaw/voice-call"
+  version = "^1.2.0"
+}
+`,
+				ExpectError: regexp.MustCompile(`not available in file mode`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_MCPServerResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_mcp_server" "github" {
+  name          = "github"
+  command       = "npx"
+  args          = ["-y", "@modelcontextprotocol/server-github"]
+  env           = { GITHUB_PERSONAL_ACCESS_TOKEN = "test-token" }
+  allowed_tools = ["search_repositories"]
+}
+
+resource "openclaw_mcp_server" "docs" {
+  name      = "docs"
+  enabled   = false
+  transport = "sse"
+  url       = "https://mcp.example.com/sse"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_mcp_server.github", "transport", "stdio"),
+					resource.TestCheckResourceAttr("openclaw_mcp_server.github", "args.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_mcp_server.github", "env.GITHUB_PERSONAL_ACCESS_TOKEN", "test-token"),
+					resource.TestCheckResourceAttr("openclaw_mcp_server.docs", "url", "https://mcp.example.com/sse"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_mcp_server.github",
+				ImportState:       true,
+				ImportStateId:     "github",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_MCPServerResource_MissingURL(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_mcp_server" "bad" {
+  name      = "bad"
+  transport = "sse"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing url`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SystemPromptResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+	promptFile := filepath.Join(filepath.Dir(cfgPath), "support.md")
+	os.WriteFile(promptFile, []byte("You are a support agent."), 0o644)
+
+	config := providerBlock + fmt.Sprintf(`
+resource "openclaw_system_prompt" "support" {
+  key          = "support"
+  content_file = %q
+  channels     = ["whatsapp"]
+}
+
+resource "openclaw_system_prompt" "research" {
+  key     = "research"
+  content = "Cite your sources."
+  agents  = ["research"]
+}
+`, promptFile)
+	storedContent := func(want string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			raw, err := os.ReadFile(cfgPath)
+			if err != nil {
+				return err
+			}
+			var cfg struct {
+				Prompts map[string]struct {
+					Content string `json:"content"`
+				} `json:"prompts"`
+			}
+			if err := json.Unmarshal(raw, &cfg); err != nil {
+				return err
+			}
+			if got := cfg.Prompts["support"].Content; got != want {
+				return fmt.Errorf("prompts.support.content = %q, want %q", got, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					storedContent("You are a support agent."),
+					resource.TestCheckResourceAttr("openclaw_system_prompt.support", "content_sha256",
+						"57e8f485cbb5aab3a684a972d8cc3981c066cbf52ef8706d75f2bc0a831c78f7"),
+					resource.TestCheckResourceAttr("openclaw_system_prompt.research", "agents.0", "research"),
+				),
+			},
+			{
+				PreConfig: func() {
+					os.WriteFile(promptFile, []byte("You are a billing support agent."), 0o644)
+				},
+				Config: config,
+				Check:  storedContent("You are a billing support agent."),
+			},
+			{
+				ResourceName:      "openclaw_system_prompt.research",
+				ImportState:       true,
+				ImportStateId:     "research",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SystemPromptResource_MissingContent(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_system_prompt" "empty" {
+  key = "empty"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing prompt content`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_PersonaResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_persona" "concierge" {
+  name             = "concierge"
+  description      = "Warm and brief"
+  prompt           = "You are a hotel concierge."
+  thinking_default = "low"
+  model            = "fast"
+}
+
+resource "openclaw_agent" "front_desk" {
+  agent_id = "front-desk"
+  persona  = openclaw_persona.concierge.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_persona.concierge", "id", "concierge"),
+					resource.TestCheckResourceAttr("openclaw_persona.concierge", "thinking_default", "low"),
+					resource.TestCheckResourceAttr("openclaw_agent.front_desk", "persona", "concierge"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_persona.concierge",
+				ImportState:       true,
+				ImportStateId:     "concierge",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_BudgetResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_budget" "test" {
+  monthly_usd        = 500
+  agent_caps         = { research = 150.5 }
+  channel_caps       = { whatsapp = 200 }
+  action_on_exceeded = "fallback-model"
+  fallback_model     = "anthropic/claude-haiku-4-5"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_budget.test", "id", "budget"),
+					resource.TestCheckResourceAttr("openclaw_budget.test", "monthly_usd", "500"),
+					resource.TestCheckResourceAttr("openclaw_budget.test", "agent_caps.research", "150.5"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_budget.test",
+				ImportState:       true,
+				ImportStateId:     "budget",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_BudgetResource_MissingFallbackModel(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_budget" "test" {
+  monthly_usd        = 500
+  action_on_exceeded = "fallback-model"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing fallback model`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_UsageRequiresGateway(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      providerBlock + `data "openclaw_usage" "test" {}`,
+				ExpectError: regexp.MustCompile(`not available in file mode`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SessionsRequiresGateway(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      providerBlock + `data "openclaw_sessions" "test" {}`,
+				ExpectError: regexp.MustCompile(`not available in file mode`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_CronJobsRequiresGateway(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      providerBlock + `data "openclaw_cron_jobs" "test" {}`,
+				ExpectError: regexp.MustCompile(`not available in file mode`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_DevicesRequiresGateway(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      providerBlock + `data "openclaw_devices" "test" {}`,
+				ExpectError: regexp.MustCompile(`not available in file mode`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_RateLimitResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_rate_limit" "test" {
+  peer_messages_per_minute = 10
+  peer_burst               = 5
+  channel_concurrent_runs  = 4
+  cooldown_message         = "Try again in {retryAfter}s."
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_rate_limit.test", "id", "rate_limit"),
+					resource.TestCheckResourceAttr("openclaw_rate_limit.test", "peer_burst", "5"),
+					resource.TestCheckResourceAttr("openclaw_rate_limit.test", "channel_concurrent_runs", "4"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_rate_limit.test",
+				ImportState:       true,
+				ImportStateId:     "rate_limit",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_RateLimitResource_InvalidLimit(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_rate_limit" "test" {
+  peer_messages_per_minute = 0
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid limit`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_UpdatePolicyResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_update_policy" "test" {
+  channel           = "beta"
+  check_interval    = "6h"
+  auto_apply        = true
+  auto_apply_window = "23:00-04:00"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_update_policy.test", "id", "update_policy"),
+					resource.TestCheckResourceAttr("openclaw_update_policy.test", "channel", "beta"),
+					resource.TestCheckResourceAttr("openclaw_update_policy.test", "auto_apply_window", "23:00-04:00"),
+				),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_update_policy" "test" {
+  pinned_version = "2026.10.2"
+  auto_apply     = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_update_policy.test", "pinned_version", "2026.10.2"),
+					resource.TestCheckNoResourceAttr("openclaw_update_policy.test", "channel"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_update_policy.test",
+				ImportState:       true,
+				ImportStateId:     "update_policy",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_UpdatePolicyResource_InvalidWindow(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_update_policy" "test" {
+  auto_apply        = true
+  auto_apply_window = "2am-5am"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid window`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SecretResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+
+	config := func(value string) string {
+		return providerBlock + fmt.Sprintf(`
+resource "openclaw_secret" "openai" {
+  name  = "OPENAI_API_KEY"
+  value = %q
+}
+
+data "openclaw_secrets" "all" {
+  depends_on = [openclaw_secret.openai]
+}
+`, value)
+	}
+	storedValue := func(want string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			raw, err := os.ReadFile(cfgPath)
+			if err != nil {
+				return err
+			}
+			var cfg struct {
+				Env struct {
+					Vars map[string]string `json:"vars"`
+				} `json:"env"`
+			}
+			if err := json.Unmarshal(raw, &cfg); err != nil {
+				return err
+			}
+			if got := cfg.Env.Vars["OPENAI_API_KEY"]; got != want {
+				return fmt.Errorf("env.vars.OPENAI_API_KEY = %q, want %q", got, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("sk-one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					storedValue("sk-one"),
+					resource.TestCheckResourceAttr("data.openclaw_secrets.all", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.openclaw_secrets.all", "names.0", "OPENAI_API_KEY"),
+				),
+			},
+			{
+				Config: config("sk-two"),
+				Check:  storedValue("sk-two"),
+			},
+			{
+				ResourceName:            "openclaw_secret.openai",
+				ImportState:             true,
+				ImportStateId:           "OPENAI_API_KEY",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SecretResource_MissingValue(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_secret" "test" {
+  name = "OPENAI_API_KEY"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing secret value`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_WorkspaceFileResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+	dir := filepath.Dir(cfgPath)
+	os.WriteFile(cfgPath, []byte(`{"agents":{"defaults":{"workspace":"workspace"}}}`), 0o644)
+	agentsFile := filepath.Join(dir, "workspace", "AGENTS.md")
+
+	config := providerBlock + `
+resource "openclaw_workspace_file" "agents" {
+  path    = "AGENTS.md"
+  content = "# Agents\n\nBe concise.\n"
+  mode    = "0600"
+}
+`
+	onDisk := func(want string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			data, err := os.ReadFile(agentsFile)
+			if err != nil {
+				return err
+			}
+			if string(data) != want {
+				return fmt.Errorf("%s = %q, want %q", agentsFile, data, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					onDisk("# Agents\n\nBe concise.\n"),
+					resource.TestCheckResourceAttr("openclaw_workspace_file.agents", "id", "main/AGENTS.md"),
+					resource.TestCheckResourceAttr("openclaw_workspace_file.agents", "agent_id", "main"),
+					resource.TestCheckResourceAttr("openclaw_workspace_file.agents", "mode", "0600"),
+				),
+			},
+			{
+				// An edit made in the workspace is drift, and is reverted.
+				PreConfig: func() {
+					os.WriteFile(agentsFile, []byte("edited by hand"), 0o600)
+				},
+				Config: config,
+				Check:  onDisk("# Agents\n\nBe concise.\n"),
+			},
+			{
+				ResourceName:            "openclaw_workspace_file.agents",
+				ImportState:             true,
+				ImportStateId:           "main/AGENTS.md",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mode"},
+			},
+		},
+	})
+}
+
+func TestAccFileMode_WorkspaceFileResource_InvalidPath(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_workspace_file" "escape" {
+  path    = "../outside.md"
+  content = "x"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid path`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ConfigRawResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+	os.WriteFile(cfgPath, []byte(`{"meta":{"lastTouchedAt":"2026-10-01"},"logging":{"level":"debug"}}`), 0o644)
+
+	config := providerBlock + `
+resource "openclaw_config_raw" "test" {
+  content = jsonencode({
+    gateway = { port = 18789, mode = "local" }
+    logging = { level = "info" }
+    meta    = { lastTouchedAt = "from terraform" }
+  })
+  ignore_paths = ["meta"]
+}
+`
+	readConfig := func() (map[string]any, error) {
+		raw, err := os.ReadFile(cfgPath)
+		if err != nil {
+			return nil, err
+		}
+		var cfg map[string]any
+		return cfg, json.Unmarshal(raw, &cfg)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_config_raw.test", "id", "config_raw"),
+					resource.TestCheckResourceAttrSet("openclaw_config_raw.test", "content_hash"),
+					func(*terraform.State) error {
+						cfg, err := readConfig()
+						if err != nil {
+							return err
+						}
+						// The ignored path keeps the value already in the file.
+						if got := cfg["meta"].(map[string]any)["lastTouchedAt"]; got != "2026-10-01" {
+							return fmt.Errorf("meta.lastTouchedAt = %v, want 2026-10-01", got)
+						}
+						if got := cfg["logging"].(map[string]any)["level"]; got != "info" {
+							return fmt.Errorf("logging.level = %v, want info", got)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// A change under an ignored path is not drift.
+				PreConfig: func() {
+					os.WriteFile(cfgPath, []byte(`{
+  // touched by the gateway
+  "meta": {"lastTouchedAt": "2026-10-17"},
+  "logging": {"level": "info"},
+  "gateway": {"mode": "local", "port": 18789},
+}`), 0o644)
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				// Any other change is, and is reverted.
+				PreConfig: func() {
+					os.WriteFile(cfgPath, []byte(`{"meta":{"lastTouchedAt":"2026-10-17"},"logging":{"level":"trace"},"gateway":{"mode":"local","port":18789}}`), 0o644)
+				},
+				Config: config,
+				Check: func(*terraform.State) error {
+					cfg, err := readConfig()
+					if err != nil {
+						return err
+					}
+					if got := cfg["logging"].(map[string]any)["level"]; got != "info" {
+						return fmt.Errorf("logging.level = %v, want info", got)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:            "openclaw_config_raw.test",
+				ImportState:             true,
+				ImportStateId:           "config_raw",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_hash", "ignore_paths"},
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ConfigRawResource_InvalidContent(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_config_raw" "test" {
+  content = "{ not json"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid config`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ConfigSectionResource(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+	os.WriteFile(cfgPath, []byte(`{
+  // set by hand
+  "experimental": {"voiceV2": {"enabled": false, "legacy": true}, "other": 1}
+}`), 0o644)
+
+	config := func(value string) string {
+		return providerBlock + fmt.Sprintf(`
+resource "openclaw_config_section" "voice" {
+  path       = "experimental.voiceV2"
+  value_json = jsonencode(%s)
+}
+`, value)
+	}
+	experimental := func(want string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			cfg, err := client.NewFileClient(cfgPath)
+			if err != nil {
+				return err
+			}
+			sec, err := cfg.GetConfigSection(context.Background(), "experimental")
+			if err != nil {
+				return err
+			}
+			got, _ := json.Marshal(sec.Value)
+			if string(got) != want {
+				return fmt.Errorf("experimental = %s, want %s", got, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`{ enabled = true, voices = ["alloy"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_config_section.voice", "id", "experimental.voiceV2"),
+					// The value is replaced; its siblings are not.
+					experimental(`{"other":1,"voiceV2":{"enabled":true,"voices":["alloy"]}}`),
+				),
+			},
+			{
+				// A reformatted file is not drift.
+				PreConfig: func() {
+					os.WriteFile(cfgPath, []byte(`{experimental: {other: 1, voiceV2: {voices: ["alloy"], enabled: true}}}`), 0o644)
+				},
+				Config:   config(`{ enabled = true, voices = ["alloy"] }`),
+				PlanOnly: true,
+			},
+			{
+				Config: config(`{ enabled = true, voices = ["alloy", "verse"], rate = 1.5 }`),
+				Check:  experimental(`{"other":1,"voiceV2":{"enabled":true,"rate":1.5,"voices":["alloy","verse"]}}`),
+			},
+			{
+				ResourceName:            "openclaw_config_section.voice",
+				ImportState:             true,
+				ImportStateId:           "experimental.voiceV2",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value_json"},
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ConfigSectionResource_Null(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_config_section" "test" {
+  path       = "experimental.flags"
+  value_json = jsonencode({ a = null })
+}
+`,
+				ExpectError: regexp.MustCompile(`must not contain null`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SecurityResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_security" "test" {
+  elevated_require_approval = true
+  shell_allow               = ["^git (status|diff|log)"]
+  shell_deny                = ["rm\\s+-rf\\s+/", "^sudo "]
+  file_deny_paths           = ["~/.ssh/**", "/etc/shadow"]
+  audit_enabled             = true
+  audit_path                = "~/.openclaw/audit.log"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_security.test", "elevated_require_approval", "true"),
+					resource.TestCheckResourceAttr("openclaw_security.test", "shell_allow.#", "1"),
+					resource.TestCheckResourceAttr("openclaw_security.test", "shell_deny.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_security.test", "file_deny_paths.0", "~/.ssh/**"),
+					resource.TestCheckResourceAttr("openclaw_security.test", "audit_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SecurityResource_InvalidRegex(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_security" "test" {
+  shell_deny = ["rm -rf ("]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid regular expression`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_NamedGateways(t *testing.T) {
+	defaultPath, _ := testConfigDir(t)
+	edgePath, _ := testConfigDir(t)
+	providerBlock := `
+provider "openclaw" {
+  config_path = "` + defaultPath + `"
+
+  gateways {
+    name        = "edge"
+    config_path = "` + edgePath + `"
+  }
+}
+`
+	configContains := func(path, want string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if !strings.Contains(string(data), want) {
+				return fmt.Errorf("%s does not contain %q:\n%s", path, want, data)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_plugin" "home" {
+  plugin_id = "home-plugin"
+  enabled   = true
+}
+
+resource "openclaw_plugin" "edge" {
+  gateway   = "edge"
+  plugin_id = "edge-plugin"
+  enabled   = true
+}
+
+data "openclaw_config" "edge" {
+  gateway    = "edge"
+  depends_on = [openclaw_plugin.edge]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_plugin.edge", "gateway", "edge"),
+					resource.TestCheckNoResourceAttr("openclaw_plugin.home", "gateway"),
+					configContains(defaultPath, `home-plugin`),
+					configContains(edgePath, `edge-plugin`),
+					resource.TestCheckResourceAttrWith("data.openclaw_config.edge", "raw", func(raw string) error {
+						if strings.Contains(raw, "home-plugin") {
+							return fmt.Errorf("edge config contains the default gateway's plugin: %s", raw)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      "openclaw_plugin.edge",
+				ImportState:       true,
+				ImportStateId:     "edge:edge-plugin",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_NamedGateways_Unknown(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_session" "test" {
+  gateway  = "missing"
+  dm_scope = "main"
+}
+`,
+				ExpectError: regexp.MustCompile(`no gateway named "missing"`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_InvalidConnectTimeout(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path     = "` + cfgPath + `"
+  connect_timeout = "soon"
+}
+
+data "openclaw_config" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid connect_timeout`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ReadOnly(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+	seed := `{"gateway":{"port":18789}}`
+	os.WriteFile(cfgPath, []byte(seed), 0o644)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy: func(*terraform.State) error {
+			got, err := os.ReadFile(cfgPath)
+			if err != nil {
+				return err
+			}
+			if string(got) != seed {
+				return fmt.Errorf("config was written in read-only mode: %s", got)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path = "` + cfgPath + `"
+  read_only   = true
+}
+
+data "openclaw_gateway" "test" {}
+
+resource "openclaw_gateway" "test" {
+  port = data.openclaw_gateway.test.port + 1
+}
+`,
+				ExpectError: regexp.MustCompile(`Provider is read-only`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ReadOnlyDestroy(t *testing.T) {
+	cfgPath, providerBlock := testConfigDir(t)
+	readOnlyBlock := `
+provider "openclaw" {
+  config_path = "` + cfgPath + `"
+  read_only   = true
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_config_raw" "test" {
+  content = jsonencode({ gateway = { port = 18789 } })
+}
+`,
+			},
+			{
+				// Even a destroy that would leave the config alone is refused.
+				Config:      readOnlyBlock,
+				ExpectError: regexp.MustCompile(`Provider is read-only`),
+			},
+			{
+				Config: providerBlock,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_DockerConfigPathMustBeAbsolute(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  docker_container = "openclaw"
+  config_path      = "~/.openclaw/openclaw.json"
+}
+
+data "openclaw_config" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`absolute path inside the container`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_InvalidGatewayURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  gateway_url = "http://127.0.0.1:18789"
+}
+
+data "openclaw_config" "test" {}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must use the ws:// or wss:// scheme`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_DiscoveredGatewaysInvalidSource(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path = "` + cfgPath + `"
+}
+
+data "openclaw_discovered_gateways" "test" {
+  sources = ["bonjour"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid discovery source`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_InvalidScopes(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path = "` + cfgPath + `"
+  scopes      = ["operator.read", ""]
+}
+
+data "openclaw_config" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid scopes`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_InvalidWaitForGateway(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path      = "` + cfgPath + `"
+  wait_for_gateway = "-1m"
+}
+
+data "openclaw_config" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid wait_for_gateway`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_BatchWrites(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path  = "` + cfgPath + `"
+  batch_writes = true
+}
+
+resource "openclaw_gateway" "test" {
+  port = 19001
+}
+
+resource "openclaw_session" "test" {
+  dm_scope = "per-peer"
+}
+
+resource "openclaw_cron" "test" {
+  enabled = true
+}
+`,
+				Check: func(*terraform.State) error {
+					data, err := os.ReadFile(cfgPath)
+					if err != nil {
+						return err
+					}
+					var cfg map[string]any
+					if err := json.Unmarshal(data, &cfg); err != nil {
+						return err
+					}
+					for _, key := range []string{"gateway", "session", "cron"} {
+						if _, ok := cfg[key]; !ok {
+							return fmt.Errorf("config is missing %s: %s", key, data)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccFileMode_InvalidConfigCacheTTL(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path      = "` + cfgPath + `"
+  config_cache_ttl = "soon"
+}
+
+data "openclaw_config" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid config_cache_ttl`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_BackupOnWrite(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path      = "` + cfgPath + `"
+  backup_on_write  = true
+  backup_retention = 1
+}
+
+resource "openclaw_gateway" "test" {
+  port = 19001
+}
+`,
+				Check: func(*terraform.State) error {
+					backups, err := filepath.Glob(cfgPath + ".tfbackup-*")
+					if err != nil {
+						return err
+					}
+					if len(backups) != 1 {
+						return fmt.Errorf("backups = %v, want 1", backups)
+					}
+					data, err := os.ReadFile(backups[0])
+					if err != nil {
+						return err
+					}
+					if string(data) != "{}" {
+						return fmt.Errorf("backup = %s, want the config before the apply", data)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccFileMode_StrictConcurrency(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+	config := `
+provider "openclaw" {
+  config_path        = "` + cfgPath + `"
+  strict_concurrency = true
+  config_cache_ttl   = "0s"
+}
+
+resource "openclaw_gateway" "test" {
+  port = 19001
+}
+
+resource "openclaw_session" "test" {
+  dm_scope = "per-peer"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// Resources applied in parallel do not trip over each other.
+				Config: config,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ConfigChangesRequiresGateway(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path = "` + cfgPath + `"
+}
+
+data "openclaw_config_changes" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`only available in WebSocket mode`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_InvalidWaitAfterWrite(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path      = "` + cfgPath + `"
+  wait_after_write = "-5s"
+}
+
+data "openclaw_config" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid wait_after_write`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_InvalidCircuitBreakerCooldown(t *testing.T) {
+	cfgPath, _ := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openclaw" {
+  config_path              = "` + cfgPath + `"
+  circuit_breaker_cooldown = "never"
+}
+
+data "openclaw_config" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid circuit_breaker_cooldown`),
+			},
+		},
+	})
+}
+
+func TestAccFileMode_HookEndpointResource(t *testing.T) {
+	configPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_hook" "test" {
+  enabled = true
+}
+
+resource "openclaw_hook_endpoint" "github" {
+  name        = "github"
+  path        = "github"
+  token       = "gh-secret"
+  agent_id    = "ops"
+  session_key = "hook:github"
+  methods     = ["POST"]
+  template    = "{{action}}"
+}
+
+resource "openclaw_hook_endpoint" "alerts" {
+  name = "alerts"
+  path = "alerts"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_hook_endpoint.github", "id", "github"),
+					resource.TestCheckResourceAttr("openclaw_hook_endpoint.github", "agent_id", "ops"),
+					resource.TestCheckResourceAttr("openclaw_hook_endpoint.github", "methods.0", "POST"),
+					resource.TestCheckResourceAttr("openclaw_hook_endpoint.alerts", "path", "alerts"),
+				),
+			},
+			{
+				// Destroying the hooks block leaves the endpoints in place.
+				Config: providerBlock + `
+resource "openclaw_hook_endpoint" "alerts" {
+  name = "alerts"
+  path = "alerts"
+}
+`,
+				Check: func(_ *terraform.State) error {
+					data, err := os.ReadFile(configPath)
+					if err != nil {
+						return err
+					}
+					if !strings.Contains(string(data), `"alerts"`) || strings.Contains(string(data), `"github"`) {
+						return fmt.Errorf("expected only the alerts endpoint to remain, got %s", data)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:      "openclaw_hook_endpoint.alerts",
+				ImportState:       true,
+				ImportStateId:     "alerts",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ModelAliasResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_model_alias" "fast" {
+  alias = "fast"
+  model = "anthropic/claude-haiku-4-5"
+}
+
+resource "openclaw_model_alias" "smart" {
+  alias = "smart"
+  model = "anthropic/claude-opus-4-6"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_model_alias.fast", "id", "fast"),
+					resource.TestCheckResourceAttr("openclaw_model_alias.fast", "model", "anthropic/claude-haiku-4-5"),
+					resource.TestCheckResourceAttr("openclaw_model_alias.smart", "model", "anthropic/claude-opus-4-6"),
+				),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_model_alias" "fast" {
+  alias = "fast"
+  model = "openai/gpt-5.2-mini"
+}
+`,
+				Check: resource.TestCheckResourceAttr("openclaw_model_alias.fast", "model", "openai/gpt-5.2-mini"),
+			},
+			{
+				ResourceName:      "openclaw_model_alias.fast",
+				ImportState:       true,
+				ImportStateId:     "fast",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_MemoryResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_memory" "test" {
+  enabled         = true
+  backend         = "builtin"
+  embedding_model = "openai/text-embedding-3-small"
+  max_entries     = 5000
+  retention       = "30d"
+  scope           = "shared"
+}
+
+data "openclaw_memory" "test" {
+  depends_on = [openclaw_memory.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_memory.test", "id", "memory"),
+					resource.TestCheckResourceAttr("openclaw_memory.test", "max_entries", "5000"),
+					resource.TestCheckResourceAttr("data.openclaw_memory.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.openclaw_memory.test", "embedding_model", "openai/text-embedding-3-small"),
+					resource.TestCheckResourceAttr("data.openclaw_memory.test", "max_entries", "5000"),
+					resource.TestCheckResourceAttr("data.openclaw_memory.test", "scope", "shared"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_memory.test",
+				ImportState:       true,
+				ImportStateId:     "memory",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_LoggingResource(t *testing.T) {
+	configPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_logging" "test" {
+  level        = "info"
+  format       = "json"
+  max_size_mb  = 50
+  max_age_days = 7
+
+  subsystem_levels = {
+    "channels.telegram" = "debug"
+    "cron"              = "warn"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_logging.test", "id", "logging"),
+					resource.TestCheckResourceAttr("openclaw_logging.test", "max_size_mb", "50"),
+					resource.TestCheckResourceAttr("openclaw_logging.test", "subsystem_levels.channels.telegram", "debug"),
+				),
+			},
+			{
+				// Dropping an override removes it from the config.
+				Config: providerBlock + `
+resource "openclaw_logging" "test" {
+  level        = "info"
+  format       = "json"
+  max_size_mb  = 50
+  max_age_days = 7
+
+  subsystem_levels = {
+    "channels.telegram" = "debug"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_logging.test", "subsystem_levels.%", "1"),
+					func(_ *terraform.State) error {
+						data, err := os.ReadFile(configPath)
+						if err != nil {
+							return err
+						}
+						if strings.Contains(string(data), `"cron"`) {
+							return fmt.Errorf("expected the cron override to be removed, got %s", data)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "openclaw_logging.test",
+				ImportState:       true,
+				ImportStateId:     "logging",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ObservabilityResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_observability" "test" {
+  sampling_ratio = 1.5
+}
+`,
+				ExpectError: regexp.MustCompile(`sampling_ratio must be between 0 and 1`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_observability" "test" {
+  service_name    = "openclaw-test"
+  metrics_enabled = true
+  metrics_port    = 9464
+  otlp_endpoint   = "http://otel-collector:4318"
+  sampling_ratio  = 0.25
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_observability.test", "id", "observability"),
+					resource.TestCheckResourceAttr("openclaw_observability.test", "metrics_port", "9464"),
+					resource.TestCheckResourceAttr("openclaw_observability.test", "sampling_ratio", "0.25"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_observability.test",
+				ImportState:       true,
+				ImportStateId:     "observability",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_BrowserResource(t *testing.T) {
+	configPath, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_tools" "test" {
+  browser_enabled = true
+}
+
+resource "openclaw_browser" "test" {
+  headless              = true
+  profile_dir           = "/tmp/profile"
+  allowed_domains       = ["github.com", "example.com"]
+  download_dir          = "/tmp/downloads"
+  max_screenshots       = 10
+  screenshot_max_width  = 1280
+  screenshot_max_height = 800
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_browser.test", "id", "browser"),
+					resource.TestCheckResourceAttr("openclaw_browser.test", "allowed_domains.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_browser.test", "screenshot_max_width", "1280"),
+					resource.TestCheckResourceAttr("openclaw_tools.test", "browser_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_browser.test",
+				ImportState:       true,
+				ImportStateId:     "browser",
+				ImportStateVerify: true,
+			},
+			{
+				// Destroying the browser settings keeps tools.browser.enabled.
+				Config: providerBlock + `
+resource "openclaw_tools" "test" {
+  browser_enabled = true
+}
+`,
+				Check: func(_ *terraform.State) error {
+					data, err := os.ReadFile(configPath)
+					if err != nil {
+						return err
+					}
+					var cfg map[string]any
+					if err := json.Unmarshal(data, &cfg); err != nil {
+						return err
+					}
+					browser, _ := cfg["tools"].(map[string]any)["browser"].(map[string]any)
+					if len(browser) != 1 || browser["enabled"] != true {
+						return fmt.Errorf("expected only tools.browser.enabled to remain, got %v", browser)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccFileMode_SandboxResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_sandbox" "test" {
+  cpus = 0
+}
+`,
+				ExpectError: regexp.MustCompile(`cpus must be greater than 0`),
+			},
+			{
+				Config: providerBlock + `
+resource "openclaw_agent_defaults" "test" {
+  sandbox_mode  = "non-main"
+  sandbox_scope = "agent"
+}
+
+resource "openclaw_sandbox" "test" {
+  image           = "ghcr.io/openclaw/sandbox:latest"
+  cpus            = 1.5
+  memory          = "2g"
+  network         = "none"
+  mount_allowlist = ["/data"]
+  idle_teardown   = "30m"
+}
+
+data "openclaw_sandbox" "test" {
+  depends_on = [openclaw_agent_defaults.test, openclaw_sandbox.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_sandbox.test", "id", "sandbox"),
+					resource.TestCheckResourceAttr("openclaw_sandbox.test", "cpus", "1.5"),
+					resource.TestCheckResourceAttr("data.openclaw_sandbox.test", "mode", "non-main"),
+					resource.TestCheckResourceAttr("data.openclaw_sandbox.test", "scope", "agent"),
+					resource.TestCheckResourceAttr("data.openclaw_sandbox.test", "memory", "2g"),
+					resource.TestCheckResourceAttr("data.openclaw_sandbox.test", "mount_allowlist.0", "/data"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_sandbox.test",
+				ImportState:       true,
+				ImportStateId:     "sandbox",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFileMode_ToolProfileResource(t *testing.T) {
+	_, providerBlock := testConfigDir(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerBlock + `
+resource "openclaw_tools" "test" {
+  profile = "coding"
+}
+
+resource "openclaw_tool_profile" "support" {
+  name  = "support"
+  allow = ["read", "web_search"]
+  deny  = ["exec"]
+  options_json = jsonencode({
+    web_search = { max_results = 5 }
+  })
+}
+
+resource "openclaw_agent" "helpdesk" {
+  agent_id      = "helpdesk"
+  tools_profile = openclaw_tool_profile.support.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openclaw_tool_profile.support", "id", "support"),
+					resource.TestCheckResourceAttr("openclaw_tool_profile.support", "allow.#", "2"),
+					resource.TestCheckResourceAttr("openclaw_tool_profile.support", "deny.0", "exec"),
+					resource.TestCheckResourceAttr("openclaw_agent.helpdesk", "tools_profile", "support"),
+					resource.TestCheckResourceAttr("openclaw_tools.test", "profile", "coding"),
+				),
+			},
+			{
+				ResourceName:      "openclaw_tool_profile.support",
+				ImportState:       true,
+				ImportStateId:     "support",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
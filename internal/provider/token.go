@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// tokenCommandTimeout bounds token_command so a hung secret manager prompt
+// cannot stall provider configuration indefinitely.
+const tokenCommandTimeout = 30 * time.Second
+
+// resolveToken returns the gateway token from exactly one of the token,
+// token_file and token_command attributes, falling back to the
+// OPENCLAW_GATEWAY_TOKEN and OPENCLAW_GATEWAY_TOKEN_FILE environment variables.
+func resolveToken(ctx context.Context, config OpenClawProviderModel) (string, error) {
+	token := config.Token.ValueString()
+	tokenFile := config.TokenFile.ValueString()
+	tokenCommand := config.TokenCommand.ValueString()
+
+	set := 0
+	for _, v := range []string{token, tokenFile, tokenCommand} {
+		if v != "" {
+			set++
+		}
+	}
+	if set > 1 {
+		return "", fmt.Errorf("only one of token, token_file and token_command may be set")
+	}
+
+	switch {
+	case token != "":
+		return token, nil
+	case tokenFile != "":
+		return readTokenFile(tokenFile)
+	case tokenCommand != "":
+		return runTokenCommand(ctx, tokenCommand)
+	}
+
+	if v := os.Getenv("OPENCLAW_GATEWAY_TOKEN"); v != "" {
+		return v, nil
+	}
+	if v := os.Getenv("OPENCLAW_GATEWAY_TOKEN_FILE"); v != "" {
+		return readTokenFile(v)
+	}
+	return "", nil
+}
+
+func readTokenFile(path string) (string, error) {
+	path, err := expandValue(path)
+	if err != nil {
+		return "", fmt.Errorf("token file: %w", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// runTokenCommand runs command through the platform shell and returns its
+// trimmed stdout.
+func runTokenCommand(ctx context.Context, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return "", fmt.Errorf("token_command failed: %w", err)
+		}
+		return "", fmt.Errorf("token_command failed: %w: %s", err, msg)
+	}
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("token_command produced no output")
+	}
+	return token, nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveToken(t *testing.T) {
+	t.Setenv("OPENCLAW_GATEWAY_TOKEN", "")
+	t.Setenv("OPENCLAW_GATEWAY_TOKEN_FILE", "")
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("  from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	model := func(token, file, command string) OpenClawProviderModel {
+		str := func(v string) types.String {
+			if v == "" {
+				return types.StringNull()
+			}
+			return types.StringValue(v)
+		}
+		return OpenClawProviderModel{Token: str(token), TokenFile: str(file), TokenCommand: str(command)}
+	}
+
+	type tokenCase struct {
+		name    string
+		config  OpenClawProviderModel
+		want    string
+		wantErr string
+	}
+	cases := []tokenCase{
+		{name: "token", config: model("literal", "", ""), want: "literal"},
+		{name: "file", config: model("", tokenFile, ""), want: "from-file"},
+		{name: "conflict", config: model("literal", tokenFile, ""), wantErr: "only one of"},
+		{name: "missing file", config: model("", filepath.Join(t.TempDir(), "nope"), ""), wantErr: "reading token file"},
+		{name: "none", config: model("", "", ""), want: ""},
+	}
+	if runtime.GOOS != "windows" {
+		cases = append(cases,
+			tokenCase{name: "command", config: model("", "", "echo from-command"), want: "from-command"},
+			tokenCase{name: "failing command", config: model("", "", "echo denied >&2; exit 3"), wantErr: "denied"},
+		)
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resolveToken(context.Background(), tc.config)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestResolveToken_EnvFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("env-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("OPENCLAW_GATEWAY_TOKEN", "")
+	t.Setenv("OPENCLAW_GATEWAY_TOKEN_FILE", tokenFile)
+
+	got, err := resolveToken(context.Background(), OpenClawProviderModel{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "env-file" {
+		t.Fatalf("got %q, want env-file", got)
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ provider.ProviderWithConfigValidators = &OpenClawProvider{}
+
+// ConfigValidators catches connection settings that can only fail or mislead
+// at apply time, so they surface during terraform validate and plan instead.
+func (p *OpenClawProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		modePrecedenceValidator{},
+		gatewayURLValidator{},
+		credentialsValidator{},
+	}
+}
+
+// modePrecedenceValidator warns when settings for a lower-precedence mode are
+// set alongside gateway_url and would be silently ignored.
+type modePrecedenceValidator struct{}
+
+func (v modePrecedenceValidator) Description(_ context.Context) string {
+	return "config_path, docker_container and discover are ignored when gateway_url is set"
+}
+
+func (v modePrecedenceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v modePrecedenceValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config OpenClawProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || !isSet(config.GatewayURL) {
+		return
+	}
+	for _, attr := range []struct {
+		name string
+		v    types.String
+	}{
+		{"config_path", config.ConfigPath},
+		{"docker_container", config.DockerContainer},
+	} {
+		if isSet(attr.v) {
+			resp.Diagnostics.AddAttributeWarning(path.Root(attr.name), "Conflicting connection settings",
+				fmt.Sprintf("gateway_url takes precedence over %s, so %s is ignored. Remove one of them.", attr.name, attr.name))
+		}
+	}
+	if config.Discover.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(path.Root("discover"), "Conflicting connection settings",
+			"discover only applies when gateway_url is unset, so it is ignored. Remove one of them.")
+	}
+}
+
+// gatewayURLValidator rejects gateway URLs the client could never dial.
+type gatewayURLValidator struct{}
+
+func (v gatewayURLValidator) Description(_ context.Context) string {
+	return "gateway URLs must be ws:// or wss:// URLs with a host"
+}
+
+func (v gatewayURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v gatewayURLValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config OpenClawProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	check := func(v types.String, at path.Path) {
+		// Values with $ references are checked once expanded in Configure.
+		if !isSet(v) || strings.Contains(v.ValueString(), "$") {
+			return
+		}
+		if _, err := parseGatewayURL(v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(at, "Invalid gateway URL", err.Error())
+		}
+	}
+	check(config.GatewayURL, path.Root("gateway_url"))
+	for i, g := range config.Gateways {
+		check(g.URL, path.Root("gateways").AtListIndex(i).AtName("url"))
+	}
+}
+
+// credentialsValidator warns when a gateway reached over the network is
+// given no credentials. A gateway is only reachable off-host when it binds
+// beyond loopback (bind = "all"), and without auth that exposes its config
+// to anyone on the network.
+type credentialsValidator struct{}
+
+func (v credentialsValidator) Description(_ context.Context) string {
+	return "non-loopback gateways should be given a token or password"
+}
+
+func (v credentialsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v credentialsValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config OpenClawProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings tunnelled over SSH dial the gateway from the remote host.
+	if isSet(config.GatewayURL) && !isSet(config.SSHHost) && os.Getenv("OPENCLAW_SSH_HOST") == "" {
+		hasCreds := false
+		for _, v := range []types.String{config.Token, config.TokenFile, config.TokenCommand, config.Password} {
+			hasCreds = hasCreds || !v.IsNull()
+		}
+		for _, env := range []string{"OPENCLAW_GATEWAY_TOKEN", "OPENCLAW_GATEWAY_TOKEN_FILE", "OPENCLAW_GATEWAY_PASSWORD"} {
+			hasCreds = hasCreds || os.Getenv(env) != ""
+		}
+		if !hasCreds && isRemoteGateway(config.GatewayURL.ValueString()) {
+			resp.Diagnostics.AddAttributeWarning(path.Root("gateway_url"), "Unauthenticated remote gateway",
+				unauthenticatedWarning(config.GatewayURL.ValueString()))
+		}
+	}
+
+	for i, g := range config.Gateways {
+		if !isSet(g.URL) || !g.Token.IsNull() || !g.Password.IsNull() {
+			continue
+		}
+		if isRemoteGateway(g.URL.ValueString()) {
+			resp.Diagnostics.AddAttributeWarning(path.Root("gateways").AtListIndex(i).AtName("url"),
+				"Unauthenticated remote gateway", unauthenticatedWarning(g.URL.ValueString()))
+		}
+	}
+}
+
+func unauthenticatedWarning(u string) string {
+	return fmt.Sprintf("No token or password is configured for %s. A gateway reachable from other hosts "+
+		"(bind = \"all\") should require auth; otherwise anyone on the network can read and change its config.", u)
+}
+
+// parseGatewayURL checks that s is a URL the WebSocket client can dial.
+func parseGatewayURL(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid URL: %v", s, err)
+	}
+	if u.Scheme != "ws" && u.Scheme != "wss" {
+		return nil, fmt.Errorf("%q must use the ws:// or wss:// scheme", s)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("%q has no host", s)
+	}
+	return u, nil
+}
+
+// isRemoteGateway reports whether s points at a host other than this one.
+// Unparseable URLs are left to gatewayURLValidator.
+func isRemoteGateway(s string) bool {
+	if strings.Contains(s, "$") {
+		return false
+	}
+	u, err := parseGatewayURL(s)
+	if err != nil {
+		return false
+	}
+	host := u.Hostname()
+	if host == "localhost" {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return false
+	}
+	return true
+}
+
+// isSet reports whether v has a known, non-empty value.
+func isSet(v types.String) bool {
+	return !v.IsNull() && !v.IsUnknown() && v.ValueString() != ""
+}
@@ -0,0 +1,32 @@
+package provider
+
+import "testing"
+
+func TestParseGatewayURL(t *testing.T) {
+	for _, s := range []string{"ws://127.0.0.1:18789", "wss://gw.example.com", "ws://[::1]:18789/ws"} {
+		if _, err := parseGatewayURL(s); err != nil {
+			t.Errorf("parseGatewayURL(%q): %v", s, err)
+		}
+	}
+	for _, s := range []string{"http://127.0.0.1:18789", "127.0.0.1:18789", "ws://", "ws://:18789", "ws://gw example.com"} {
+		if _, err := parseGatewayURL(s); err == nil {
+			t.Errorf("parseGatewayURL(%q): expected error", s)
+		}
+	}
+}
+
+func TestIsRemoteGateway(t *testing.T) {
+	for s, want := range map[string]bool{
+		"ws://127.0.0.1:18789":    false,
+		"ws://localhost:18789":    false,
+		"ws://[::1]:18789":        false,
+		"ws://192.168.1.10:18789": true,
+		"wss://gw.example.com":    true,
+		"ws://${GATEWAY_HOST}:1":  false,
+		"not a url":               false,
+	} {
+		if got := isRemoteGateway(s); got != want {
+			t.Errorf("isRemoteGateway(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,16 +17,18 @@ var _ resource.Resource = &AgentResource{}
 var _ resource.ResourceWithImportState = &AgentResource{}
 
 type AgentResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type AgentModel struct {
 	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
 	AgentID         types.String `tfsdk:"agent_id"`
 	DefaultAgent    types.Bool   `tfsdk:"default_agent"`
 	Name            types.String `tfsdk:"name"`
 	Workspace       types.String `tfsdk:"workspace"`
 	Model           types.String `tfsdk:"model"`
+	Persona         types.String `tfsdk:"persona"`
 	IdentityName    types.String `tfsdk:"identity_name"`
 	IdentityEmoji   types.String `tfsdk:"identity_emoji"`
 	IdentityTheme   types.String `tfsdk:"identity_theme"`
@@ -37,6 +40,12 @@ type AgentModel struct {
 	ToolsDeny       types.List   `tfsdk:"tools_deny"`
 }
 
+// identityDeprecation is shown for the inline identity_* attributes, which
+// openclaw_agent_identity replaces.
+const identityDeprecation = "Manage the agent identity with the openclaw_agent_identity resource instead. " +
+	"Remove the identity_* attributes from openclaw_agent first: the identity already in the config is kept, " +
+	"and can then be imported with terraform import openclaw_agent_identity.<name> <agent_id>."
+
 func NewAgentResource() resource.Resource {
 	return &AgentResource{}
 }
@@ -49,7 +58,8 @@ func (r *AgentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 		Description: "Manages an individual agent entry in agents.list[].",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"agent_id": schema.StringAttribute{
 				Description: "Stable identifier for the agent (maps to 'id' in config).",
 				Required:    true,
@@ -70,17 +80,24 @@ func (r *AgentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Model for this agent (e.g. anthropic/claude-opus-4-6).",
 				Optional:    true,
 			},
-			"identity_name": schema.StringAttribute{
-				Description: "Agent identity display name.",
+			"persona": schema.StringAttribute{
+				Description: "Persona name, as defined with openclaw_persona.",
 				Optional:    true,
 			},
+			"identity_name": schema.StringAttribute{
+				Description:        "Agent identity display name. Deprecated: use openclaw_agent_identity.",
+				Optional:           true,
+				DeprecationMessage: identityDeprecation,
+			},
 			"identity_emoji": schema.StringAttribute{
-				Description: "Agent identity emoji.",
-				Optional:    true,
+				Description:        "Agent identity emoji. Deprecated: use openclaw_agent_identity.",
+				Optional:           true,
+				DeprecationMessage: identityDeprecation,
 			},
 			"identity_theme": schema.StringAttribute{
-				Description: "Agent identity theme color.",
-				Optional:    true,
+				Description:        "Agent identity theme color. Deprecated: use openclaw_agent_identity.",
+				Optional:           true,
+				DeprecationMessage: identityDeprecation,
 			},
 			"mention_patterns": schema.ListAttribute{
 				Description: "Patterns that mention this agent in group chats.",
@@ -96,7 +113,7 @@ func (r *AgentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 			},
 			"tools_profile": schema.StringAttribute{
-				Description: "Tools profile name.",
+				Description: "Tools profile name: a built-in profile or one defined with openclaw_tool_profile.",
 				Optional:    true,
 			},
 			"tools_allow": schema.ListAttribute{
@@ -122,13 +139,13 @@ func (r *AgentResource) Configure(_ context.Context, req resource.ConfigureReque
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 // ── helpers for reading/writing the agents.list array ────────
 
-func (r *AgentResource) getAgentsList(ctx context.Context) ([]any, string, error) {
-	agentsSection, hash, err := client.GetSection(ctx, r.client, "agents")
+func getAgentsList(ctx context.Context, c client.Client) ([]any, string, error) {
+	agentsSection, hash, err := client.GetSection(ctx, c, "agents")
 	if err != nil {
 		return nil, "", err
 	}
@@ -146,7 +163,7 @@ func (r *AgentResource) getAgentsList(ctx context.Context) ([]any, string, error
 	return list, hash, nil
 }
 
-func (r *AgentResource) findAgentIndex(list []any, agentID string) int {
+func findAgentIndex(list []any, agentID string) int {
 	for i, item := range list {
 		if m, ok := item.(map[string]any); ok {
 			if id, ok := m["id"].(string); ok && id == agentID {
@@ -157,21 +174,47 @@ func (r *AgentResource) findAgentIndex(list []any, agentID string) int {
 	return -1
 }
 
-func (r *AgentResource) writeAgentsList(ctx context.Context, list []any, hash string) error {
+func writeAgentsList(ctx context.Context, c client.Client, list []any, hash string) error {
 	patch := map[string]any{"agents": map[string]any{"list": list}}
-	return r.client.PatchConfig(ctx, patch, hash)
+	return c.PatchConfig(ctx, patch, hash)
+}
+
+// keepEntryKeys carries the given keys of the existing entry at idx over to
+// entry when the plan sets none inline, so that an identity managed by
+// openclaw_agent_identity, a heartbeat managed by openclaw_heartbeat or an
+// environment managed by openclaw_agent_env survives the entry being
+// rewritten.
+func keepEntryKeys(list []any, idx int, entry map[string]any, keys ...string) {
+	if idx < 0 {
+		return
+	}
+	old, ok := list[idx].(map[string]any)
+	if !ok {
+		return
+	}
+	for _, k := range keys {
+		if _, ok := entry[k]; ok {
+			continue
+		}
+		if v, ok := old[k]; ok {
+			entry[k] = v
+		}
+	}
 }
 
 // ── CRUD ─────────────────────────────────────────────────────
 
 func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan AgentModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	list, hash, err := r.getAgentsList(ctx)
+	list, hash, err := getAgentsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
 		return
@@ -180,15 +223,17 @@ func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest,
 	entry := r.modelToMap(ctx, plan)
 	agentID := plan.AgentID.ValueString()
 
-	idx := r.findAgentIndex(list, agentID)
+	idx := findAgentIndex(list, agentID)
+	keepEntryKeys(list, idx, entry, "identity", "heartbeat", "env")
 	if idx >= 0 {
 		list[idx] = entry
 	} else {
+		idx = len(list)
 		list = append(list, entry)
 	}
 
-	if err := r.writeAgentsList(ctx, list, hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write agents list", err.Error())
+	if err := writeAgentsList(ctx, r.client, list, hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write agents list", err, "agents", "list", strconv.Itoa(idx))
 		return
 	}
 
@@ -197,20 +242,23 @@ func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state AgentModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	list, _, err := r.getAgentsList(ctx)
+	list, _, err := getAgentsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
 		return
 	}
 
 	agentID := state.AgentID.ValueString()
-	idx := r.findAgentIndex(list, agentID)
+	idx := findAgentIndex(list, agentID)
 	if idx < 0 {
 		resp.State.RemoveResource(ctx)
 		return
@@ -228,13 +276,16 @@ func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan AgentModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	list, hash, err := r.getAgentsList(ctx)
+	list, hash, err := getAgentsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
 		return
@@ -243,15 +294,17 @@ func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest,
 	entry := r.modelToMap(ctx, plan)
 	agentID := plan.AgentID.ValueString()
 
-	idx := r.findAgentIndex(list, agentID)
+	idx := findAgentIndex(list, agentID)
+	keepEntryKeys(list, idx, entry, "identity", "heartbeat", "env")
 	if idx >= 0 {
 		list[idx] = entry
 	} else {
+		idx = len(list)
 		list = append(list, entry)
 	}
 
-	if err := r.writeAgentsList(ctx, list, hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write agents list", err.Error())
+	if err := writeAgentsList(ctx, r.client, list, hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write agents list", err, "agents", "list", strconv.Itoa(idx))
 		return
 	}
 
@@ -260,40 +313,46 @@ func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *AgentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state AgentModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	list, hash, err := r.getAgentsList(ctx)
+	list, hash, err := getAgentsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
 		return
 	}
 
 	agentID := state.AgentID.ValueString()
-	idx := r.findAgentIndex(list, agentID)
+	idx := findAgentIndex(list, agentID)
 	if idx >= 0 {
 		list = append(list[:idx], list[idx+1:]...)
 	}
 
-	if err := r.writeAgentsList(ctx, list, hash); err != nil {
+	if err := writeAgentsList(ctx, r.client, list, hash); err != nil {
 		resp.Diagnostics.AddError("Failed to delete agent", err.Error())
 		return
 	}
 }
 
 func (r *AgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	agentID := req.ID
+	gw, agentID, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 
-	list, _, err := r.getAgentsList(ctx)
+	list, _, err := getAgentsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
 		return
 	}
 
-	idx := r.findAgentIndex(list, agentID)
+	idx := findAgentIndex(list, agentID)
 	if idx < 0 {
 		resp.Diagnostics.AddError("Agent not found", fmt.Sprintf("No agent with id %q in agents.list", agentID))
 		return
@@ -309,6 +368,7 @@ func (r *AgentResource) ImportState(ctx context.Context, req resource.ImportStat
 	state.AgentID = types.StringValue(agentID)
 	r.mapToModel(ctx, entry, &state)
 	state.ID = types.StringValue(agentID)
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -322,6 +382,7 @@ func (r *AgentResource) modelToMap(ctx context.Context, m AgentModel) map[string
 	setIfString(d, "name", m.Name)
 	setIfString(d, "workspace", m.Workspace)
 	setIfString(d, "model", m.Model)
+	setIfString(d, "persona", m.Persona)
 	setIfString(d, "sandboxMode", m.SandboxMode)
 	setIfString(d, "sandboxScope", m.SandboxScope)
 
@@ -356,10 +417,13 @@ func (r *AgentResource) mapToModel(ctx context.Context, s map[string]any, m *Age
 	readString(s, "name", &m.Name)
 	readString(s, "workspace", &m.Workspace)
 	readString(s, "model", &m.Model)
+	readString(s, "persona", &m.Persona)
 	readString(s, "sandboxMode", &m.SandboxMode)
 	readString(s, "sandboxScope", &m.SandboxScope)
 
-	if identity, ok := s["identity"].(map[string]any); ok {
+	// The inline identity is only read back while it is in use; otherwise
+	// the identity belongs to openclaw_agent_identity.
+	if identity, ok := s["identity"].(map[string]any); ok && m.hasInlineIdentity() {
 		readString(identity, "name", &m.IdentityName)
 		readString(identity, "emoji", &m.IdentityEmoji)
 		readString(identity, "theme", &m.IdentityTheme)
@@ -375,3 +439,7 @@ func (r *AgentResource) mapToModel(ctx context.Context, s map[string]any, m *Age
 		readStringList(ctx, tools, "deny", &m.ToolsDeny)
 	}
 }
+
+func (m AgentModel) hasInlineIdentity() bool {
+	return !m.IdentityName.IsNull() || !m.IdentityEmoji.IsNull() || !m.IdentityTheme.IsNull()
+}
@@ -18,11 +18,12 @@ var _ resource.Resource = &AgentDefaultsResource{}
 var _ resource.ResourceWithImportState = &AgentDefaultsResource{}
 
 type AgentDefaultsResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type AgentDefaultsResourceModel struct {
 	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
 	Workspace       types.String `tfsdk:"workspace"`
 	ModelPrimary    types.String `tfsdk:"model_primary"`
 	ModelFallbacks  types.List   `tfsdk:"model_fallbacks"`
@@ -41,6 +42,13 @@ type AgentDefaultsResourceModel struct {
 	SandboxScope types.String `tfsdk:"sandbox_scope"`
 }
 
+// agentDefaultsKeys are the keys of agents.defaults this resource owns,
+// apart from heartbeat.
+var agentDefaultsKeys = []string{
+	"workspace", "timeoutSeconds", "maxConcurrent", "userTimezone",
+	"thinkingDefault", "verboseDefault", "model", "sandbox",
+}
+
 func NewAgentDefaultsResource() resource.Resource {
 	return &AgentDefaultsResource{}
 }
@@ -56,6 +64,7 @@ func (r *AgentDefaultsResource) Schema(_ context.Context, _ resource.SchemaReque
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"gateway": gatewayAttribute(),
 			"workspace": schema.StringAttribute{
 				Description: "Default agent workspace path.",
 				Optional:    true,
@@ -96,12 +105,14 @@ func (r *AgentDefaultsResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional:    true,
 			},
 			"heartbeat_every": schema.StringAttribute{
-				Description: "Heartbeat interval duration string (e.g. 30m, 2h). 0m disables.",
-				Optional:    true,
+				Description:        "Heartbeat interval duration string (e.g. 30m, 2h). 0m disables. Deprecated: use openclaw_heartbeat.",
+				Optional:           true,
+				DeprecationMessage: "Use the openclaw_heartbeat resource instead.",
 			},
 			"heartbeat_target": schema.StringAttribute{
-				Description: "Heartbeat delivery target: last|whatsapp|telegram|discord|none.",
-				Optional:    true,
+				Description:        "Heartbeat delivery target: last|whatsapp|telegram|discord|none. Deprecated: use openclaw_heartbeat.",
+				Optional:           true,
+				DeprecationMessage: "Use the openclaw_heartbeat resource instead.",
 			},
 			"sandbox_mode": schema.StringAttribute{
 				Description: "Sandbox mode: off|non-main|all.",
@@ -124,10 +135,13 @@ func (r *AgentDefaultsResource) Configure(_ context.Context, req resource.Config
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *AgentDefaultsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan AgentDefaultsResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -144,7 +158,7 @@ func (r *AgentDefaultsResource) Create(ctx context.Context, req resource.CreateR
 
 	patch := map[string]any{"agents": map[string]any{"defaults": defaults}}
 	if err := r.client.PatchConfig(ctx, patch, hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write agent defaults", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write agent defaults", err, "agents", "defaults")
 		return
 	}
 
@@ -153,6 +167,9 @@ func (r *AgentDefaultsResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *AgentDefaultsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state AgentDefaultsResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -175,6 +192,9 @@ func (r *AgentDefaultsResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *AgentDefaultsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan AgentDefaultsResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -191,7 +211,7 @@ func (r *AgentDefaultsResource) Update(ctx context.Context, req resource.UpdateR
 
 	patch := map[string]any{"agents": map[string]any{"defaults": defaults}}
 	if err := r.client.PatchConfig(ctx, patch, cfg.Hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write agent defaults", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write agent defaults", err, "agents", "defaults")
 		return
 	}
 
@@ -199,21 +219,39 @@ func (r *AgentDefaultsResource) Update(ctx context.Context, req resource.UpdateR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *AgentDefaultsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *AgentDefaultsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state AgentDefaultsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
 		return
 	}
 
-	patch := map[string]any{"agents": map[string]any{"defaults": nil}}
+	// The heartbeat belongs to openclaw_heartbeat unless the deprecated
+	// heartbeat_* attributes are set.
+	unset := withNullKeys(map[string]any{}, agentDefaultsKeys...)
+	if !state.HeartbeatEvery.IsNull() || !state.HeartbeatTarget.IsNull() {
+		unset["heartbeat"] = nil
+	}
+	patch := map[string]any{"agents": map[string]any{"defaults": unset}}
 	if err := r.client.PatchConfig(ctx, patch, cfg.Hash); err != nil {
 		resp.Diagnostics.AddError("Failed to delete agent defaults", err.Error())
 		return
 	}
 }
 
-func (r *AgentDefaultsResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *AgentDefaultsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "agents", "defaults")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import agent defaults", err.Error())
@@ -225,6 +263,7 @@ func (r *AgentDefaultsResource) ImportState(ctx context.Context, _ resource.Impo
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("agent_defaults")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -330,11 +369,13 @@ func (r *AgentDefaultsResource) mapToModel(_ context.Context, section map[string
 		m.ModelPrimary = types.StringValue(model)
 	}
 
+	// The heartbeat is only read back while this resource manages it, so that
+	// it can be left to openclaw_heartbeat.
 	if hb, ok := section["heartbeat"].(map[string]any); ok {
-		if v, ok := hb["every"].(string); ok {
+		if v, ok := hb["every"].(string); ok && !m.HeartbeatEvery.IsNull() {
 			m.HeartbeatEvery = types.StringValue(v)
 		}
-		if v, ok := hb["target"].(string); ok {
+		if v, ok := hb["target"].(string); ok && !m.HeartbeatTarget.IsNull() {
 			m.HeartbeatTarget = types.StringValue(v)
 		}
 	}
@@ -0,0 +1,348 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &AgentEnvResource{}
+var _ resource.ResourceWithImportState = &AgentEnvResource{}
+var _ resource.ResourceWithValidateConfig = &AgentEnvResource{}
+
+type AgentEnvResource struct {
+	gatewayTarget
+}
+
+type AgentEnvModel struct {
+	ID            types.String `tfsdk:"id"`
+	Gateway       types.String `tfsdk:"gateway"`
+	AgentID       types.String `tfsdk:"agent_id"`
+	Vars          types.Map    `tfsdk:"vars"`
+	SensitiveVars types.Map    `tfsdk:"sensitive_vars"`
+}
+
+var envVarPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func NewAgentEnvResource() resource.Resource {
+	return &AgentEnvResource{}
+}
+
+func (r *AgentEnvResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_env"
+}
+
+func (r *AgentEnvResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the environment variables of an agent in agents.list[] (env.vars), leaving the rest of the entry to openclaw_agent.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"agent_id": schema.StringAttribute{
+				Description: "ID of the agent. The agent must already exist.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vars": schema.MapAttribute{
+				Description: "Environment variables shown in plans, such as workspace settings.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"sensitive_vars": schema.MapAttribute{
+				Description: "Environment variables hidden from plans, such as API keys. Sensitive.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *AgentEnvResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *AgentEnvResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AgentEnvModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Vars.IsNull() && config.SensitiveVars.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("vars"), "Missing variables",
+			"At least one of vars or sensitive_vars must be set.")
+		return
+	}
+
+	plain := make(map[string]types.String)
+	for _, attr := range []struct {
+		name string
+		vars types.Map
+	}{{"vars", config.Vars}, {"sensitive_vars", config.SensitiveVars}} {
+		if attr.vars.IsNull() || attr.vars.IsUnknown() {
+			continue
+		}
+		var vars map[string]types.String
+		resp.Diagnostics.Append(attr.vars.ElementsAs(ctx, &vars, false)...)
+		for key := range vars {
+			if !envVarPattern.MatchString(key) {
+				resp.Diagnostics.AddAttributeError(path.Root(attr.name).AtMapKey(key), "Invalid variable name",
+					fmt.Sprintf("%q is not a valid environment variable name: use letters, digits and underscores, not starting with a digit.", key))
+				continue
+			}
+			if attr.name == "vars" {
+				plain[key] = vars[key]
+			} else if _, ok := plain[key]; ok {
+				resp.Diagnostics.AddAttributeError(path.Root("sensitive_vars").AtMapKey(key), "Conflicting variable",
+					fmt.Sprintf("%s is set in both vars and sensitive_vars.", key))
+			}
+		}
+	}
+}
+
+func (r *AgentEnvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan AgentEnvModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeEnv(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AgentEnvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state AgentEnvModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, _, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
+		return
+	}
+	agentID := state.AgentID.ValueString()
+	vars := agentEnvVars(list, agentID)
+	if vars == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.varsToModel(ctx, vars, &state)
+	state.ID = types.StringValue(agentID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AgentEnvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan AgentEnvModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeEnv(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AgentEnvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state AgentEnvModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, hash, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
+		return
+	}
+	idx := findAgentIndex(list, state.AgentID.ValueString())
+	if idx < 0 {
+		return
+	}
+	entry, ok := list[idx].(map[string]any)
+	if !ok {
+		return
+	}
+	env, ok := entry["env"].(map[string]any)
+	if !ok {
+		return
+	}
+	delete(env, "vars")
+	if len(env) == 0 {
+		delete(entry, "env")
+	}
+
+	if err := writeAgentsList(ctx, r.client, list, hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete agent environment", err.Error())
+		return
+	}
+}
+
+// ImportState imports every variable into sensitive_vars, since there is no
+// telling which of them are secrets. Move the others to vars in the
+// configuration; the next apply only changes state.
+func (r *AgentEnvResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, agentID, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	list, _, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
+		return
+	}
+	if findAgentIndex(list, agentID) < 0 {
+		resp.Diagnostics.AddError("Agent not found", fmt.Sprintf("No agent with id %q in agents.list", agentID))
+		return
+	}
+	vars := agentEnvVars(list, agentID)
+	if vars == nil {
+		resp.Diagnostics.AddError("Agent environment not found", fmt.Sprintf("Agent %q has no env.vars", agentID))
+		return
+	}
+
+	state := AgentEnvModel{
+		Vars:          types.MapNull(types.StringType),
+		SensitiveVars: types.MapNull(types.StringType),
+	}
+	r.varsToModel(ctx, vars, &state)
+	state.AgentID = types.StringValue(agentID)
+	state.ID = types.StringValue(agentID)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// writeEnv replaces env.vars of the planned agent's entry, leaving the rest of
+// the entry as it is.
+func (r *AgentEnvResource) writeEnv(ctx context.Context, plan tfsdk.Plan, m *AgentEnvModel, diags *diag.Diagnostics) bool {
+	list, hash, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read agents list", err.Error())
+		return false
+	}
+	agentID := m.AgentID.ValueString()
+	idx := findAgentIndex(list, agentID)
+	if idx < 0 {
+		diags.AddError("Agent not found",
+			fmt.Sprintf("No agent with id %q in agents.list. Create it with openclaw_agent before setting its environment.", agentID))
+		return false
+	}
+	entry, ok := list[idx].(map[string]any)
+	if !ok {
+		diags.AddError("Agent entry is not an object", "")
+		return false
+	}
+	env, ok := entry["env"].(map[string]any)
+	if !ok {
+		env = make(map[string]any)
+		entry["env"] = env
+	}
+	vars := make(map[string]any)
+	for _, group := range []types.Map{m.Vars, m.SensitiveVars} {
+		if group.IsNull() || group.IsUnknown() {
+			continue
+		}
+		var strs map[string]string
+		diags.Append(group.ElementsAs(ctx, &strs, false)...)
+		for k, v := range strs {
+			vars[k] = v
+		}
+	}
+	env["vars"] = vars
+
+	if err := writeAgentsList(ctx, r.client, list, hash); err != nil {
+		addWriteError(ctx, diags, plan, "Failed to write agent environment", err, "agents", "list", strconv.Itoa(idx), "env", "vars")
+		return false
+	}
+	m.ID = types.StringValue(agentID)
+	return true
+}
+
+// agentEnvVars returns env.vars of the agent's entry, or nil if the agent or
+// its variables are missing.
+func agentEnvVars(list []any, agentID string) map[string]any {
+	idx := findAgentIndex(list, agentID)
+	if idx < 0 {
+		return nil
+	}
+	entry, _ := list[idx].(map[string]any)
+	env, _ := entry["env"].(map[string]any)
+	vars, _ := env["vars"].(map[string]any)
+	return vars
+}
+
+// varsToModel splits vars between the two maps: a variable stays in vars if
+// the state already has it there, and anything else, including variables
+// added outside Terraform, lands in sensitive_vars.
+func (r *AgentEnvResource) varsToModel(ctx context.Context, vars map[string]any, m *AgentEnvModel) {
+	known := make(map[string]bool)
+	if !m.Vars.IsNull() && !m.Vars.IsUnknown() {
+		for k := range m.Vars.Elements() {
+			known[k] = true
+		}
+	}
+	plain := make(map[string]string)
+	sensitive := make(map[string]string)
+	for k, v := range vars {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if known[k] {
+			plain[k] = s
+		} else {
+			sensitive[k] = s
+		}
+	}
+	m.Vars = stringMapOrNull(ctx, plain)
+	m.SensitiveVars = stringMapOrNull(ctx, sensitive)
+}
+
+func stringMapOrNull(ctx context.Context, m map[string]string) types.Map {
+	if len(m) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	v, _ := types.MapValueFrom(ctx, types.StringType, m)
+	return v
+}
@@ -0,0 +1,261 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &AgentIdentityResource{}
+var _ resource.ResourceWithImportState = &AgentIdentityResource{}
+
+type AgentIdentityResource struct {
+	gatewayTarget
+}
+
+type AgentIdentityModel struct {
+	ID      types.String `tfsdk:"id"`
+	Gateway types.String `tfsdk:"gateway"`
+	AgentID types.String `tfsdk:"agent_id"`
+	Name    types.String `tfsdk:"name"`
+	Emoji   types.String `tfsdk:"emoji"`
+	Theme   types.String `tfsdk:"theme"`
+	Avatar  types.String `tfsdk:"avatar"`
+}
+
+func NewAgentIdentityResource() resource.Resource {
+	return &AgentIdentityResource{}
+}
+
+func (r *AgentIdentityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_identity"
+}
+
+func (r *AgentIdentityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the identity of an agent in agents.list[], leaving the rest of the entry to openclaw_agent.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"agent_id": schema.StringAttribute{
+				Description: "ID of the agent. The agent must already exist.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Identity display name.",
+				Optional:    true,
+			},
+			"emoji": schema.StringAttribute{
+				Description: "Identity emoji.",
+				Optional:    true,
+			},
+			"theme": schema.StringAttribute{
+				Description: "Identity theme (color or persona description).",
+				Optional:    true,
+			},
+			"avatar": schema.StringAttribute{
+				Description: "Avatar image, as a URL or a path in the agent workspace.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AgentIdentityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *AgentIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan AgentIdentityModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeIdentity(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AgentIdentityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state AgentIdentityModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, _, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
+		return
+	}
+	agentID := state.AgentID.ValueString()
+	idx := findAgentIndex(list, agentID)
+	if idx < 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	entry, _ := list[idx].(map[string]any)
+	identity, ok := entry["identity"].(map[string]any)
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(identity, &state)
+	state.ID = types.StringValue(agentID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AgentIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan AgentIdentityModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeIdentity(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AgentIdentityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state AgentIdentityModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, hash, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
+		return
+	}
+	idx := findAgentIndex(list, state.AgentID.ValueString())
+	if idx < 0 {
+		return
+	}
+	entry, ok := list[idx].(map[string]any)
+	if !ok {
+		return
+	}
+	if _, ok := entry["identity"]; !ok {
+		return
+	}
+	delete(entry, "identity")
+
+	if err := writeAgentsList(ctx, r.client, list, hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete agent identity", err.Error())
+		return
+	}
+}
+
+func (r *AgentIdentityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, agentID, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	list, _, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
+		return
+	}
+	idx := findAgentIndex(list, agentID)
+	if idx < 0 {
+		resp.Diagnostics.AddError("Agent not found", fmt.Sprintf("No agent with id %q in agents.list", agentID))
+		return
+	}
+	entry, _ := list[idx].(map[string]any)
+	identity, ok := entry["identity"].(map[string]any)
+	if !ok {
+		resp.Diagnostics.AddError("Agent identity not found", fmt.Sprintf("Agent %q has no identity", agentID))
+		return
+	}
+
+	var state AgentIdentityModel
+	r.mapToModel(identity, &state)
+	state.AgentID = types.StringValue(agentID)
+	state.ID = types.StringValue(agentID)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// writeIdentity replaces the identity of the planned agent's entry, leaving
+// the rest of the entry as it is.
+func (r *AgentIdentityResource) writeIdentity(ctx context.Context, plan tfsdk.Plan, m *AgentIdentityModel, diags *diag.Diagnostics) bool {
+	list, hash, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read agents list", err.Error())
+		return false
+	}
+	agentID := m.AgentID.ValueString()
+	idx := findAgentIndex(list, agentID)
+	if idx < 0 {
+		diags.AddError("Agent not found",
+			fmt.Sprintf("No agent with id %q in agents.list. Create it with openclaw_agent before setting its identity.", agentID))
+		return false
+	}
+	entry, ok := list[idx].(map[string]any)
+	if !ok {
+		diags.AddError("Agent entry is not an object", "")
+		return false
+	}
+	entry["identity"] = r.modelToMap(*m)
+
+	if err := writeAgentsList(ctx, r.client, list, hash); err != nil {
+		addWriteError(ctx, diags, plan, "Failed to write agent identity", err, "agents", "list", strconv.Itoa(idx), "identity")
+		return false
+	}
+	m.ID = types.StringValue(agentID)
+	return true
+}
+
+func (r *AgentIdentityResource) modelToMap(m AgentIdentityModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "name", m.Name)
+	setIfString(d, "emoji", m.Emoji)
+	setIfString(d, "theme", m.Theme)
+	setIfString(d, "avatar", m.Avatar)
+	return d
+}
+
+func (r *AgentIdentityResource) mapToModel(s map[string]any, m *AgentIdentityModel) {
+	readString(s, "name", &m.Name)
+	readString(s, "emoji", &m.Emoji)
+	readString(s, "theme", &m.Theme)
+	readString(s, "avatar", &m.Avatar)
+}
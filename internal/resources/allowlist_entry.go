@@ -0,0 +1,234 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &AllowlistEntryResource{}
+var _ resource.ResourceWithImportState = &AllowlistEntryResource{}
+
+// allowFromMu serializes changes to allowFrom arrays. Each change rewrites
+// the whole array, so entries applied in parallel would otherwise each add
+// themselves to the same stale copy and only the last would be kept.
+var allowFromMu sync.Mutex
+
+type AllowlistEntryResource struct {
+	gatewayTarget
+}
+
+type AllowlistEntryModel struct {
+	ID         types.String `tfsdk:"id"`
+	Gateway    types.String `tfsdk:"gateway"`
+	Channel    types.String `tfsdk:"channel"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func NewAllowlistEntryResource() resource.Resource {
+	return &AllowlistEntryResource{}
+}
+
+func (r *AllowlistEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowlist_entry"
+}
+
+func (r *AllowlistEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages one entry of a channel's allowFrom list, leaving the other entries as they are.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"channel": schema.StringAttribute{
+				Description: "Channel whose allowFrom list holds the entry (e.g. whatsapp, telegram).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				Description: "Phone number, user ID or handle to allow, in the channel's format.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AllowlistEntryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *AllowlistEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan AllowlistEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	channel := plan.Channel.ValueString()
+	if err := r.setEntry(ctx, channel, plan.Identifier.ValueString(), true); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to add allowlist entry", err, "channels", channel, "allowFrom")
+		return
+	}
+	plan.ID = types.StringValue(channel + "/" + plan.Identifier.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AllowlistEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state AllowlistEntryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", state.Channel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read allowlist", err.Error())
+		return
+	}
+	if !hasAllowEntry(allowFromList(section), state.Identifier.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.ID = types.StringValue(state.Channel.ValueString() + "/" + state.Identifier.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called: both attributes force replacement.
+func (r *AllowlistEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan AllowlistEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AllowlistEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state AllowlistEntryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.setEntry(ctx, state.Channel.ValueString(), state.Identifier.ValueString(), false); err != nil {
+		resp.Diagnostics.AddError("Failed to remove allowlist entry", err.Error())
+		return
+	}
+}
+
+func (r *AllowlistEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, importID, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	// Import ID format: channel/identifier
+	channel, identifier, found := strings.Cut(importID, "/")
+	if !found || channel == "" || identifier == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: channel/identifier")
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", channel)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read allowlist", err.Error())
+		return
+	}
+	if !hasAllowEntry(allowFromList(section), identifier) {
+		resp.Diagnostics.AddError("Allowlist entry not found", fmt.Sprintf("%q is not in channels.%s.allowFrom", identifier, channel))
+		return
+	}
+	state := AllowlistEntryModel{
+		ID:         types.StringValue(importID),
+		Gateway:    gatewayValue(gw),
+		Channel:    types.StringValue(channel),
+		Identifier: types.StringValue(identifier),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// setEntry adds identifier to the channel's allowFrom list, or removes every
+// copy of it, and writes the list back only if that changed it. An emptied
+// list is removed.
+func (r *AllowlistEntryResource) setEntry(ctx context.Context, channel, identifier string, present bool) error {
+	allowFromMu.Lock()
+	defer allowFromMu.Unlock()
+
+	section, hash, err := client.GetNestedSection(ctx, r.client, "channels", channel)
+	if err != nil {
+		return err
+	}
+	current := allowFromList(section)
+	if hasAllowEntry(current, identifier) == present {
+		return nil
+	}
+
+	next := make([]any, 0, len(current)+1)
+	for _, v := range current {
+		if s, ok := allowEntryString(v); !ok || s != identifier {
+			next = append(next, v)
+		}
+	}
+	if present {
+		next = append(next, identifier)
+	}
+	var value any = next
+	if len(next) == 0 {
+		value = nil
+	}
+	return client.PatchNestedSection(ctx, r.client, value, hash, "channels", channel, "allowFrom")
+}
+
+// hasAllowEntry reports whether a channel section's allowFrom holds identifier.
+func hasAllowEntry(allowFrom []any, identifier string) bool {
+	for _, v := range allowFrom {
+		if s, ok := allowEntryString(v); ok && s == identifier {
+			return true
+		}
+	}
+	return false
+}
+
+// allowEntryString returns an allowFrom entry as a string. Numeric user IDs,
+// as Telegram uses, may have been written to the config as numbers.
+func allowEntryString(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+func allowFromList(section map[string]any) []any {
+	list, _ := section["allowFrom"].([]any)
+	return list
+}
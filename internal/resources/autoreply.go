@@ -0,0 +1,232 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &AutoreplyResource{}
+var _ resource.ResourceWithImportState = &AutoreplyResource{}
+var _ resource.ResourceWithValidateConfig = &AutoreplyResource{}
+
+type AutoreplyResource struct {
+	gatewayTarget
+}
+
+type AutoreplyModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Template        types.String `tfsdk:"template"`
+	Channels        types.List   `tfsdk:"channels"`
+	CooldownMinutes types.Int64  `tfsdk:"cooldown_minutes"`
+}
+
+func NewAutoreplyResource() resource.Resource {
+	return &AutoreplyResource{}
+}
+
+func (r *AutoreplyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_autoreply"
+}
+
+func (r *AutoreplyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the away message sent in place of agent replies (autoReply section).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Send the away message instead of running the agent. Default: true.",
+				Optional:    true,
+			},
+			"template": schema.StringAttribute{
+				Description: "Away message text. {{peerName}} and {{channel}} are replaced with the sender's name and channel.",
+				Required:    true,
+			},
+			"channels": schema.ListAttribute{
+				Description: "Channels the away message is sent on (e.g. whatsapp, telegram). All channels when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"cooldown_minutes": schema.Int64Attribute{
+				Description: "Minutes before the same peer gets the away message again. Default: 60.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AutoreplyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig rejects an empty template, repeated channels and a cooldown
+// that is not positive.
+func (r *AutoreplyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AutoreplyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if t := config.Template; !t.IsNull() && !t.IsUnknown() && strings.TrimSpace(t.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("template"), "Empty template",
+			"template must contain the away message text.")
+	}
+	validateListElements(ctx, &resp.Diagnostics, "channels", config.Channels, strings.ToLower, nil)
+	if v := config.CooldownMinutes; !v.IsNull() && !v.IsUnknown() && v.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("cooldown_minutes"), "Invalid limit",
+			fmt.Sprintf("cooldown_minutes must be positive, got %d", v.ValueInt64()))
+	}
+}
+
+func (r *AutoreplyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan AutoreplyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "autoReply", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write autoreply config", err, "autoReply")
+		return
+	}
+
+	plan.ID = types.StringValue("autoreply")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AutoreplyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state AutoreplyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "autoReply")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read autoreply config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("autoreply")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AutoreplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan AutoreplyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "autoReply", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write autoreply config", err, "autoReply")
+		return
+	}
+
+	plan.ID = types.StringValue("autoreply")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AutoreplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "autoReply", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete autoreply config", err.Error())
+		return
+	}
+}
+
+func (r *AutoreplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "autoReply")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import autoreply config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Autoreply not configured", "The config has no autoReply section to import.")
+		return
+	}
+
+	state := AutoreplyModel{Channels: types.ListNull(types.StringType)}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("autoreply")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+// modelToMap patches unset attributes to null, so that removing channels
+// from the configuration sends the away message on every channel again.
+func (r *AutoreplyResource) modelToMap(ctx context.Context, m AutoreplyModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "template", m.Template)
+	setIfStringList(ctx, d, "channels", m.Channels)
+	setIfInt64(d, "cooldownMinutes", m.CooldownMinutes)
+	return withNullKeys(d, "enabled", "channels", "cooldownMinutes")
+}
+
+func (r *AutoreplyResource) mapToModel(ctx context.Context, s map[string]any, m *AutoreplyModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "template", &m.Template)
+	readStringList(ctx, s, "channels", &m.Channels)
+	readFloat64AsInt64(s, "cooldownMinutes", &m.CooldownMinutes)
+}
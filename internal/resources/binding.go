@@ -2,8 +2,8 @@ package resources
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,11 +18,12 @@ var _ resource.Resource = &BindingResource{}
 var _ resource.ResourceWithImportState = &BindingResource{}
 
 type BindingResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type BindingModel struct {
 	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
 	AgentID        types.String `tfsdk:"agent_id"`
 	MatchChannel   types.String `tfsdk:"match_channel"`
 	MatchAccountID types.String `tfsdk:"match_account_id"`
@@ -42,7 +43,8 @@ func (r *BindingResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		Description: "Manages an individual binding entry in bindings[].",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"agent_id": schema.StringAttribute{
 				Description: "Agent ID this binding routes to.",
 				Required:    true,
@@ -76,7 +78,7 @@ func (r *BindingResource) Configure(_ context.Context, req resource.ConfigureReq
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 // ── composite key ────────────────────────────────────────────
@@ -106,13 +108,13 @@ func bindingKeyFromMap(entry map[string]any) string {
 
 // ── helpers for reading/writing the bindings array ───────────
 
-func (r *BindingResource) getBindingsList(ctx context.Context) ([]any, string, error) {
-	cfg, err := r.client.GetConfig(ctx)
+func getBindingsList(ctx context.Context, c client.Client) ([]any, string, error) {
+	cfg, err := c.GetConfig(ctx)
 	if err != nil {
 		return nil, "", fmt.Errorf("reading config: %w", err)
 	}
 
-	parsed, err := parseRawJSONHelper(cfg.Raw)
+	parsed, err := client.ParseConfig(cfg.Raw)
 	if err != nil {
 		return nil, cfg.Hash, err
 	}
@@ -139,21 +141,24 @@ func (r *BindingResource) findBindingIndex(list []any, key string) int {
 	return -1
 }
 
-func (r *BindingResource) writeBindingsList(ctx context.Context, list []any, hash string) error {
+func writeBindingsList(ctx context.Context, c client.Client, list []any, hash string) error {
 	patch := map[string]any{"bindings": list}
-	return r.client.PatchConfig(ctx, patch, hash)
+	return c.PatchConfig(ctx, patch, hash)
 }
 
 // ── CRUD ─────────────────────────────────────────────────────
 
 func (r *BindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan BindingModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	list, hash, err := r.getBindingsList(ctx)
+	list, hash, err := getBindingsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read bindings", err.Error())
 		return
@@ -166,11 +171,12 @@ func (r *BindingResource) Create(ctx context.Context, req resource.CreateRequest
 	if idx >= 0 {
 		list[idx] = entry
 	} else {
+		idx = len(list)
 		list = append(list, entry)
 	}
 
-	if err := r.writeBindingsList(ctx, list, hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write bindings", err.Error())
+	if err := writeBindingsList(ctx, r.client, list, hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write bindings", err, "bindings", strconv.Itoa(idx))
 		return
 	}
 
@@ -179,13 +185,16 @@ func (r *BindingResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *BindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state BindingModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	list, _, err := r.getBindingsList(ctx)
+	list, _, err := getBindingsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read bindings", err.Error())
 		return
@@ -210,13 +219,16 @@ func (r *BindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *BindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan BindingModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	list, hash, err := r.getBindingsList(ctx)
+	list, hash, err := getBindingsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read bindings", err.Error())
 		return
@@ -229,11 +241,12 @@ func (r *BindingResource) Update(ctx context.Context, req resource.UpdateRequest
 	if idx >= 0 {
 		list[idx] = entry
 	} else {
+		idx = len(list)
 		list = append(list, entry)
 	}
 
-	if err := r.writeBindingsList(ctx, list, hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write bindings", err.Error())
+	if err := writeBindingsList(ctx, r.client, list, hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write bindings", err, "bindings", strconv.Itoa(idx))
 		return
 	}
 
@@ -242,13 +255,16 @@ func (r *BindingResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *BindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state BindingModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	list, hash, err := r.getBindingsList(ctx)
+	list, hash, err := getBindingsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read bindings", err.Error())
 		return
@@ -260,15 +276,19 @@ func (r *BindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 		list = append(list[:idx], list[idx+1:]...)
 	}
 
-	if err := r.writeBindingsList(ctx, list, hash); err != nil {
+	if err := writeBindingsList(ctx, r.client, list, hash); err != nil {
 		resp.Diagnostics.AddError("Failed to delete binding", err.Error())
 		return
 	}
 }
 
 func (r *BindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, importID, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	// Import ID format: agentId/channel/accountId
-	parts := strings.SplitN(req.ID, "/", 3)
+	parts := strings.SplitN(importID, "/", 3)
 	if len(parts) < 2 {
 		resp.Diagnostics.AddError("Invalid import ID", "Expected format: agentId/channel or agentId/channel/accountId")
 		return
@@ -282,7 +302,7 @@ func (r *BindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	}
 	key := bindingCompositeKey(agentID, channel, accountID)
 
-	list, _, err := r.getBindingsList(ctx)
+	list, _, err := getBindingsList(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read bindings", err.Error())
 		return
@@ -303,6 +323,7 @@ func (r *BindingResource) ImportState(ctx context.Context, req resource.ImportSt
 	var state BindingModel
 	r.mapToModel(entry, &state)
 	state.ID = types.StringValue(key)
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -344,12 +365,3 @@ func (r *BindingResource) mapToModel(s map[string]any, m *BindingModel) {
 		}
 	}
 }
-
-// parseRawJSONHelper is a local helper to parse raw JSON config.
-func parseRawJSONHelper(raw string) (map[string]any, error) {
-	var result map[string]any
-	if err := json.Unmarshal([]byte(raw), &result); err != nil {
-		return nil, fmt.Errorf("json unmarshal: %w", err)
-	}
-	return result, nil
-}
@@ -0,0 +1,281 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &BindingSetResource{}
+var _ resource.ResourceWithImportState = &BindingSetResource{}
+var _ resource.ResourceWithValidateConfig = &BindingSetResource{}
+
+type BindingSetResource struct {
+	gatewayTarget
+}
+
+type BindingSetModel struct {
+	ID       types.String           `tfsdk:"id"`
+	Gateway  types.String           `tfsdk:"gateway"`
+	Bindings []BindingSetEntryModel `tfsdk:"bindings"`
+}
+
+type BindingSetEntryModel struct {
+	AgentID types.String         `tfsdk:"agent_id"`
+	Match   BindingSetMatchModel `tfsdk:"match"`
+}
+
+type BindingSetMatchModel struct {
+	Channel   types.String `tfsdk:"channel"`
+	AccountID types.String `tfsdk:"account_id"`
+	PeerKind  types.String `tfsdk:"peer_kind"`
+	PeerID    types.String `tfsdk:"peer_id"`
+}
+
+func NewBindingSetResource() resource.Resource {
+	return &BindingSetResource{}
+}
+
+func (r *BindingSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_binding_set"
+}
+
+func (r *BindingSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the whole bindings[] array, in order. Do not combine with openclaw_binding.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"bindings": schema.ListNestedAttribute{
+				Description: "Bindings in priority order: a message is routed by the first binding that matches it.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"agent_id": schema.StringAttribute{
+							Description: "Agent ID this binding routes to.",
+							Required:    true,
+						},
+						"match": schema.SingleNestedAttribute{
+							Description: "Messages this binding applies to.",
+							Required:    true,
+							Attributes: map[string]schema.Attribute{
+								"channel": schema.StringAttribute{
+									Description: "Channel to match (e.g. discord, telegram, whatsapp).",
+									Required:    true,
+								},
+								"account_id": schema.StringAttribute{
+									Description: "Account ID to match.",
+									Optional:    true,
+								},
+								"peer_kind": schema.StringAttribute{
+									Description: "Peer kind to match (e.g. dm, group).",
+									Optional:    true,
+								},
+								"peer_id": schema.StringAttribute{
+									Description: "Peer ID to match.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *BindingSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig rejects a binding whose match repeats an earlier one: the
+// earlier binding always wins, so the later one would never apply.
+func (r *BindingSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var bindings types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bindings"), &bindings)...)
+	if resp.Diagnostics.HasError() || bindings.IsNull() || bindings.IsUnknown() {
+		return
+	}
+	var objects []types.Object
+	resp.Diagnostics.Append(bindings.ElementsAs(ctx, &objects, false)...)
+
+	seen := make(map[BindingSetMatchModel]int, len(objects))
+	for i, obj := range objects {
+		var entry BindingSetEntryModel
+		resp.Diagnostics.Append(obj.As(ctx, &entry, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		m := entry.Match
+		if obj.IsUnknown() || m.Channel.IsUnknown() || m.AccountID.IsUnknown() || m.PeerKind.IsUnknown() || m.PeerID.IsUnknown() {
+			continue
+		}
+		if j, ok := seen[m]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("bindings").AtListIndex(i).AtName("match"), "Unreachable binding",
+				fmt.Sprintf("bindings[%d] has the same match as bindings[%d], which takes priority, so it would never apply.", i, j))
+			continue
+		}
+		seen[m] = i
+	}
+}
+
+func (r *BindingSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan BindingSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := writeBindingsList(ctx, r.client, r.modelToList(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write bindings", err, "bindings")
+		return
+	}
+
+	plan.ID = types.StringValue("bindings")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BindingSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state BindingSetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, _, err := getBindingsList(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read bindings", err.Error())
+		return
+	}
+	if list == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Bindings = r.listToModel(list)
+	state.ID = types.StringValue("bindings")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BindingSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan BindingSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := writeBindingsList(ctx, r.client, r.modelToList(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write bindings", err, "bindings")
+		return
+	}
+
+	plan.ID = types.StringValue("bindings")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BindingSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := writeBindingsList(ctx, r.client, nil, cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete bindings", err.Error())
+		return
+	}
+}
+
+func (r *BindingSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	list, _, err := getBindingsList(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import bindings", err.Error())
+		return
+	}
+
+	state := BindingSetModel{Bindings: r.listToModel(list)}
+	state.ID = types.StringValue("bindings")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ list conversion ─────────────────────────────────
+
+// modelToList writes each binding in the layout openclaw_binding uses.
+func (r *BindingSetResource) modelToList(m BindingSetModel) []any {
+	var binding BindingResource
+	list := make([]any, 0, len(m.Bindings))
+	for _, b := range m.Bindings {
+		list = append(list, binding.modelToMap(BindingModel{
+			AgentID:        b.AgentID,
+			MatchChannel:   b.Match.Channel,
+			MatchAccountID: b.Match.AccountID,
+			MatchPeerKind:  b.Match.PeerKind,
+			MatchPeerID:    b.Match.PeerID,
+		}))
+	}
+	return list
+}
+
+func (r *BindingSetResource) listToModel(list []any) []BindingSetEntryModel {
+	var binding BindingResource
+	bindings := make([]BindingSetEntryModel, 0, len(list))
+	for _, item := range list {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		var b BindingModel
+		binding.mapToModel(entry, &b)
+		bindings = append(bindings, BindingSetEntryModel{
+			AgentID: b.AgentID,
+			Match: BindingSetMatchModel{
+				Channel:   b.MatchChannel,
+				AccountID: b.MatchAccountID,
+				PeerKind:  b.MatchPeerKind,
+				PeerID:    b.MatchPeerID,
+			},
+		})
+	}
+	return bindings
+}
@@ -0,0 +1,237 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &BrowserResource{}
+var _ resource.ResourceWithImportState = &BrowserResource{}
+
+type BrowserResource struct {
+	gatewayTarget
+}
+
+type BrowserModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Gateway             types.String `tfsdk:"gateway"`
+	Headless            types.Bool   `tfsdk:"headless"`
+	ExecutablePath      types.String `tfsdk:"executable_path"`
+	ProfileDir          types.String `tfsdk:"profile_dir"`
+	AllowedDomains      types.List   `tfsdk:"allowed_domains"`
+	DownloadDir         types.String `tfsdk:"download_dir"`
+	MaxScreenshots      types.Int64  `tfsdk:"max_screenshots"`
+	ScreenshotMaxWidth  types.Int64  `tfsdk:"screenshot_max_width"`
+	ScreenshotMaxHeight types.Int64  `tfsdk:"screenshot_max_height"`
+}
+
+// browserKeys are the tools.browser settings this resource manages.
+// tools.browser.enabled belongs to openclaw_tools.
+var browserKeys = []string{"headless", "executablePath", "profileDir", "allowedDomains", "downloadDir", "screenshots"}
+
+func NewBrowserResource() resource.Resource {
+	return &BrowserResource{}
+}
+
+func (r *BrowserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_browser"
+}
+
+func (r *BrowserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw browser tool configuration under tools.browser.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"headless": schema.BoolAttribute{
+				Description: "Run the browser without a visible window.",
+				Optional:    true,
+			},
+			"executable_path": schema.StringAttribute{
+				Description: "Path of the Chromium-based browser to launch. The bundled browser is used when unset.",
+				Optional:    true,
+			},
+			"profile_dir": schema.StringAttribute{
+				Description: "Directory holding the browser profile (cookies, logins).",
+				Optional:    true,
+			},
+			"allowed_domains": schema.ListAttribute{
+				Description: "Domains the browser may visit. All domains are allowed when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"download_dir": schema.StringAttribute{
+				Description: "Directory downloads are saved to.",
+				Optional:    true,
+			},
+			"max_screenshots": schema.Int64Attribute{
+				Description: "Maximum screenshots taken per agent run.",
+				Optional:    true,
+			},
+			"screenshot_max_width": schema.Int64Attribute{
+				Description: "Maximum screenshot width in pixels. Larger screenshots are scaled down.",
+				Optional:    true,
+			},
+			"screenshot_max_height": schema.Int64Attribute{
+				Description: "Maximum screenshot height in pixels. Larger screenshots are scaled down.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *BrowserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *BrowserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan BrowserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "tools", "browser"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write browser config", err, "tools", "browser")
+		return
+	}
+	plan.ID = types.StringValue("browser")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BrowserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state BrowserModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "tools", "browser")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read browser config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("browser")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BrowserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan BrowserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "tools", "browser"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write browser config", err, "tools", "browser")
+		return
+	}
+	plan.ID = types.StringValue("browser")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BrowserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	unset := make(map[string]any, len(browserKeys))
+	for _, k := range browserKeys {
+		unset[k] = nil
+	}
+	if err := client.PatchNestedSection(ctx, r.client, unset, cfg.Hash, "tools", "browser"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete browser config", err.Error())
+		return
+	}
+}
+
+func (r *BrowserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "tools", "browser")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import browser config", err.Error())
+		return
+	}
+	state := BrowserModel{AllowedDomains: types.ListNull(types.StringType)}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("browser")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BrowserResource) modelToMap(ctx context.Context, m BrowserModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "headless", m.Headless)
+	setIfString(d, "executablePath", m.ExecutablePath)
+	setIfString(d, "profileDir", m.ProfileDir)
+	setIfStringList(ctx, d, "allowedDomains", m.AllowedDomains)
+	setIfString(d, "downloadDir", m.DownloadDir)
+
+	screenshots := make(map[string]any)
+	setIfInt64(screenshots, "maxPerRun", m.MaxScreenshots)
+	setIfInt64(screenshots, "maxWidth", m.ScreenshotMaxWidth)
+	setIfInt64(screenshots, "maxHeight", m.ScreenshotMaxHeight)
+	if len(screenshots) > 0 {
+		d["screenshots"] = screenshots
+	}
+	return d
+}
+
+func (r *BrowserResource) mapToModel(ctx context.Context, s map[string]any, m *BrowserModel) {
+	readBool(s, "headless", &m.Headless)
+	readString(s, "executablePath", &m.ExecutablePath)
+	readString(s, "profileDir", &m.ProfileDir)
+	readStringList(ctx, s, "allowedDomains", &m.AllowedDomains)
+	readString(s, "downloadDir", &m.DownloadDir)
+
+	if screenshots, ok := s["screenshots"].(map[string]any); ok {
+		readFloat64AsInt64(screenshots, "maxPerRun", &m.MaxScreenshots)
+		readFloat64AsInt64(screenshots, "maxWidth", &m.ScreenshotMaxWidth)
+		readFloat64AsInt64(screenshots, "maxHeight", &m.ScreenshotMaxHeight)
+	}
+}
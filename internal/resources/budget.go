@@ -0,0 +1,268 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &BudgetResource{}
+var _ resource.ResourceWithImportState = &BudgetResource{}
+var _ resource.ResourceWithValidateConfig = &BudgetResource{}
+
+type BudgetResource struct {
+	gatewayTarget
+}
+
+type BudgetModel struct {
+	ID               types.String  `tfsdk:"id"`
+	Gateway          types.String  `tfsdk:"gateway"`
+	MonthlyUSD       types.Float64 `tfsdk:"monthly_usd"`
+	AgentCaps        types.Map     `tfsdk:"agent_caps"`
+	ChannelCaps      types.Map     `tfsdk:"channel_caps"`
+	ActionOnExceeded types.String  `tfsdk:"action_on_exceeded"`
+	FallbackModel    types.String  `tfsdk:"fallback_model"`
+}
+
+func NewBudgetResource() resource.Resource {
+	return &BudgetResource{}
+}
+
+func (r *BudgetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_budget"
+}
+
+func (r *BudgetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw spend limits (budget section).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"monthly_usd": schema.Float64Attribute{
+				Description: "Total spend allowed per calendar month, in USD.",
+				Optional:    true,
+			},
+			"agent_caps": schema.MapAttribute{
+				Description: "Monthly spend allowed per agent, in USD, keyed by agent ID.",
+				Optional:    true,
+				ElementType: types.Float64Type,
+			},
+			"channel_caps": schema.MapAttribute{
+				Description: "Monthly spend allowed per channel, in USD, keyed by channel name.",
+				Optional:    true,
+				ElementType: types.Float64Type,
+			},
+			"action_on_exceeded": schema.StringAttribute{
+				Description: "What happens once a cap is reached: warn|block|fallback-model.",
+				Optional:    true,
+			},
+			"fallback_model": schema.StringAttribute{
+				Description: "Model used once a cap is reached. Required when action_on_exceeded is fallback-model.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *BudgetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig rejects negative caps and an action the gateway does not
+// know, and requires fallback_model exactly when it is used.
+func (r *BudgetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BudgetModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.MonthlyUSD; !v.IsNull() && !v.IsUnknown() && v.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("monthly_usd"), "Invalid cap",
+			fmt.Sprintf("monthly_usd must not be negative, got %g", v.ValueFloat64()))
+	}
+	for _, attr := range []struct {
+		name string
+		caps types.Map
+	}{{"agent_caps", config.AgentCaps}, {"channel_caps", config.ChannelCaps}} {
+		if attr.caps.IsNull() || attr.caps.IsUnknown() {
+			continue
+		}
+		var caps map[string]types.Float64
+		resp.Diagnostics.Append(attr.caps.ElementsAs(ctx, &caps, false)...)
+		for key, v := range caps {
+			if !v.IsNull() && !v.IsUnknown() && v.ValueFloat64() < 0 {
+				resp.Diagnostics.AddAttributeError(path.Root(attr.name).AtMapKey(key), "Invalid cap",
+					fmt.Sprintf("%s[%q] must not be negative, got %g", attr.name, key, v.ValueFloat64()))
+			}
+		}
+	}
+
+	if config.ActionOnExceeded.IsUnknown() {
+		return
+	}
+	switch action := config.ActionOnExceeded.ValueString(); action {
+	case "fallback-model":
+		if config.FallbackModel.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("fallback_model"), "Missing fallback model",
+				"Set fallback_model when action_on_exceeded is \"fallback-model\".")
+		}
+	case "", "warn", "block":
+		if !config.FallbackModel.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("fallback_model"), "Unused fallback model",
+				"fallback_model is only used when action_on_exceeded is \"fallback-model\".")
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("action_on_exceeded"), "Invalid action",
+			fmt.Sprintf("action_on_exceeded must be warn, block or fallback-model, got %q", action))
+	}
+}
+
+func (r *BudgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan BudgetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "budget", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write budget config", err, "budget")
+		return
+	}
+
+	plan.ID = types.StringValue("budget")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BudgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state BudgetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "budget")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read budget config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("budget")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BudgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan BudgetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "budget", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write budget config", err, "budget")
+		return
+	}
+
+	plan.ID = types.StringValue("budget")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BudgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "budget", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete budget config", err.Error())
+		return
+	}
+}
+
+func (r *BudgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "budget")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import budget config", err.Error())
+		return
+	}
+
+	state := BudgetModel{
+		AgentCaps:   types.MapNull(types.Float64Type),
+		ChannelCaps: types.MapNull(types.Float64Type),
+	}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("budget")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+func (r *BudgetResource) modelToMap(ctx context.Context, m BudgetModel) map[string]any {
+	d := make(map[string]any)
+	setIfFloat64(d, "monthlyUsd", m.MonthlyUSD)
+	setIfFloat64Map(ctx, d, "perAgent", m.AgentCaps)
+	setIfFloat64Map(ctx, d, "perChannel", m.ChannelCaps)
+	setIfString(d, "onExceeded", m.ActionOnExceeded)
+	setIfString(d, "fallbackModel", m.FallbackModel)
+	return d
+}
+
+func (r *BudgetResource) mapToModel(ctx context.Context, s map[string]any, m *BudgetModel) {
+	readFloat64(s, "monthlyUsd", &m.MonthlyUSD)
+	readFloat64Map(ctx, s, "perAgent", &m.AgentCaps)
+	readFloat64Map(ctx, s, "perChannel", &m.ChannelCaps)
+	readString(s, "onExceeded", &m.ActionOnExceeded)
+	readString(s, "fallbackModel", &m.FallbackModel)
+}
@@ -19,11 +19,12 @@ var _ resource.Resource = &ChannelDiscordResource{}
 var _ resource.ResourceWithImportState = &ChannelDiscordResource{}
 
 type ChannelDiscordResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ChannelDiscordModel struct {
 	ID               types.String `tfsdk:"id"`
+	Gateway          types.String `tfsdk:"gateway"`
 	Enabled          types.Bool   `tfsdk:"enabled"`
 	Token            types.String `tfsdk:"token"`
 	DmPolicy         types.String `tfsdk:"dm_policy"`
@@ -53,7 +54,8 @@ func (r *ChannelDiscordResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw Discord channel configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Enable or disable the Discord channel.",
 				Optional:    true,
@@ -143,10 +145,13 @@ func (r *ChannelDiscordResource) Configure(_ context.Context, req resource.Confi
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *ChannelDiscordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelDiscordModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -158,7 +163,7 @@ func (r *ChannelDiscordResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "discord"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Discord config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Discord config", err, "channels", "discord")
 		return
 	}
 	plan.ID = types.StringValue("channel_discord")
@@ -166,6 +171,9 @@ func (r *ChannelDiscordResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *ChannelDiscordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state ChannelDiscordModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -186,6 +194,9 @@ func (r *ChannelDiscordResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *ChannelDiscordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelDiscordModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -197,14 +208,17 @@ func (r *ChannelDiscordResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "discord"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Discord config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Discord config", err, "channels", "discord")
 		return
 	}
 	plan.ID = types.StringValue("channel_discord")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *ChannelDiscordResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *ChannelDiscordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
@@ -216,7 +230,11 @@ func (r *ChannelDiscordResource) Delete(ctx context.Context, _ resource.DeleteRe
 	}
 }
 
-func (r *ChannelDiscordResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *ChannelDiscordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "discord")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import Discord config", err.Error())
@@ -224,9 +242,11 @@ func (r *ChannelDiscordResource) ImportState(ctx context.Context, _ resource.Imp
 	}
 	var state ChannelDiscordModel
 	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("channel_discord")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -260,7 +280,7 @@ func (r *ChannelDiscordResource) mapToModel(ctx context.Context, s map[string]an
 	readBool(s, "enabled", &m.Enabled)
 	// Don't read back token
 	readString(s, "dmPolicy", &m.DmPolicy)
-	readStringList(ctx, s, "allowFrom", &m.AllowFrom)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
 	readBool(s, "allowBots", &m.AllowBots)
 	readFloat64AsInt64(s, "mediaMaxMb", &m.MediaMaxMb)
 	readFloat64AsInt64(s, "textChunkLimit", &m.TextChunkLimit)
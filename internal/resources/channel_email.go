@@ -0,0 +1,289 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelEmailResource{}
+var _ resource.ResourceWithImportState = &ChannelEmailResource{}
+
+type ChannelEmailResource struct {
+	gatewayTarget
+}
+
+type ChannelEmailModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Address         types.String `tfsdk:"address"`
+	IMAPHost        types.String `tfsdk:"imap_host"`
+	IMAPPort        types.Int64  `tfsdk:"imap_port"`
+	IMAPUsername    types.String `tfsdk:"imap_username"`
+	IMAPPassword    types.String `tfsdk:"imap_password"`
+	SMTPHost        types.String `tfsdk:"smtp_host"`
+	SMTPPort        types.Int64  `tfsdk:"smtp_port"`
+	SMTPUsername    types.String `tfsdk:"smtp_username"`
+	SMTPPassword    types.String `tfsdk:"smtp_password"`
+	PollInterval    types.String `tfsdk:"poll_interval"`
+	AllowFrom       types.List   `tfsdk:"allow_from"`
+	SubjectPrefix   types.String `tfsdk:"subject_prefix"`
+	AttachmentMaxMb types.Int64  `tfsdk:"attachment_max_mb"`
+}
+
+func NewChannelEmailResource() resource.Resource {
+	return &ChannelEmailResource{}
+}
+
+func (r *ChannelEmailResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_email"
+}
+
+func (r *ChannelEmailResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw email channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the email channel.",
+				Optional:    true,
+			},
+			"address": schema.StringAttribute{
+				Description: "Email address the agent receives mail at and sends from.",
+				Optional:    true,
+			},
+			"imap_host": schema.StringAttribute{
+				Description: "IMAP server for inbound mail.",
+				Optional:    true,
+			},
+			"imap_port": schema.Int64Attribute{
+				Description: "IMAP server port (implicit TLS). Default: 993.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(993),
+			},
+			"imap_username": schema.StringAttribute{
+				Description: "IMAP username. Defaults to address.",
+				Optional:    true,
+			},
+			"imap_password": schema.StringAttribute{
+				Description: "IMAP password. Sensitive. Falls back to EMAIL_IMAP_PASSWORD.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"smtp_host": schema.StringAttribute{
+				Description: "SMTP server for outbound mail.",
+				Optional:    true,
+			},
+			"smtp_port": schema.Int64Attribute{
+				Description: "SMTP server port (STARTTLS). Default: 587.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(587),
+			},
+			"smtp_username": schema.StringAttribute{
+				Description: "SMTP username. Defaults to address.",
+				Optional:    true,
+			},
+			"smtp_password": schema.StringAttribute{
+				Description: "SMTP password. Sensitive. Falls back to EMAIL_SMTP_PASSWORD.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often the inbox is checked for new mail, as a duration string. Default: 1m.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("1m"),
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "Sender addresses (or @domain suffixes) the agent accepts mail from.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"subject_prefix": schema.StringAttribute{
+				Description: "Prefix added to the subject of outgoing mail (e.g. [OpenClaw]).",
+				Optional:    true,
+			},
+			"attachment_max_mb": schema.Int64Attribute{
+				Description: "Max size of an inbound attachment in MB. Default: 20.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(20),
+			},
+		},
+	}
+}
+
+func (r *ChannelEmailResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelEmailModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "email"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write email config", err, "channels", "email")
+		return
+	}
+	plan.ID = types.StringValue("channel_email")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelEmailModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "email")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read email config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_email")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelEmailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelEmailModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "email"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write email config", err, "channels", "email")
+		return
+	}
+	plan.ID = types.StringValue("channel_email")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelEmailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "email"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete email config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "email")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import email config", err.Error())
+		return
+	}
+	state := ChannelEmailModel{AllowFrom: types.ListNull(types.StringType)}
+	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_email")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelEmailResource) modelToMap(ctx context.Context, m ChannelEmailModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "address", m.Address)
+	setIfString(d, "pollInterval", m.PollInterval)
+	setIfStringList(ctx, d, "allowFrom", m.AllowFrom)
+	setIfString(d, "subjectPrefix", m.SubjectPrefix)
+	setIfInt64(d, "attachmentMaxMb", m.AttachmentMaxMb)
+
+	imap := make(map[string]any)
+	setIfString(imap, "host", m.IMAPHost)
+	setIfInt64(imap, "port", m.IMAPPort)
+	setIfString(imap, "username", m.IMAPUsername)
+	setIfString(imap, "password", m.IMAPPassword)
+	if len(imap) > 0 {
+		d["imap"] = imap
+	}
+
+	smtp := make(map[string]any)
+	setIfString(smtp, "host", m.SMTPHost)
+	setIfInt64(smtp, "port", m.SMTPPort)
+	setIfString(smtp, "username", m.SMTPUsername)
+	setIfString(smtp, "password", m.SMTPPassword)
+	if len(smtp) > 0 {
+		d["smtp"] = smtp
+	}
+	return d
+}
+
+func (r *ChannelEmailResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelEmailModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "address", &m.Address)
+	readString(s, "pollInterval", &m.PollInterval)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
+	readString(s, "subjectPrefix", &m.SubjectPrefix)
+	readFloat64AsInt64(s, "attachmentMaxMb", &m.AttachmentMaxMb)
+
+	if imap, ok := s["imap"].(map[string]any); ok {
+		readString(imap, "host", &m.IMAPHost)
+		readFloat64AsInt64(imap, "port", &m.IMAPPort)
+		readString(imap, "username", &m.IMAPUsername)
+	}
+	if smtp, ok := s["smtp"].(map[string]any); ok {
+		readString(smtp, "host", &m.SMTPHost)
+		readFloat64AsInt64(smtp, "port", &m.SMTPPort)
+		readString(smtp, "username", &m.SMTPUsername)
+	}
+}
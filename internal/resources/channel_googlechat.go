@@ -18,11 +18,12 @@ var _ resource.Resource = &ChannelGoogleChatResource{}
 var _ resource.ResourceWithImportState = &ChannelGoogleChatResource{}
 
 type ChannelGoogleChatResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ChannelGoogleChatModel struct {
 	ID          types.String `tfsdk:"id"`
+	Gateway     types.String `tfsdk:"gateway"`
 	Enabled     types.Bool   `tfsdk:"enabled"`
 	WebhookPath types.String `tfsdk:"webhook_path"`
 	BotUser     types.String `tfsdk:"bot_user"`
@@ -44,7 +45,8 @@ func (r *ChannelGoogleChatResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw Google Chat channel configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Enable or disable the Google Chat channel.",
 				Optional:    true,
@@ -93,10 +95,13 @@ func (r *ChannelGoogleChatResource) Configure(_ context.Context, req resource.Co
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *ChannelGoogleChatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelGoogleChatModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -108,7 +113,7 @@ func (r *ChannelGoogleChatResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "googlechat"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Google Chat config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Google Chat config", err, "channels", "googlechat")
 		return
 	}
 	plan.ID = types.StringValue("channel_googlechat")
@@ -116,6 +121,9 @@ func (r *ChannelGoogleChatResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *ChannelGoogleChatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state ChannelGoogleChatModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -136,6 +144,9 @@ func (r *ChannelGoogleChatResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *ChannelGoogleChatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelGoogleChatModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -147,14 +158,17 @@ func (r *ChannelGoogleChatResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "googlechat"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Google Chat config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Google Chat config", err, "channels", "googlechat")
 		return
 	}
 	plan.ID = types.StringValue("channel_googlechat")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *ChannelGoogleChatResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *ChannelGoogleChatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
@@ -166,7 +180,11 @@ func (r *ChannelGoogleChatResource) Delete(ctx context.Context, _ resource.Delet
 	}
 }
 
-func (r *ChannelGoogleChatResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *ChannelGoogleChatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "googlechat")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import Google Chat config", err.Error())
@@ -177,6 +195,7 @@ func (r *ChannelGoogleChatResource) ImportState(ctx context.Context, _ resource.
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("channel_googlechat")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -18,11 +18,12 @@ var _ resource.Resource = &ChannelIMessageResource{}
 var _ resource.ResourceWithImportState = &ChannelIMessageResource{}
 
 type ChannelIMessageResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ChannelIMessageModel struct {
 	ID           types.String `tfsdk:"id"`
+	Gateway      types.String `tfsdk:"gateway"`
 	Enabled      types.Bool   `tfsdk:"enabled"`
 	DmPolicy     types.String `tfsdk:"dm_policy"`
 	AllowFrom    types.List   `tfsdk:"allow_from"`
@@ -44,7 +45,8 @@ func (r *ChannelIMessageResource) Schema(_ context.Context, _ resource.SchemaReq
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw iMessage channel configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Enable or disable the iMessage channel.",
 				Optional:    true,
@@ -93,10 +95,13 @@ func (r *ChannelIMessageResource) Configure(_ context.Context, req resource.Conf
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *ChannelIMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelIMessageModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -108,7 +113,7 @@ func (r *ChannelIMessageResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "imessage"); err != nil {
-		resp.Diagnostics.AddError("Failed to write iMessage config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write iMessage config", err, "channels", "imessage")
 		return
 	}
 	plan.ID = types.StringValue("channel_imessage")
@@ -116,6 +121,9 @@ func (r *ChannelIMessageResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *ChannelIMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state ChannelIMessageModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -136,6 +144,9 @@ func (r *ChannelIMessageResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *ChannelIMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelIMessageModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -147,14 +158,17 @@ func (r *ChannelIMessageResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "imessage"); err != nil {
-		resp.Diagnostics.AddError("Failed to write iMessage config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write iMessage config", err, "channels", "imessage")
 		return
 	}
 	plan.ID = types.StringValue("channel_imessage")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *ChannelIMessageResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *ChannelIMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
@@ -166,7 +180,11 @@ func (r *ChannelIMessageResource) Delete(ctx context.Context, _ resource.DeleteR
 	}
 }
 
-func (r *ChannelIMessageResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *ChannelIMessageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "imessage")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import iMessage config", err.Error())
@@ -174,9 +192,11 @@ func (r *ChannelIMessageResource) ImportState(ctx context.Context, _ resource.Im
 	}
 	var state ChannelIMessageModel
 	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("channel_imessage")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -195,7 +215,7 @@ func (r *ChannelIMessageResource) modelToMap(ctx context.Context, m ChannelIMess
 func (r *ChannelIMessageResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelIMessageModel) {
 	readBool(s, "enabled", &m.Enabled)
 	readString(s, "dmPolicy", &m.DmPolicy)
-	readStringList(ctx, s, "allowFrom", &m.AllowFrom)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
 	readFloat64AsInt64(s, "historyLimit", &m.HistoryLimit)
 	readFloat64AsInt64(s, "mediaMaxMb", &m.MediaMaxMb)
 	readString(s, "service", &m.Service)
@@ -0,0 +1,251 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelIRCResource{}
+var _ resource.ResourceWithImportState = &ChannelIRCResource{}
+
+type ChannelIRCResource struct {
+	gatewayTarget
+}
+
+type ChannelIRCModel struct {
+	ID               types.String `tfsdk:"id"`
+	Gateway          types.String `tfsdk:"gateway"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Server           types.String `tfsdk:"server"`
+	Port             types.Int64  `tfsdk:"port"`
+	TLS              types.Bool   `tfsdk:"tls"`
+	Nick             types.String `tfsdk:"nick"`
+	NickServPassword types.String `tfsdk:"nickserv_password"`
+	Channels         types.List   `tfsdk:"channels"`
+	DmPolicy         types.String `tfsdk:"dm_policy"`
+	AllowFrom        types.List   `tfsdk:"allow_from"`
+	LineDelayMs      types.Int64  `tfsdk:"line_delay_ms"`
+}
+
+func NewChannelIRCResource() resource.Resource {
+	return &ChannelIRCResource{}
+}
+
+func (r *ChannelIRCResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_irc"
+}
+
+func (r *ChannelIRCResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw IRC channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the IRC channel.",
+				Optional:    true,
+			},
+			"server": schema.StringAttribute{
+				Description: "IRC server hostname (e.g. irc.libera.chat).",
+				Optional:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "IRC server port. Default: 6697.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(6697),
+			},
+			"tls": schema.BoolAttribute{
+				Description: "Connect over TLS. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"nick": schema.StringAttribute{
+				Description: "Nickname of the bot.",
+				Optional:    true,
+			},
+			"nickserv_password": schema.StringAttribute{
+				Description: "Password used to identify the nick with NickServ. Sensitive. Falls back to IRC_NICKSERV_PASSWORD.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"channels": schema.ListAttribute{
+				Description: "Channels the bot joins and responds in (e.g. #ops).",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"dm_policy": schema.StringAttribute{
+				Description: "DM policy: pairing (default), allowlist, open, disabled.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("pairing"),
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "Nicks allowed to message the bot.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"line_delay_ms": schema.Int64Attribute{
+				Description: "Minimum delay between outgoing lines in milliseconds, to stay under the server's flood limit. Default: 1000.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1000),
+			},
+		},
+	}
+}
+
+func (r *ChannelIRCResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelIRCResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelIRCModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "irc"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write IRC config", err, "channels", "irc")
+		return
+	}
+	plan.ID = types.StringValue("channel_irc")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelIRCResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelIRCModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "irc")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read IRC config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_irc")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelIRCResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelIRCModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "irc"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write IRC config", err, "channels", "irc")
+		return
+	}
+	plan.ID = types.StringValue("channel_irc")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelIRCResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "irc"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete IRC config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelIRCResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "irc")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import IRC config", err.Error())
+		return
+	}
+	state := ChannelIRCModel{
+		Channels:  types.ListNull(types.StringType),
+		AllowFrom: types.ListNull(types.StringType),
+	}
+	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_irc")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelIRCResource) modelToMap(ctx context.Context, m ChannelIRCModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "server", m.Server)
+	setIfInt64(d, "port", m.Port)
+	setIfBool(d, "tls", m.TLS)
+	setIfString(d, "nick", m.Nick)
+	setIfString(d, "nickservPassword", m.NickServPassword)
+	setIfStringList(ctx, d, "channels", m.Channels)
+	setIfString(d, "dmPolicy", m.DmPolicy)
+	setIfStringList(ctx, d, "allowFrom", m.AllowFrom)
+	setIfInt64(d, "lineDelayMs", m.LineDelayMs)
+	return d
+}
+
+func (r *ChannelIRCResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelIRCModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "server", &m.Server)
+	readFloat64AsInt64(s, "port", &m.Port)
+	readBool(s, "tls", &m.TLS)
+	readString(s, "nick", &m.Nick)
+	readStringList(ctx, s, "channels", &m.Channels)
+	readString(s, "dmPolicy", &m.DmPolicy)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
+	readFloat64AsInt64(s, "lineDelayMs", &m.LineDelayMs)
+}
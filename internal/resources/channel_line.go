@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelLINEResource{}
+var _ resource.ResourceWithImportState = &ChannelLINEResource{}
+
+type ChannelLINEResource struct {
+	gatewayTarget
+}
+
+type ChannelLINEModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Gateway            types.String `tfsdk:"gateway"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	ChannelAccessToken types.String `tfsdk:"channel_access_token"`
+	ChannelSecret      types.String `tfsdk:"channel_secret"`
+	DmPolicy           types.String `tfsdk:"dm_policy"`
+	AllowFrom          types.List   `tfsdk:"allow_from"`
+	RichMessages       types.Bool   `tfsdk:"rich_messages"`
+}
+
+func NewChannelLINEResource() resource.Resource {
+	return &ChannelLINEResource{}
+}
+
+func (r *ChannelLINEResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_line"
+}
+
+func (r *ChannelLINEResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw LINE channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the LINE channel.",
+				Optional:    true,
+			},
+			"channel_access_token": schema.StringAttribute{
+				Description: "Messaging API channel access token. Sensitive. Falls back to LINE_CHANNEL_ACCESS_TOKEN.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"channel_secret": schema.StringAttribute{
+				Description: "Channel secret used to verify webhook signatures. Sensitive. Falls back to LINE_CHANNEL_SECRET.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"dm_policy": schema.StringAttribute{
+				Description: "DM policy: pairing (default), allowlist, open, disabled.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("pairing"),
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "LINE user IDs allowed to message the bot.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"rich_messages": schema.BoolAttribute{
+				Description: "Send replies as Flex Messages where formatting calls for it. Plain text only when false. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *ChannelLINEResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelLINEResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelLINEModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "line"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write LINE config", err, "channels", "line")
+		return
+	}
+	plan.ID = types.StringValue("channel_line")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelLINEResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelLINEModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "line")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read LINE config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_line")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelLINEResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelLINEModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "line"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write LINE config", err, "channels", "line")
+		return
+	}
+	plan.ID = types.StringValue("channel_line")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelLINEResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "line"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete LINE config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelLINEResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "line")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import LINE config", err.Error())
+		return
+	}
+	state := ChannelLINEModel{AllowFrom: types.ListNull(types.StringType)}
+	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_line")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelLINEResource) modelToMap(ctx context.Context, m ChannelLINEModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "channelAccessToken", m.ChannelAccessToken)
+	setIfString(d, "channelSecret", m.ChannelSecret)
+	setIfString(d, "dmPolicy", m.DmPolicy)
+	setIfStringList(ctx, d, "allowFrom", m.AllowFrom)
+	setIfBool(d, "richMessages", m.RichMessages)
+	return d
+}
+
+func (r *ChannelLINEResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelLINEModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "dmPolicy", &m.DmPolicy)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
+	readBool(s, "richMessages", &m.RichMessages)
+}
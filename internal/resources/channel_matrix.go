@@ -0,0 +1,239 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelMatrixResource{}
+var _ resource.ResourceWithImportState = &ChannelMatrixResource{}
+
+type ChannelMatrixResource struct {
+	gatewayTarget
+}
+
+type ChannelMatrixModel struct {
+	ID            types.String `tfsdk:"id"`
+	Gateway       types.String `tfsdk:"gateway"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	HomeserverURL types.String `tfsdk:"homeserver_url"`
+	AccessToken   types.String `tfsdk:"access_token"`
+	UserID        types.String `tfsdk:"user_id"`
+	DmPolicy      types.String `tfsdk:"dm_policy"`
+	AllowFrom     types.List   `tfsdk:"allow_from"`
+	Rooms         types.List   `tfsdk:"rooms"`
+	Encryption    types.Bool   `tfsdk:"encryption"`
+	HistoryLimit  types.Int64  `tfsdk:"history_limit"`
+}
+
+func NewChannelMatrixResource() resource.Resource {
+	return &ChannelMatrixResource{}
+}
+
+func (r *ChannelMatrixResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_matrix"
+}
+
+func (r *ChannelMatrixResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw Matrix channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the Matrix channel.",
+				Optional:    true,
+			},
+			"homeserver_url": schema.StringAttribute{
+				Description: "Homeserver URL (e.g. https://matrix.org).",
+				Optional:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "Access token of the bot account. Sensitive. Falls back to MATRIX_ACCESS_TOKEN.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Matrix user ID of the bot account (e.g. @openclaw:matrix.org).",
+				Optional:    true,
+			},
+			"dm_policy": schema.StringAttribute{
+				Description: "DM policy: pairing (default), allowlist, open, disabled.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("pairing"),
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "Matrix user IDs allowed to message the bot.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"rooms": schema.ListAttribute{
+				Description: "Room IDs or aliases the bot responds in. The bot ignores other rooms when set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"encryption": schema.BoolAttribute{
+				Description: "Enable end-to-end encryption support for encrypted rooms.",
+				Optional:    true,
+			},
+			"history_limit": schema.Int64Attribute{
+				Description: "Max chat history messages. Default: 50.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(50),
+			},
+		},
+	}
+}
+
+func (r *ChannelMatrixResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelMatrixResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelMatrixModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "matrix"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Matrix config", err, "channels", "matrix")
+		return
+	}
+	plan.ID = types.StringValue("channel_matrix")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelMatrixResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelMatrixModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "matrix")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Matrix config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_matrix")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelMatrixResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelMatrixModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "matrix"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Matrix config", err, "channels", "matrix")
+		return
+	}
+	plan.ID = types.StringValue("channel_matrix")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelMatrixResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "matrix"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Matrix config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelMatrixResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "matrix")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import Matrix config", err.Error())
+		return
+	}
+	state := ChannelMatrixModel{
+		AllowFrom: types.ListNull(types.StringType),
+		Rooms:     types.ListNull(types.StringType),
+	}
+	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_matrix")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelMatrixResource) modelToMap(ctx context.Context, m ChannelMatrixModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "homeserverUrl", m.HomeserverURL)
+	setIfString(d, "accessToken", m.AccessToken)
+	setIfString(d, "userId", m.UserID)
+	setIfString(d, "dmPolicy", m.DmPolicy)
+	setIfStringList(ctx, d, "allowFrom", m.AllowFrom)
+	setIfStringList(ctx, d, "rooms", m.Rooms)
+	setIfBool(d, "encryption", m.Encryption)
+	setIfInt64(d, "historyLimit", m.HistoryLimit)
+	return d
+}
+
+func (r *ChannelMatrixResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelMatrixModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "homeserverUrl", &m.HomeserverURL)
+	readString(s, "userId", &m.UserID)
+	readString(s, "dmPolicy", &m.DmPolicy)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
+	readStringList(ctx, s, "rooms", &m.Rooms)
+	readBool(s, "encryption", &m.Encryption)
+	readFloat64AsInt64(s, "historyLimit", &m.HistoryLimit)
+}
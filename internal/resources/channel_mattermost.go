@@ -0,0 +1,261 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelMattermostResource{}
+var _ resource.ResourceWithImportState = &ChannelMattermostResource{}
+
+type ChannelMattermostResource struct {
+	gatewayTarget
+}
+
+type ChannelMattermostModel struct {
+	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	ServerURL      types.String `tfsdk:"server_url"`
+	BotToken       types.String `tfsdk:"bot_token"`
+	Teams          types.List   `tfsdk:"teams"`
+	Channels       types.List   `tfsdk:"channels"`
+	DmPolicy       types.String `tfsdk:"dm_policy"`
+	AllowFrom      types.List   `tfsdk:"allow_from"`
+	HistoryLimit   types.Int64  `tfsdk:"history_limit"`
+	TextChunkLimit types.Int64  `tfsdk:"text_chunk_limit"`
+	ChunkMode      types.String `tfsdk:"chunk_mode"`
+	ReplyToMode    types.String `tfsdk:"reply_to_mode"`
+}
+
+func NewChannelMattermostResource() resource.Resource {
+	return &ChannelMattermostResource{}
+}
+
+func (r *ChannelMattermostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_mattermost"
+}
+
+func (r *ChannelMattermostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw Mattermost channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the Mattermost channel.",
+				Optional:    true,
+			},
+			"server_url": schema.StringAttribute{
+				Description: "Mattermost server URL (e.g. https://chat.example.com).",
+				Optional:    true,
+			},
+			"bot_token": schema.StringAttribute{
+				Description: "Bot account access token. Sensitive. Falls back to MATTERMOST_BOT_TOKEN.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"teams": schema.ListAttribute{
+				Description: "Team names or IDs the bot responds in. All teams the bot belongs to when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"channels": schema.ListAttribute{
+				Description: "Channel names or IDs the bot responds in. Other channels are ignored when set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"dm_policy": schema.StringAttribute{
+				Description: "DM policy: pairing (default), allowlist, open, disabled.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("pairing"),
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "Mattermost usernames or user IDs allowed to message the bot.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"history_limit": schema.Int64Attribute{
+				Description: "Max chat history messages. Default: 50.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(50),
+			},
+			"text_chunk_limit": schema.Int64Attribute{
+				Description: "Max characters per chunk. Default: 4000.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(4000),
+			},
+			"chunk_mode": schema.StringAttribute{
+				Description: "Chunk mode: length or newline. Default: length.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("length"),
+			},
+			"reply_to_mode": schema.StringAttribute{
+				Description: "Reply-to behavior: off, first, all. Default: off.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("off"),
+			},
+		},
+	}
+}
+
+func (r *ChannelMattermostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelMattermostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelMattermostModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "mattermost"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Mattermost config", err, "channels", "mattermost")
+		return
+	}
+	plan.ID = types.StringValue("channel_mattermost")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelMattermostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelMattermostModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "mattermost")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Mattermost config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_mattermost")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelMattermostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelMattermostModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "mattermost"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Mattermost config", err, "channels", "mattermost")
+		return
+	}
+	plan.ID = types.StringValue("channel_mattermost")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelMattermostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "mattermost"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Mattermost config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelMattermostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "mattermost")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import Mattermost config", err.Error())
+		return
+	}
+	state := ChannelMattermostModel{
+		Teams:     types.ListNull(types.StringType),
+		Channels:  types.ListNull(types.StringType),
+		AllowFrom: types.ListNull(types.StringType),
+	}
+	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_mattermost")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelMattermostResource) modelToMap(ctx context.Context, m ChannelMattermostModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "serverUrl", m.ServerURL)
+	setIfString(d, "botToken", m.BotToken)
+	setIfStringList(ctx, d, "teams", m.Teams)
+	setIfStringList(ctx, d, "channels", m.Channels)
+	setIfString(d, "dmPolicy", m.DmPolicy)
+	setIfStringList(ctx, d, "allowFrom", m.AllowFrom)
+	setIfInt64(d, "historyLimit", m.HistoryLimit)
+	setIfInt64(d, "textChunkLimit", m.TextChunkLimit)
+	setIfString(d, "chunkMode", m.ChunkMode)
+	setIfString(d, "replyToMode", m.ReplyToMode)
+	return d
+}
+
+func (r *ChannelMattermostResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelMattermostModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "serverUrl", &m.ServerURL)
+	readStringList(ctx, s, "teams", &m.Teams)
+	readStringList(ctx, s, "channels", &m.Channels)
+	readString(s, "dmPolicy", &m.DmPolicy)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
+	readFloat64AsInt64(s, "historyLimit", &m.HistoryLimit)
+	readFloat64AsInt64(s, "textChunkLimit", &m.TextChunkLimit)
+	readString(s, "chunkMode", &m.ChunkMode)
+	readString(s, "replyToMode", &m.ReplyToMode)
+}
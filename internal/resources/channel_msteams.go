@@ -0,0 +1,234 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelMSTeamsResource{}
+var _ resource.ResourceWithImportState = &ChannelMSTeamsResource{}
+
+type ChannelMSTeamsResource struct {
+	gatewayTarget
+}
+
+type ChannelMSTeamsModel struct {
+	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	AppID          types.String `tfsdk:"app_id"`
+	AppPassword    types.String `tfsdk:"app_password"`
+	AllowedTenants types.List   `tfsdk:"allowed_tenants"`
+	DmPolicy       types.String `tfsdk:"dm_policy"`
+	AllowFrom      types.List   `tfsdk:"allow_from"`
+	HistoryLimit   types.Int64  `tfsdk:"history_limit"`
+	ReplyStyle     types.String `tfsdk:"reply_style"`
+}
+
+func NewChannelMSTeamsResource() resource.Resource {
+	return &ChannelMSTeamsResource{}
+}
+
+func (r *ChannelMSTeamsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_msteams"
+}
+
+func (r *ChannelMSTeamsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw Microsoft Teams channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the Microsoft Teams channel.",
+				Optional:    true,
+			},
+			"app_id": schema.StringAttribute{
+				Description: "Microsoft App ID of the Azure Bot registration.",
+				Optional:    true,
+			},
+			"app_password": schema.StringAttribute{
+				Description: "Client secret of the Azure Bot registration. Sensitive. Falls back to MSTEAMS_APP_PASSWORD.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"allowed_tenants": schema.ListAttribute{
+				Description: "Azure AD tenant IDs the bot accepts messages from. All tenants when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"dm_policy": schema.StringAttribute{
+				Description: "DM policy: pairing (default), allowlist, open, disabled.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("pairing"),
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "Azure AD object IDs or user principal names allowed to message the bot.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"history_limit": schema.Int64Attribute{
+				Description: "Max chat history messages. Default: 50.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(50),
+			},
+			"reply_style": schema.StringAttribute{
+				Description: "How replies are posted in channels: thread (default) or top-level.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("thread"),
+			},
+		},
+	}
+}
+
+func (r *ChannelMSTeamsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelMSTeamsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelMSTeamsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "msteams"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Microsoft Teams config", err, "channels", "msteams")
+		return
+	}
+	plan.ID = types.StringValue("channel_msteams")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelMSTeamsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelMSTeamsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "msteams")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Microsoft Teams config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_msteams")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelMSTeamsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelMSTeamsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "msteams"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Microsoft Teams config", err, "channels", "msteams")
+		return
+	}
+	plan.ID = types.StringValue("channel_msteams")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelMSTeamsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "msteams"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Microsoft Teams config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelMSTeamsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "msteams")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import Microsoft Teams config", err.Error())
+		return
+	}
+	state := ChannelMSTeamsModel{
+		AllowedTenants: types.ListNull(types.StringType),
+		AllowFrom:      types.ListNull(types.StringType),
+	}
+	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_msteams")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelMSTeamsResource) modelToMap(ctx context.Context, m ChannelMSTeamsModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "appId", m.AppID)
+	setIfString(d, "appPassword", m.AppPassword)
+	setIfStringList(ctx, d, "allowTenants", m.AllowedTenants)
+	setIfString(d, "dmPolicy", m.DmPolicy)
+	setIfStringList(ctx, d, "allowFrom", m.AllowFrom)
+	setIfInt64(d, "historyLimit", m.HistoryLimit)
+	setIfString(d, "replyStyle", m.ReplyStyle)
+	return d
+}
+
+func (r *ChannelMSTeamsResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelMSTeamsModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "appId", &m.AppID)
+	readStringList(ctx, s, "allowTenants", &m.AllowedTenants)
+	readString(s, "dmPolicy", &m.DmPolicy)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
+	readFloat64AsInt64(s, "historyLimit", &m.HistoryLimit)
+	readString(s, "replyStyle", &m.ReplyStyle)
+}
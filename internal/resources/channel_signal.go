@@ -18,11 +18,12 @@ var _ resource.Resource = &ChannelSignalResource{}
 var _ resource.ResourceWithImportState = &ChannelSignalResource{}
 
 type ChannelSignalResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ChannelSignalModel struct {
 	ID                    types.String `tfsdk:"id"`
+	Gateway               types.String `tfsdk:"gateway"`
 	Enabled               types.Bool   `tfsdk:"enabled"`
 	DmPolicy              types.String `tfsdk:"dm_policy"`
 	AllowFrom             types.List   `tfsdk:"allow_from"`
@@ -42,7 +43,8 @@ func (r *ChannelSignalResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw Signal channel configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Enable or disable the Signal channel.",
 				Optional:    true,
@@ -83,10 +85,13 @@ func (r *ChannelSignalResource) Configure(_ context.Context, req resource.Config
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *ChannelSignalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelSignalModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -98,7 +103,7 @@ func (r *ChannelSignalResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "signal"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Signal config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Signal config", err, "channels", "signal")
 		return
 	}
 	plan.ID = types.StringValue("channel_signal")
@@ -106,6 +111,9 @@ func (r *ChannelSignalResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *ChannelSignalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state ChannelSignalModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -126,6 +134,9 @@ func (r *ChannelSignalResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *ChannelSignalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelSignalModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -137,14 +148,17 @@ func (r *ChannelSignalResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "signal"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Signal config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Signal config", err, "channels", "signal")
 		return
 	}
 	plan.ID = types.StringValue("channel_signal")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *ChannelSignalResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *ChannelSignalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
@@ -156,7 +170,11 @@ func (r *ChannelSignalResource) Delete(ctx context.Context, _ resource.DeleteReq
 	}
 }
 
-func (r *ChannelSignalResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *ChannelSignalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "signal")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import Signal config", err.Error())
@@ -164,9 +182,11 @@ func (r *ChannelSignalResource) ImportState(ctx context.Context, _ resource.Impo
 	}
 	var state ChannelSignalModel
 	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("channel_signal")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -183,7 +203,7 @@ func (r *ChannelSignalResource) modelToMap(ctx context.Context, m ChannelSignalM
 func (r *ChannelSignalResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelSignalModel) {
 	readBool(s, "enabled", &m.Enabled)
 	readString(s, "dmPolicy", &m.DmPolicy)
-	readStringList(ctx, s, "allowFrom", &m.AllowFrom)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
 	readString(s, "reactionNotifications", &m.ReactionNotifications)
 	readFloat64AsInt64(s, "historyLimit", &m.HistoryLimit)
 }
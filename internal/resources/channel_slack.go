@@ -19,11 +19,12 @@ var _ resource.Resource = &ChannelSlackResource{}
 var _ resource.ResourceWithImportState = &ChannelSlackResource{}
 
 type ChannelSlackResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ChannelSlackModel struct {
 	ID                    types.String `tfsdk:"id"`
+	Gateway               types.String `tfsdk:"gateway"`
 	Enabled               types.Bool   `tfsdk:"enabled"`
 	BotToken              types.String `tfsdk:"bot_token"`
 	AppToken              types.String `tfsdk:"app_token"`
@@ -50,7 +51,8 @@ func (r *ChannelSlackResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw Slack channel configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Enable or disable the Slack channel.",
 				Optional:    true,
@@ -131,10 +133,13 @@ func (r *ChannelSlackResource) Configure(_ context.Context, req resource.Configu
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *ChannelSlackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelSlackModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -146,7 +151,7 @@ func (r *ChannelSlackResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "slack"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Slack config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Slack config", err, "channels", "slack")
 		return
 	}
 	plan.ID = types.StringValue("channel_slack")
@@ -154,6 +159,9 @@ func (r *ChannelSlackResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *ChannelSlackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state ChannelSlackModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -174,6 +182,9 @@ func (r *ChannelSlackResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *ChannelSlackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelSlackModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -185,14 +196,17 @@ func (r *ChannelSlackResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "slack"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Slack config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Slack config", err, "channels", "slack")
 		return
 	}
 	plan.ID = types.StringValue("channel_slack")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *ChannelSlackResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *ChannelSlackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
@@ -204,7 +218,11 @@ func (r *ChannelSlackResource) Delete(ctx context.Context, _ resource.DeleteRequ
 	}
 }
 
-func (r *ChannelSlackResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *ChannelSlackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "slack")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import Slack config", err.Error())
@@ -212,9 +230,11 @@ func (r *ChannelSlackResource) ImportState(ctx context.Context, _ resource.Impor
 	}
 	var state ChannelSlackModel
 	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("channel_slack")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -238,7 +258,7 @@ func (r *ChannelSlackResource) modelToMap(ctx context.Context, m ChannelSlackMod
 func (r *ChannelSlackResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelSlackModel) {
 	readBool(s, "enabled", &m.Enabled)
 	readString(s, "dmPolicy", &m.DmPolicy)
-	readStringList(ctx, s, "allowFrom", &m.AllowFrom)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
 	readBool(s, "allowBots", &m.AllowBots)
 	readFloat64AsInt64(s, "historyLimit", &m.HistoryLimit)
 	readFloat64AsInt64(s, "textChunkLimit", &m.TextChunkLimit)
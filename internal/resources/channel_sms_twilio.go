@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelSMSTwilioResource{}
+var _ resource.ResourceWithImportState = &ChannelSMSTwilioResource{}
+
+type ChannelSMSTwilioResource struct {
+	gatewayTarget
+}
+
+type ChannelSMSTwilioModel struct {
+	ID           types.String `tfsdk:"id"`
+	Gateway      types.String `tfsdk:"gateway"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	AccountSID   types.String `tfsdk:"account_sid"`
+	AuthToken    types.String `tfsdk:"auth_token"`
+	FromNumber   types.String `tfsdk:"from_number"`
+	AllowTo      types.List   `tfsdk:"allow_to"`
+	SegmentLimit types.Int64  `tfsdk:"segment_limit"`
+	WebhookPath  types.String `tfsdk:"webhook_path"`
+}
+
+func NewChannelSMSTwilioResource() resource.Resource {
+	return &ChannelSMSTwilioResource{}
+}
+
+func (r *ChannelSMSTwilioResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_sms_twilio"
+}
+
+func (r *ChannelSMSTwilioResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw SMS (Twilio) channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the SMS channel.",
+				Optional:    true,
+			},
+			"account_sid": schema.StringAttribute{
+				Description: "Twilio account SID (AC...).",
+				Optional:    true,
+			},
+			"auth_token": schema.StringAttribute{
+				Description: "Twilio auth token. Sensitive. Falls back to TWILIO_AUTH_TOKEN.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"from_number": schema.StringAttribute{
+				Description: "Twilio phone number messages are sent from, in E.164 format.",
+				Optional:    true,
+			},
+			"allow_to": schema.ListAttribute{
+				Description: "Phone numbers, in E.164 format, the bot may send messages to and accept messages from.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"segment_limit": schema.Int64Attribute{
+				Description: "Max SMS segments per outbound message. Longer replies are truncated. Default: 3.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3),
+			},
+			"webhook_path": schema.StringAttribute{
+				Description: "Gateway path Twilio posts inbound messages to. Default: /sms/twilio.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("/sms/twilio"),
+			},
+		},
+	}
+}
+
+func (r *ChannelSMSTwilioResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelSMSTwilioResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelSMSTwilioModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "sms"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write SMS config", err, "channels", "sms")
+		return
+	}
+	plan.ID = types.StringValue("channel_sms_twilio")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelSMSTwilioResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelSMSTwilioModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "sms")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read SMS config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_sms_twilio")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelSMSTwilioResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelSMSTwilioModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "sms"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write SMS config", err, "channels", "sms")
+		return
+	}
+	plan.ID = types.StringValue("channel_sms_twilio")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelSMSTwilioResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "sms"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete SMS config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelSMSTwilioResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "sms")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import SMS config", err.Error())
+		return
+	}
+	state := ChannelSMSTwilioModel{AllowTo: types.ListNull(types.StringType)}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_sms_twilio")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelSMSTwilioResource) modelToMap(ctx context.Context, m ChannelSMSTwilioModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "accountSid", m.AccountSID)
+	setIfString(d, "authToken", m.AuthToken)
+	setIfString(d, "fromNumber", m.FromNumber)
+	setIfStringList(ctx, d, "allowTo", m.AllowTo)
+	setIfInt64(d, "segmentLimit", m.SegmentLimit)
+	setIfString(d, "webhookPath", m.WebhookPath)
+	return d
+}
+
+func (r *ChannelSMSTwilioResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelSMSTwilioModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "accountSid", &m.AccountSID)
+	readString(s, "fromNumber", &m.FromNumber)
+	readStringList(ctx, s, "allowTo", &m.AllowTo)
+	readFloat64AsInt64(s, "segmentLimit", &m.SegmentLimit)
+	readString(s, "webhookPath", &m.WebhookPath)
+}
@@ -17,11 +17,12 @@ var _ resource.Resource = &ChannelTelegramResource{}
 var _ resource.ResourceWithImportState = &ChannelTelegramResource{}
 
 type ChannelTelegramResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ChannelTelegramModel struct {
 	ID           types.String `tfsdk:"id"`
+	Gateway      types.String `tfsdk:"gateway"`
 	Enabled      types.Bool   `tfsdk:"enabled"`
 	BotToken     types.String `tfsdk:"bot_token"`
 	DmPolicy     types.String `tfsdk:"dm_policy"`
@@ -49,6 +50,7 @@ func (r *ChannelTelegramResource) Schema(_ context.Context, _ resource.SchemaReq
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"gateway": gatewayAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Enable or disable the Telegram channel.",
 				Optional:    true,
@@ -106,10 +108,13 @@ func (r *ChannelTelegramResource) Configure(_ context.Context, req resource.Conf
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *ChannelTelegramResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelTelegramModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -125,7 +130,7 @@ func (r *ChannelTelegramResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	if err := client.PatchNestedSection(ctx, r.client, tg, cfg.Hash, "channels", "telegram"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Telegram config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Telegram config", err, "channels", "telegram")
 		return
 	}
 
@@ -134,6 +139,9 @@ func (r *ChannelTelegramResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *ChannelTelegramResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state ChannelTelegramModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -156,6 +164,9 @@ func (r *ChannelTelegramResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *ChannelTelegramResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelTelegramModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -171,7 +182,7 @@ func (r *ChannelTelegramResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	if err := client.PatchNestedSection(ctx, r.client, tg, cfg.Hash, "channels", "telegram"); err != nil {
-		resp.Diagnostics.AddError("Failed to write Telegram config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Telegram config", err, "channels", "telegram")
 		return
 	}
 
@@ -179,7 +190,10 @@ func (r *ChannelTelegramResource) Update(ctx context.Context, req resource.Updat
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *ChannelTelegramResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *ChannelTelegramResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
@@ -192,7 +206,11 @@ func (r *ChannelTelegramResource) Delete(ctx context.Context, _ resource.DeleteR
 	}
 }
 
-func (r *ChannelTelegramResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *ChannelTelegramResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "telegram")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import Telegram config", err.Error())
@@ -201,9 +219,11 @@ func (r *ChannelTelegramResource) ImportState(ctx context.Context, _ resource.Im
 
 	var state ChannelTelegramModel
 	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("channel_telegram")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -254,16 +274,7 @@ func (r *ChannelTelegramResource) mapToModel(ctx context.Context, section map[st
 	if v, ok := section["dmPolicy"].(string); ok {
 		m.DmPolicy = types.StringValue(v)
 	}
-	if v, ok := section["allowFrom"].([]any); ok {
-		strs := make([]string, 0, len(v))
-		for _, s := range v {
-			if str, ok := s.(string); ok {
-				strs = append(strs, str)
-			}
-		}
-		list, _ := types.ListValueFrom(ctx, types.StringType, strs)
-		m.AllowFrom = list
-	}
+	readStringListIfSet(ctx, section, "allowFrom", &m.AllowFrom)
 	if v, ok := section["streamMode"].(string); ok {
 		m.StreamMode = types.StringValue(v)
 	}
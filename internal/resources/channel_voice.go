@@ -0,0 +1,226 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelVoiceResource{}
+var _ resource.ResourceWithImportState = &ChannelVoiceResource{}
+
+type ChannelVoiceResource struct {
+	gatewayTarget
+}
+
+type ChannelVoiceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Gateway            types.String `tfsdk:"gateway"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	TelephonyProvider  types.String `tfsdk:"telephony_provider"`
+	PhoneNumber        types.String `tfsdk:"phone_number"`
+	TtsVoice           types.String `tfsdk:"tts_voice"`
+	MaxDurationSeconds types.Int64  `tfsdk:"max_duration_seconds"`
+	DmPolicy           types.String `tfsdk:"dm_policy"`
+	AllowFrom          types.List   `tfsdk:"allow_from"`
+}
+
+func NewChannelVoiceResource() resource.Resource {
+	return &ChannelVoiceResource{}
+}
+
+func (r *ChannelVoiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_voice"
+}
+
+func (r *ChannelVoiceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw voice (phone call) channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the voice channel.",
+				Optional:    true,
+			},
+			"telephony_provider": schema.StringAttribute{
+				Description: "Telephony provider: twilio, telnyx, plivo.",
+				Optional:    true,
+			},
+			"phone_number": schema.StringAttribute{
+				Description: "Phone number that receives and places calls (E.164, e.g. +15555550123).",
+				Optional:    true,
+			},
+			"tts_voice": schema.StringAttribute{
+				Description: "Text-to-speech voice used for agent replies.",
+				Optional:    true,
+			},
+			"max_duration_seconds": schema.Int64Attribute{
+				Description: "Max call duration in seconds before the agent hangs up.",
+				Optional:    true,
+			},
+			"dm_policy": schema.StringAttribute{
+				Description: "Inbound call policy: pairing (default), allowlist, open, disabled.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("pairing"),
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "Caller phone numbers allowed to reach the agent.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ChannelVoiceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelVoiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelVoiceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "voice"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write voice config", err, "channels", "voice")
+		return
+	}
+	plan.ID = types.StringValue("channel_voice")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelVoiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelVoiceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "voice")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read voice config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_voice")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelVoiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelVoiceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "voice"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write voice config", err, "channels", "voice")
+		return
+	}
+	plan.ID = types.StringValue("channel_voice")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelVoiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "voice"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete voice config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelVoiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "voice")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import voice config", err.Error())
+		return
+	}
+	var state ChannelVoiceModel
+	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_voice")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelVoiceResource) modelToMap(ctx context.Context, m ChannelVoiceModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "provider", m.TelephonyProvider)
+	setIfString(d, "phoneNumber", m.PhoneNumber)
+	setIfInt64(d, "maxDurationSeconds", m.MaxDurationSeconds)
+	setIfString(d, "dmPolicy", m.DmPolicy)
+	setIfStringList(ctx, d, "allowFrom", m.AllowFrom)
+
+	tts := make(map[string]any)
+	setIfString(tts, "voice", m.TtsVoice)
+	if len(tts) > 0 {
+		d["tts"] = tts
+	}
+	return d
+}
+
+func (r *ChannelVoiceResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelVoiceModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "provider", &m.TelephonyProvider)
+	readString(s, "phoneNumber", &m.PhoneNumber)
+	readFloat64AsInt64(s, "maxDurationSeconds", &m.MaxDurationSeconds)
+	readString(s, "dmPolicy", &m.DmPolicy)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
+
+	if tts, ok := s["tts"].(map[string]any); ok {
+		readString(tts, "voice", &m.TtsVoice)
+	}
+}
@@ -0,0 +1,262 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelWebChatResource{}
+var _ resource.ResourceWithImportState = &ChannelWebChatResource{}
+var _ resource.ResourceWithValidateConfig = &ChannelWebChatResource{}
+
+type ChannelWebChatResource struct {
+	gatewayTarget
+}
+
+type ChannelWebChatModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Gateway               types.String `tfsdk:"gateway"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Path                  types.String `tfsdk:"path"`
+	AllowedOrigins        types.List   `tfsdk:"allowed_origins"`
+	SessionCookieName     types.String `tfsdk:"session_cookie_name"`
+	SessionCookieSecure   types.Bool   `tfsdk:"session_cookie_secure"`
+	SessionCookieSameSite types.String `tfsdk:"session_cookie_same_site"`
+	SessionTTL            types.String `tfsdk:"session_ttl"`
+	Theme                 types.String `tfsdk:"theme"`
+	AnonymousAccess       types.String `tfsdk:"anonymous_access"`
+}
+
+func NewChannelWebChatResource() resource.Resource {
+	return &ChannelWebChatResource{}
+}
+
+func (r *ChannelWebChatResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_webchat"
+}
+
+func (r *ChannelWebChatResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw web chat channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the web chat widget.",
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Gateway path the widget is served from. Default: /chat.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("/chat"),
+			},
+			"allowed_origins": schema.ListAttribute{
+				Description: "Origins allowed to embed the widget and call its API (CORS), e.g. https://www.example.com. Same-origin only when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"session_cookie_name": schema.StringAttribute{
+				Description: "Name of the cookie that keeps a visitor's session. Default: openclaw_chat.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("openclaw_chat"),
+			},
+			"session_cookie_secure": schema.BoolAttribute{
+				Description: "Only send the session cookie over HTTPS. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"session_cookie_same_site": schema.StringAttribute{
+				Description: "SameSite mode of the session cookie: strict, lax, or none. Use none to embed the widget on another site. Default: lax.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("lax"),
+			},
+			"session_ttl": schema.StringAttribute{
+				Description: "How long a visitor session lasts, as a duration string. Default: 24h.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("24h"),
+			},
+			"theme": schema.StringAttribute{
+				Description: "Widget theme: light, dark, or auto to follow the visitor's system setting. Default: auto.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("auto"),
+			},
+			"anonymous_access": schema.StringAttribute{
+				Description: "Policy for visitors who are not signed in: allow, pairing (visitors must be approved first), or deny. Default: deny.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("deny"),
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects a SameSite=None cookie without Secure, which
+// browsers drop, leaving every embedded visitor without a session.
+func (r *ChannelWebChatResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ChannelWebChatModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SessionCookieSameSite.ValueString() == "none" && !config.SessionCookieSecure.IsUnknown() &&
+		!config.SessionCookieSecure.IsNull() && !config.SessionCookieSecure.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("session_cookie_secure"), "Insecure cross-site session cookie",
+			"Browsers reject SameSite=None cookies that are not Secure. Set session_cookie_secure = true when session_cookie_same_site is \"none\".")
+	}
+}
+
+func (r *ChannelWebChatResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelWebChatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelWebChatModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "webchat"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write web chat config", err, "channels", "webchat")
+		return
+	}
+	plan.ID = types.StringValue("channel_webchat")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelWebChatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelWebChatModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "webchat")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read web chat config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_webchat")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelWebChatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelWebChatModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "webchat"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write web chat config", err, "channels", "webchat")
+		return
+	}
+	plan.ID = types.StringValue("channel_webchat")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelWebChatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "webchat"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete web chat config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelWebChatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "webchat")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import web chat config", err.Error())
+		return
+	}
+	state := ChannelWebChatModel{AllowedOrigins: types.ListNull(types.StringType)}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_webchat")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelWebChatResource) modelToMap(ctx context.Context, m ChannelWebChatModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "path", m.Path)
+	setIfStringList(ctx, d, "allowedOrigins", m.AllowedOrigins)
+	setIfString(d, "sessionCookieName", m.SessionCookieName)
+	setIfBool(d, "sessionCookieSecure", m.SessionCookieSecure)
+	setIfString(d, "sessionCookieSameSite", m.SessionCookieSameSite)
+	setIfString(d, "sessionTtl", m.SessionTTL)
+	setIfString(d, "theme", m.Theme)
+	setIfString(d, "anonymousAccess", m.AnonymousAccess)
+	return d
+}
+
+func (r *ChannelWebChatResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelWebChatModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "path", &m.Path)
+	readStringList(ctx, s, "allowedOrigins", &m.AllowedOrigins)
+	readString(s, "sessionCookieName", &m.SessionCookieName)
+	readBool(s, "sessionCookieSecure", &m.SessionCookieSecure)
+	readString(s, "sessionCookieSameSite", &m.SessionCookieSameSite)
+	readString(s, "sessionTtl", &m.SessionTTL)
+	readString(s, "theme", &m.Theme)
+	readString(s, "anonymousAccess", &m.AnonymousAccess)
+}
@@ -19,11 +19,12 @@ var _ resource.Resource = &ChannelWhatsAppResource{}
 var _ resource.ResourceWithImportState = &ChannelWhatsAppResource{}
 
 type ChannelWhatsAppResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ChannelWhatsAppModel struct {
 	ID               types.String `tfsdk:"id"`
+	Gateway          types.String `tfsdk:"gateway"`
 	DmPolicy         types.String `tfsdk:"dm_policy"`
 	AllowFrom        types.List   `tfsdk:"allow_from"`
 	TextChunkLimit   types.Int64  `tfsdk:"text_chunk_limit"`
@@ -48,6 +49,7 @@ func (r *ChannelWhatsAppResource) Schema(_ context.Context, _ resource.SchemaReq
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"gateway": gatewayAttribute(),
 			"dm_policy": schema.StringAttribute{
 				Description: "DM policy: pairing (default), allowlist, open, disabled.",
 				Optional:    true,
@@ -102,10 +104,13 @@ func (r *ChannelWhatsAppResource) Configure(_ context.Context, req resource.Conf
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *ChannelWhatsAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelWhatsAppModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -121,7 +126,7 @@ func (r *ChannelWhatsAppResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	if err := client.PatchNestedSection(ctx, r.client, wa, cfg.Hash, "channels", "whatsapp"); err != nil {
-		resp.Diagnostics.AddError("Failed to write WhatsApp config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write WhatsApp config", err, "channels", "whatsapp")
 		return
 	}
 
@@ -130,6 +135,9 @@ func (r *ChannelWhatsAppResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *ChannelWhatsAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state ChannelWhatsAppModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -152,6 +160,9 @@ func (r *ChannelWhatsAppResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *ChannelWhatsAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ChannelWhatsAppModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -167,7 +178,7 @@ func (r *ChannelWhatsAppResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	if err := client.PatchNestedSection(ctx, r.client, wa, cfg.Hash, "channels", "whatsapp"); err != nil {
-		resp.Diagnostics.AddError("Failed to write WhatsApp config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write WhatsApp config", err, "channels", "whatsapp")
 		return
 	}
 
@@ -175,7 +186,10 @@ func (r *ChannelWhatsAppResource) Update(ctx context.Context, req resource.Updat
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *ChannelWhatsAppResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *ChannelWhatsAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
@@ -188,7 +202,11 @@ func (r *ChannelWhatsAppResource) Delete(ctx context.Context, _ resource.DeleteR
 	}
 }
 
-func (r *ChannelWhatsAppResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *ChannelWhatsAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "whatsapp")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import WhatsApp config", err.Error())
@@ -197,9 +215,11 @@ func (r *ChannelWhatsAppResource) ImportState(ctx context.Context, _ resource.Im
 
 	var state ChannelWhatsAppModel
 	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("channel_whatsapp")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -237,16 +257,7 @@ func (r *ChannelWhatsAppResource) mapToModel(ctx context.Context, section map[st
 	if v, ok := section["dmPolicy"].(string); ok {
 		m.DmPolicy = types.StringValue(v)
 	}
-	if v, ok := section["allowFrom"].([]any); ok {
-		strs := make([]string, 0, len(v))
-		for _, s := range v {
-			if str, ok := s.(string); ok {
-				strs = append(strs, str)
-			}
-		}
-		list, _ := types.ListValueFrom(ctx, types.StringType, strs)
-		m.AllowFrom = list
-	}
+	readStringListIfSet(ctx, section, "allowFrom", &m.AllowFrom)
 	if v, ok := section["textChunkLimit"].(float64); ok {
 		m.TextChunkLimit = types.Int64Value(int64(v))
 	}
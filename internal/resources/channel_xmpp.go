@@ -0,0 +1,241 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ChannelXMPPResource{}
+var _ resource.ResourceWithImportState = &ChannelXMPPResource{}
+
+type ChannelXMPPResource struct {
+	gatewayTarget
+}
+
+type ChannelXMPPModel struct {
+	ID         types.String `tfsdk:"id"`
+	Gateway    types.String `tfsdk:"gateway"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	JID        types.String `tfsdk:"jid"`
+	Password   types.String `tfsdk:"password"`
+	Server     types.String `tfsdk:"server"`
+	MUCRooms   types.List   `tfsdk:"muc_rooms"`
+	DmPolicy   types.String `tfsdk:"dm_policy"`
+	AllowFrom  types.List   `tfsdk:"allow_from"`
+	RequireTLS types.Bool   `tfsdk:"require_tls"`
+	DirectTLS  types.Bool   `tfsdk:"direct_tls"`
+}
+
+func NewChannelXMPPResource() resource.Resource {
+	return &ChannelXMPPResource{}
+}
+
+func (r *ChannelXMPPResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_xmpp"
+}
+
+func (r *ChannelXMPPResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw XMPP channel configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the XMPP channel.",
+				Optional:    true,
+			},
+			"jid": schema.StringAttribute{
+				Description: "JID of the bot account (e.g. openclaw@example.org).",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the bot account. Sensitive. Falls back to XMPP_PASSWORD.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"server": schema.StringAttribute{
+				Description: "Server to connect to, as host or host:port. Resolved from the JID domain when unset.",
+				Optional:    true,
+			},
+			"muc_rooms": schema.ListAttribute{
+				Description: "MUC room JIDs the bot joins (e.g. ops@conference.example.org).",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"dm_policy": schema.StringAttribute{
+				Description: "DM policy: pairing (default), allowlist, open, disabled.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("pairing"),
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "Bare JIDs allowed to message the bot.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"require_tls": schema.BoolAttribute{
+				Description: "Refuse to connect unless the stream is encrypted. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"direct_tls": schema.BoolAttribute{
+				Description: "Use direct TLS (XEP-0368) instead of STARTTLS. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ChannelXMPPResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ChannelXMPPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelXMPPModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "xmpp"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write XMPP config", err, "channels", "xmpp")
+		return
+	}
+	plan.ID = types.StringValue("channel_xmpp")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelXMPPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ChannelXMPPModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "xmpp")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read XMPP config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("channel_xmpp")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelXMPPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ChannelXMPPModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "channels", "xmpp"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write XMPP config", err, "channels", "xmpp")
+		return
+	}
+	plan.ID = types.StringValue("channel_xmpp")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelXMPPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "channels", "xmpp"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete XMPP config", err.Error())
+		return
+	}
+}
+
+func (r *ChannelXMPPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "channels", "xmpp")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import XMPP config", err.Error())
+		return
+	}
+	state := ChannelXMPPModel{
+		MUCRooms:  types.ListNull(types.StringType),
+		AllowFrom: types.ListNull(types.StringType),
+	}
+	if section != nil {
+		state.AllowFrom = types.ListUnknown(types.StringType)
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("channel_xmpp")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ChannelXMPPResource) modelToMap(ctx context.Context, m ChannelXMPPModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "jid", m.JID)
+	setIfString(d, "password", m.Password)
+	setIfString(d, "server", m.Server)
+	setIfStringList(ctx, d, "mucRooms", m.MUCRooms)
+	setIfString(d, "dmPolicy", m.DmPolicy)
+	setIfStringList(ctx, d, "allowFrom", m.AllowFrom)
+	setIfBool(d, "requireTls", m.RequireTLS)
+	setIfBool(d, "directTls", m.DirectTLS)
+	return d
+}
+
+func (r *ChannelXMPPResource) mapToModel(ctx context.Context, s map[string]any, m *ChannelXMPPModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "jid", &m.JID)
+	readString(s, "server", &m.Server)
+	readStringList(ctx, s, "mucRooms", &m.MUCRooms)
+	readString(s, "dmPolicy", &m.DmPolicy)
+	readStringListIfSet(ctx, s, "allowFrom", &m.AllowFrom)
+	readBool(s, "requireTls", &m.RequireTLS)
+	readBool(s, "directTls", &m.DirectTLS)
+}
@@ -0,0 +1,261 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &CompactionResource{}
+var _ resource.ResourceWithImportState = &CompactionResource{}
+var _ resource.ResourceWithValidateConfig = &CompactionResource{}
+
+type CompactionResource struct {
+	gatewayTarget
+}
+
+type CompactionModel struct {
+	ID               types.String  `tfsdk:"id"`
+	Gateway          types.String  `tfsdk:"gateway"`
+	MaxContextTokens types.Int64   `tfsdk:"max_context_tokens"`
+	Strategy         types.String  `tfsdk:"strategy"`
+	SummaryModel     types.String  `tfsdk:"summary_model"`
+	TriggerRatio     types.Float64 `tfsdk:"trigger_ratio"`
+	TriggerMessages  types.Int64   `tfsdk:"trigger_messages"`
+}
+
+func NewCompactionResource() resource.Resource {
+	return &CompactionResource{}
+}
+
+func (r *CompactionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compaction"
+}
+
+func (r *CompactionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages how session context is kept within the model's window (compaction section).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"max_context_tokens": schema.Int64Attribute{
+				Description: "Most tokens of context sent to the model. Defaults to the model's context window.",
+				Optional:    true,
+			},
+			"strategy": schema.StringAttribute{
+				Description: "How context is compacted: summarize (older turns are summarized), truncate (older turns are dropped) or sliding-window (only the latest turns are kept).",
+				Optional:    true,
+			},
+			"summary_model": schema.StringAttribute{
+				Description: "Model that writes the summaries (e.g. anthropic/claude-haiku-4-5). Only used with the summarize strategy. Defaults to the agent's model.",
+				Optional:    true,
+			},
+			"trigger_ratio": schema.Float64Attribute{
+				Description: "Compact once the context is this full, as a fraction of max_context_tokens (e.g. 0.8).",
+				Optional:    true,
+			},
+			"trigger_messages": schema.Int64Attribute{
+				Description: "Compact once the session has this many messages, however full the context is.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *CompactionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the strategy and the ranges of the limits, and that
+// summary_model is only set for the strategy that uses it.
+func (r *CompactionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CompactionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attr := range []struct {
+		name  string
+		value types.Int64
+	}{{"max_context_tokens", config.MaxContextTokens}, {"trigger_messages", config.TriggerMessages}} {
+		if v := attr.value; !v.IsNull() && !v.IsUnknown() && v.ValueInt64() <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.name), "Invalid limit",
+				fmt.Sprintf("%s must be positive, got %d", attr.name, v.ValueInt64()))
+		}
+	}
+	if v := config.TriggerRatio; !v.IsNull() && !v.IsUnknown() && (v.ValueFloat64() <= 0 || v.ValueFloat64() > 1) {
+		resp.Diagnostics.AddAttributeError(path.Root("trigger_ratio"), "Invalid ratio",
+			fmt.Sprintf("trigger_ratio must be above 0 and at most 1, got %g", v.ValueFloat64()))
+	}
+
+	if config.Strategy.IsUnknown() {
+		return
+	}
+	switch strategy := config.Strategy.ValueString(); strategy {
+	case "", "summarize":
+	case "truncate", "sliding-window":
+		if !config.SummaryModel.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("summary_model"), "Unused summary model",
+				fmt.Sprintf("summary_model is only used with the summarize strategy, not %q.", strategy))
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("strategy"), "Invalid strategy",
+			fmt.Sprintf("strategy must be summarize, truncate or sliding-window, got %q", strategy))
+	}
+}
+
+func (r *CompactionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan CompactionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "compaction", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write compaction config", err, "compaction")
+		return
+	}
+
+	plan.ID = types.StringValue("compaction")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CompactionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state CompactionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "compaction")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read compaction config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue("compaction")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *CompactionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan CompactionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "compaction", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write compaction config", err, "compaction")
+		return
+	}
+
+	plan.ID = types.StringValue("compaction")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CompactionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "compaction", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete compaction config", err.Error())
+		return
+	}
+}
+
+func (r *CompactionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "compaction")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import compaction config", err.Error())
+		return
+	}
+
+	var state CompactionModel
+	if section != nil {
+		r.mapToModel(section, &state)
+	}
+	state.ID = types.StringValue("compaction")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+// modelToMap patches unset attributes to null, so that removing one from the
+// configuration returns the setting to the gateway's default.
+func (r *CompactionResource) modelToMap(m CompactionModel) map[string]any {
+	d := map[string]any{"trigger": nil}
+	setIfInt64(d, "maxContextTokens", m.MaxContextTokens)
+	setIfString(d, "strategy", m.Strategy)
+	setIfString(d, "summaryModel", m.SummaryModel)
+
+	trigger := make(map[string]any)
+	setIfFloat64(trigger, "ratio", m.TriggerRatio)
+	setIfInt64(trigger, "messages", m.TriggerMessages)
+	if len(trigger) > 0 {
+		d["trigger"] = withNullKeys(trigger, "ratio", "messages")
+	}
+	return withNullKeys(d, "maxContextTokens", "strategy", "summaryModel")
+}
+
+func (r *CompactionResource) mapToModel(s map[string]any, m *CompactionModel) {
+	readFloat64AsInt64(s, "maxContextTokens", &m.MaxContextTokens)
+	readString(s, "strategy", &m.Strategy)
+	readString(s, "summaryModel", &m.SummaryModel)
+	if trigger, ok := s["trigger"].(map[string]any); ok {
+		readFloat64(trigger, "ratio", &m.TriggerRatio)
+		readFloat64AsInt64(trigger, "messages", &m.TriggerMessages)
+	}
+}
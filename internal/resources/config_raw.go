@@ -0,0 +1,377 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ConfigRawResource{}
+var _ resource.ResourceWithImportState = &ConfigRawResource{}
+var _ resource.ResourceWithValidateConfig = &ConfigRawResource{}
+var _ resource.ResourceWithModifyPlan = &ConfigRawResource{}
+
+type ConfigRawResource struct {
+	gatewayTarget
+}
+
+type ConfigRawModel struct {
+	ID          types.String `tfsdk:"id"`
+	Gateway     types.String `tfsdk:"gateway"`
+	Content     types.String `tfsdk:"content"`
+	IgnorePaths types.List   `tfsdk:"ignore_paths"`
+	ContentHash types.String `tfsdk:"content_hash"`
+}
+
+func NewConfigRawResource() resource.Resource {
+	return &ConfigRawResource{}
+}
+
+func (r *ConfigRawResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_raw"
+}
+
+func (r *ConfigRawResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the whole OpenClaw config as one document. Do not combine with resources that manage parts of the config.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"content": schema.StringAttribute{
+				Description: "The whole config as JSON or JSON5, e.g. from jsonencode or templatefile.",
+				Required:    true,
+			},
+			"ignore_paths": schema.ListAttribute{
+				Description: "Dotted config paths managed outside Terraform (e.g. meta or wizard.lastRunAt). Their current values are kept on apply, and changes to them are not drift.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "SHA-256 of the config with formatting, key order and ignore_paths normalized away.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that content parses and the ignored paths are well
+// formed.
+func (r *ConfigRawResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ConfigRawModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Content.IsNull() && !config.Content.IsUnknown() {
+		if _, err := client.ParseConfig(config.Content.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid config", err.Error())
+		}
+	}
+	if config.IgnorePaths.IsNull() || config.IgnorePaths.IsUnknown() {
+		return
+	}
+	var paths []types.String
+	resp.Diagnostics.Append(config.IgnorePaths.ElementsAs(ctx, &paths, false)...)
+	for i, p := range paths {
+		if p.IsNull() || p.IsUnknown() {
+			continue
+		}
+		if _, err := splitConfigPath(p.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ignore_paths").AtListIndex(i), "Invalid path", err.Error())
+		}
+	}
+}
+
+// ModifyPlan hashes the normalized content into content_hash.
+func (r *ConfigRawResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan ConfigRawModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Content.IsUnknown() || plan.IgnorePaths.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringUnknown())...)
+		return
+	}
+	doc, err := client.ParseConfig(plan.Content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid config", err.Error())
+		return
+	}
+	_, hash, err := normalizeConfig(doc, r.ignorePaths(ctx, plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to normalize config", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringValue(hash))...)
+}
+
+func (r *ConfigRawResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ConfigRawResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ConfigRawModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.apply(ctx, &plan); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to apply config", err)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read compares the normalized config on the gateway with what was applied.
+// Only when they differ is content replaced, with the gateway's config, so
+// that the formatting of content does not show up as drift.
+func (r *ConfigRawResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ConfigRawModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.ExportConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	doc, err := client.ParseConfig(cfg.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse config", err.Error())
+		return
+	}
+	normalized, hash, err := normalizeConfig(doc, r.ignorePaths(ctx, state))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to normalize config", err.Error())
+		return
+	}
+	if hash != state.ContentHash.ValueString() {
+		state.Content = types.StringValue(normalized)
+		state.ContentHash = types.StringValue(hash)
+	}
+	state.ID = types.StringValue("config_raw")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ConfigRawResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ConfigRawModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.apply(ctx, &plan); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to apply config", err)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state. Replacing the config with an
+// empty one would leave the gateway unable to serve anything.
+func (r *ConfigRawResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.AddWarning("Config left in place",
+		"openclaw_config_raw does not clear the config on destroy. The config stays as last applied.")
+}
+
+func (r *ConfigRawResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	cfg, err := r.client.ExportConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import config", err.Error())
+		return
+	}
+	doc, err := client.ParseConfig(cfg.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse config", err.Error())
+		return
+	}
+	normalized, hash, err := normalizeConfig(doc, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to normalize config", err.Error())
+		return
+	}
+	state := ConfigRawModel{
+		ID:          types.StringValue("config_raw"),
+		Gateway:     gatewayValue(gw),
+		Content:     types.StringValue(normalized),
+		IgnorePaths: types.ListNull(types.StringType),
+		ContentHash: types.StringValue(hash),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// apply replaces the config with the planned content. Values at ignored
+// paths are carried over from the current config; where it has none, the
+// value in content is used.
+func (r *ConfigRawResource) apply(ctx context.Context, m *ConfigRawModel) error {
+	doc, err := client.ParseConfig(m.Content.ValueString())
+	if err != nil {
+		return err
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		return err
+	}
+	ignore := r.ignorePaths(ctx, *m)
+	if len(ignore) > 0 {
+		current, err := client.ParseConfig(cfg.Raw)
+		if err != nil {
+			return fmt.Errorf("parsing current config: %w", err)
+		}
+		for _, keys := range ignore {
+			if v, ok := lookupConfigPath(current, keys); ok {
+				setConfigPath(doc, keys, v)
+			}
+		}
+	}
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := r.client.ApplyConfig(ctx, string(out), cfg.Hash); err != nil {
+		return err
+	}
+	_, hash, err := normalizeConfig(doc, ignore)
+	if err != nil {
+		return err
+	}
+	m.ID = types.StringValue("config_raw")
+	m.ContentHash = types.StringValue(hash)
+	return nil
+}
+
+// ignorePaths returns the split ignore_paths. They were checked by
+// ValidateConfig, so malformed entries are skipped.
+func (r *ConfigRawResource) ignorePaths(ctx context.Context, m ConfigRawModel) [][]string {
+	if m.IgnorePaths.IsNull() || m.IgnorePaths.IsUnknown() {
+		return nil
+	}
+	var paths []string
+	m.IgnorePaths.ElementsAs(ctx, &paths, false)
+	out := make([][]string, 0, len(paths))
+	for _, p := range paths {
+		if keys, err := splitConfigPath(p); err == nil {
+			out = append(out, keys)
+		}
+	}
+	return out
+}
+
+// normalizeConfig returns doc as compact JSON with sorted keys and the
+// ignored paths removed, and the SHA-256 of that. doc is not modified.
+func normalizeConfig(doc map[string]any, ignore [][]string) (string, string, error) {
+	// Round-trip through JSON for a deep copy that ignored paths can be
+	// deleted from.
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", "", err
+	}
+	var cp map[string]any
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return "", "", err
+	}
+	for _, keys := range ignore {
+		deleteConfigPath(cp, keys)
+	}
+	if cp == nil {
+		cp = map[string]any{}
+	}
+	data, err = json.Marshal(cp)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256(data)
+	return string(data), hex.EncodeToString(sum[:]), nil
+}
+
+// splitConfigPath splits a dotted config path such as gateway.auth.token.
+func splitConfigPath(p string) ([]string, error) {
+	keys := strings.Split(p, ".")
+	for _, k := range keys {
+		if k == "" {
+			return nil, fmt.Errorf("%q is not a dotted config path such as gateway.auth.token", p)
+		}
+	}
+	return keys, nil
+}
+
+func lookupConfigPath(doc map[string]any, keys []string) (any, bool) {
+	var cur any = doc
+	for _, k := range keys {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[k]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// setConfigPath sets the value at keys, creating objects along the way and
+// replacing non-object values that are in the way.
+func setConfigPath(doc map[string]any, keys []string, v any) {
+	m := doc
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := m[k].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			m[k] = next
+		}
+		m = next
+	}
+	m[keys[len(keys)-1]] = v
+}
+
+func deleteConfigPath(doc map[string]any, keys []string) {
+	m := doc
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := m[k].(map[string]any)
+		if !ok {
+			return
+		}
+		m = next
+	}
+	delete(m, keys[len(keys)-1])
+}
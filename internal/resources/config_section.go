@@ -0,0 +1,314 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ConfigSectionResource{}
+var _ resource.ResourceWithImportState = &ConfigSectionResource{}
+var _ resource.ResourceWithValidateConfig = &ConfigSectionResource{}
+
+type ConfigSectionResource struct {
+	gatewayTarget
+}
+
+type ConfigSectionModel struct {
+	ID        types.String `tfsdk:"id"`
+	Gateway   types.String `tfsdk:"gateway"`
+	Path      types.String `tfsdk:"path"`
+	ValueJSON types.String `tfsdk:"value_json"`
+}
+
+func NewConfigSectionResource() resource.Resource {
+	return &ConfigSectionResource{}
+}
+
+func (r *ConfigSectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_section"
+}
+
+func (r *ConfigSectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the value at any config path, as JSON. For settings that have no dedicated resource yet.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"path": schema.StringAttribute{
+				Description: "Dotted config path (e.g. experimental.voiceV2).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value_json": schema.StringAttribute{
+				Description: "Value at the path as JSON, e.g. from jsonencode. Replaces whatever is at the path.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the path, and that the value is JSON without nulls:
+// in a merge patch, null deletes a key, so it cannot be written as a value.
+func (r *ConfigSectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ConfigSectionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if p := config.Path; !p.IsNull() && !p.IsUnknown() {
+		if _, err := splitConfigPath(p.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid path", err.Error())
+		}
+	}
+	if v := config.ValueJSON; !v.IsNull() && !v.IsUnknown() {
+		value, err := parseValueJSON(v.ValueString())
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddAttributeError(path.Root("value_json"), "Invalid JSON", err.Error())
+		case containsNull(value):
+			resp.Diagnostics.AddAttributeError(path.Root("value_json"), "Invalid JSON",
+				"value_json must not contain null: config writes are merge patches, where null deletes a key. Leave the key out instead.")
+		}
+	}
+}
+
+func (r *ConfigSectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ConfigSectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ConfigSectionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeValue(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read compares the value at the path with value_json as parsed JSON, so
+// that formatting and key order are not drift. Only a real difference
+// replaces value_json, with the config's value.
+func (r *ConfigSectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ConfigSectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keys, err := splitConfigPath(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid path", err.Error())
+		return
+	}
+	sec, err := r.client.GetConfigSection(ctx, keys...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config section", err.Error())
+		return
+	}
+	if sec.Value == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if current, err := parseValueJSON(state.ValueJSON.ValueString()); err != nil || !reflect.DeepEqual(current, sec.Value) {
+		data, err := json.Marshal(sec.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to encode config section", err.Error())
+			return
+		}
+		state.ValueJSON = types.StringValue(string(data))
+	}
+	state.ID = state.Path
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ConfigSectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ConfigSectionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeValue(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ConfigSectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ConfigSectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keys, err := splitConfigPath(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid path", err.Error())
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, keys...); err != nil {
+		resp.Diagnostics.AddError("Failed to delete config section", err.Error())
+		return
+	}
+}
+
+// ImportState imports the value at a dotted path, e.g. experimental.voiceV2.
+func (r *ConfigSectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, p, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	keys, err := splitConfigPath(p)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	sec, err := r.client.GetConfigSection(ctx, keys...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import config section", err.Error())
+		return
+	}
+	if sec.Value == nil {
+		resp.Diagnostics.AddError("Config section not found", fmt.Sprintf("Nothing is set at %s", p))
+		return
+	}
+	data, err := json.Marshal(sec.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode config section", err.Error())
+		return
+	}
+	state := ConfigSectionModel{
+		ID:        types.StringValue(p),
+		Gateway:   gatewayValue(gw),
+		Path:      types.StringValue(p),
+		ValueJSON: types.StringValue(string(data)),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// writeValue makes the value at the path equal value_json. The write is a
+// merge patch against the current value, with keys value_json leaves out
+// patched to null, so the rest of the config is untouched.
+func (r *ConfigSectionResource) writeValue(ctx context.Context, plan tfsdk.Plan, m *ConfigSectionModel, diags *diag.Diagnostics) bool {
+	keys, err := splitConfigPath(m.Path.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("path"), "Invalid path", err.Error())
+		return false
+	}
+	want, err := parseValueJSON(m.ValueJSON.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("value_json"), "Invalid JSON", err.Error())
+		return false
+	}
+	sec, err := r.client.GetConfigSection(ctx, keys...)
+	if err != nil {
+		diags.AddError("Failed to read config", err.Error())
+		return false
+	}
+	patch := mergePatchFor(sec.Value, want)
+	if err := client.PatchNestedSection(ctx, r.client, patch, sec.Hash, keys...); err != nil {
+		addWriteError(ctx, diags, plan, "Failed to write config section", err, keys...)
+		return false
+	}
+	m.ID = types.StringValue(strings.Join(keys, "."))
+	return true
+}
+
+// parseValueJSON parses value_json, which may hold any JSON value.
+func parseValueJSON(s string) (any, error) {
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func containsNull(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		for _, e := range v {
+			if containsNull(e) {
+				return true
+			}
+		}
+	case []any:
+		for _, e := range v {
+			if containsNull(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergePatchFor returns the RFC 7396 merge patch that turns cur into want.
+// Objects are diffed key by key; anything else is replaced whole.
+func mergePatchFor(cur, want any) any {
+	wantObj, ok := want.(map[string]any)
+	if !ok {
+		return want
+	}
+	curObj, ok := cur.(map[string]any)
+	if !ok {
+		// Patching an object onto a non-object starts from an empty one.
+		curObj = map[string]any{}
+	}
+	patch := map[string]any{}
+	for k := range curObj {
+		if _, ok := wantObj[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	for k, v := range wantObj {
+		if c, ok := curObj[k]; ok && reflect.DeepEqual(c, v) {
+			continue
+		}
+		patch[k] = mergePatchFor(curObj[k], v)
+	}
+	return patch
+}
@@ -0,0 +1,342 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ContentFilterResource{}
+var _ resource.ResourceWithImportState = &ContentFilterResource{}
+var _ resource.ResourceWithValidateConfig = &ContentFilterResource{}
+
+type ContentFilterResource struct {
+	gatewayTarget
+}
+
+type ContentFilterModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Gateway                types.String `tfsdk:"gateway"`
+	Direction              types.String `tfsdk:"direction"`
+	BlockedPhrases         types.List   `tfsdk:"blocked_phrases"`
+	BlockedDomains         types.List   `tfsdk:"blocked_domains"`
+	AllowedDomains         types.List   `tfsdk:"allowed_domains"`
+	BlockedAttachmentTypes types.List   `tfsdk:"blocked_attachment_types"`
+	Action                 types.String `tfsdk:"action"`
+}
+
+func NewContentFilterResource() resource.Resource {
+	return &ContentFilterResource{}
+}
+
+func (r *ContentFilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_filter"
+}
+
+func (r *ContentFilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages filtering of message content (contentFilter section): blocked phrases, link domains and attachment types.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"direction": schema.StringAttribute{
+				Description: "Messages filtered: inbound (from peers), outbound (from agents) or both. Default: both.",
+				Optional:    true,
+			},
+			"blocked_phrases": schema.ListAttribute{
+				Description: "Phrases that trigger the action, matched case-insensitively.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"blocked_domains": schema.ListAttribute{
+				Description: "Link domains that trigger the action. A domain also matches its subdomains.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"allowed_domains": schema.ListAttribute{
+				Description: "When set, links to any other domain trigger the action. A domain also matches its subdomains.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"blocked_attachment_types": schema.ListAttribute{
+				Description: "Attachment MIME types (e.g. application/zip, or video/* for a whole type) that trigger the action.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"action": schema.StringAttribute{
+				Description: "What happens to a message that matches: drop|flag|require-approval. Default: flag.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ContentFilterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig rejects duplicates in the lists, domains given as URLs,
+// malformed MIME types, and a domain both blocked and allowed.
+func (r *ContentFilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ContentFilterModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d := config.Direction; !d.IsNull() && !d.IsUnknown() {
+		switch d.ValueString() {
+		case "inbound", "outbound", "both":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("direction"), "Invalid direction",
+				fmt.Sprintf("direction must be inbound, outbound or both, got %q", d.ValueString()))
+		}
+	}
+	if a := config.Action; !a.IsNull() && !a.IsUnknown() {
+		switch a.ValueString() {
+		case "drop", "flag", "require-approval":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("action"), "Invalid action",
+				fmt.Sprintf("action must be drop, flag or require-approval, got %q", a.ValueString()))
+		}
+	}
+
+	// Phrases are matched case-insensitively, so they are compared that way.
+	validateListElements(ctx, &resp.Diagnostics, "blocked_phrases", config.BlockedPhrases, strings.ToLower, nil)
+	blocked := validateListElements(ctx, &resp.Diagnostics, "blocked_domains", config.BlockedDomains, strings.ToLower, validDomain)
+	allowed := validateListElements(ctx, &resp.Diagnostics, "allowed_domains", config.AllowedDomains, strings.ToLower, validDomain)
+	validateListElements(ctx, &resp.Diagnostics, "blocked_attachment_types", config.BlockedAttachmentTypes, strings.ToLower, validMIMEType)
+
+	isBlocked := make(map[string]bool, len(blocked))
+	for _, d := range blocked {
+		isBlocked[d] = true
+	}
+	for i, d := range allowed {
+		if isBlocked[d] {
+			resp.Diagnostics.AddAttributeError(path.Root("allowed_domains").AtListIndex(i), "Conflicting domain",
+				fmt.Sprintf("%q is in both blocked_domains and allowed_domains.", d))
+		}
+	}
+}
+
+// validateListElements reports duplicate elements of a list attribute,
+// compared after normalize, and elements check rejects. check returns an
+// error message, or "" for a valid element. It returns the normalized
+// elements that are known.
+func validateListElements(ctx context.Context, diags *diag.Diagnostics, attr string, list types.List, normalize func(string) string, check func(string) string) []string {
+	return validateListElementsAt(ctx, diags, path.Root(attr), list, normalize, check)
+}
+
+// validateListElementsAt is validateListElements for a list at any path, such
+// as one nested in a list of objects.
+func validateListElementsAt(ctx context.Context, diags *diag.Diagnostics, p path.Path, list types.List, normalize func(string) string, check func(string) string) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	var elems []types.String
+	diags.Append(list.ElementsAs(ctx, &elems, false)...)
+	var known []string
+	seen := make(map[string]bool, len(elems))
+	for i, e := range elems {
+		if e.IsUnknown() {
+			continue
+		}
+		v := normalize(e.ValueString())
+		if seen[v] {
+			diags.AddAttributeError(p.AtListIndex(i), "Duplicate value",
+				fmt.Sprintf("%q is listed more than once in %s.", e.ValueString(), p))
+		} else if check != nil {
+			if msg := check(e.ValueString()); msg != "" {
+				diags.AddAttributeError(p.AtListIndex(i), "Invalid value", msg)
+			}
+		}
+		seen[v] = true
+		known = append(known, v)
+	}
+	return known
+}
+
+func validDomain(d string) string {
+	if d == "" || strings.ContainsAny(d, ":/ ") {
+		return fmt.Sprintf("Expected a domain such as example.com, without scheme or path, got %q", d)
+	}
+	return ""
+}
+
+func validMIMEType(t string) string {
+	typ, sub, ok := strings.Cut(t, "/")
+	if !ok || typ == "" || sub == "" || strings.Contains(sub, "/") {
+		return fmt.Sprintf("Expected a MIME type such as application/zip or video/*, got %q", t)
+	}
+	return ""
+}
+
+func (r *ContentFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ContentFilterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "contentFilter", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write content filter config", err, "contentFilter")
+		return
+	}
+
+	plan.ID = types.StringValue("content_filter")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContentFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ContentFilterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "contentFilter")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content filter config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("content_filter")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ContentFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ContentFilterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "contentFilter", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write content filter config", err, "contentFilter")
+		return
+	}
+
+	plan.ID = types.StringValue("content_filter")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContentFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "contentFilter", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete content filter config", err.Error())
+		return
+	}
+}
+
+func (r *ContentFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "contentFilter")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import content filter config", err.Error())
+		return
+	}
+
+	state := ContentFilterModel{
+		BlockedPhrases:         types.ListNull(types.StringType),
+		BlockedDomains:         types.ListNull(types.StringType),
+		AllowedDomains:         types.ListNull(types.StringType),
+		BlockedAttachmentTypes: types.ListNull(types.StringType),
+	}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("content_filter")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+// modelToMap patches unset attributes to null, so that a list removed from
+// the configuration stops being enforced.
+func (r *ContentFilterResource) modelToMap(ctx context.Context, m ContentFilterModel) map[string]any {
+	d := map[string]any{"domains": nil, "attachments": nil}
+	setIfString(d, "direction", m.Direction)
+	setIfStringList(ctx, d, "blockedPhrases", m.BlockedPhrases)
+	setIfString(d, "action", m.Action)
+
+	domains := make(map[string]any)
+	setIfStringList(ctx, domains, "block", m.BlockedDomains)
+	setIfStringList(ctx, domains, "allow", m.AllowedDomains)
+	if len(domains) > 0 {
+		d["domains"] = withNullKeys(domains, "block", "allow")
+	}
+	if !m.BlockedAttachmentTypes.IsNull() && !m.BlockedAttachmentTypes.IsUnknown() {
+		attachments := make(map[string]any)
+		setIfStringList(ctx, attachments, "blockedTypes", m.BlockedAttachmentTypes)
+		d["attachments"] = attachments
+	}
+	return withNullKeys(d, "direction", "blockedPhrases", "action")
+}
+
+func (r *ContentFilterResource) mapToModel(ctx context.Context, s map[string]any, m *ContentFilterModel) {
+	readString(s, "direction", &m.Direction)
+	readStringList(ctx, s, "blockedPhrases", &m.BlockedPhrases)
+	readString(s, "action", &m.Action)
+	if domains, ok := s["domains"].(map[string]any); ok {
+		readStringList(ctx, domains, "block", &m.BlockedDomains)
+		readStringList(ctx, domains, "allow", &m.AllowedDomains)
+	}
+	if attachments, ok := s["attachments"].(map[string]any); ok {
+		readStringList(ctx, attachments, "blockedTypes", &m.BlockedAttachmentTypes)
+	}
+}
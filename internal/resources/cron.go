@@ -18,16 +18,22 @@ var _ resource.Resource = &CronResource{}
 var _ resource.ResourceWithImportState = &CronResource{}
 
 type CronResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type CronModel struct {
 	ID                types.String `tfsdk:"id"`
+	Gateway           types.String `tfsdk:"gateway"`
 	Enabled           types.Bool   `tfsdk:"enabled"`
 	MaxConcurrentRuns types.Int64  `tfsdk:"max_concurrent_runs"`
 	SessionRetention  types.String `tfsdk:"session_retention"`
 }
 
+// cronKeys are the cron settings this resource owns. Blackout windows under
+// cron.blackoutWindows belong to openclaw_cron_global_window and survive a
+// destroy.
+var cronKeys = []string{"enabled", "maxConcurrentRuns", "sessionRetention"}
+
 func NewCronResource() resource.Resource {
 	return &CronResource{}
 }
@@ -40,7 +46,8 @@ func (r *CronResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw cron configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Enable or disable cron jobs.",
 				Optional:    true,
@@ -70,10 +77,13 @@ func (r *CronResource) Configure(_ context.Context, req resource.ConfigureReques
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *CronResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan CronModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -85,7 +95,7 @@ func (r *CronResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "cron"); err != nil {
-		resp.Diagnostics.AddError("Failed to write cron config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write cron config", err, "cron")
 		return
 	}
 	plan.ID = types.StringValue("cron")
@@ -93,6 +103,9 @@ func (r *CronResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *CronResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state CronModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -113,6 +126,9 @@ func (r *CronResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *CronResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan CronModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -124,14 +140,17 @@ func (r *CronResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "cron"); err != nil {
-		resp.Diagnostics.AddError("Failed to write cron config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write cron config", err, "cron")
 		return
 	}
 	plan.ID = types.StringValue("cron")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *CronResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *CronResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		if isConnectionClosed(err) {
@@ -141,7 +160,11 @@ func (r *CronResource) Delete(ctx context.Context, _ resource.DeleteRequest, res
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
 		return
 	}
-	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "cron"); err != nil {
+	unset := make(map[string]any, len(cronKeys))
+	for _, k := range cronKeys {
+		unset[k] = nil
+	}
+	if err := client.PatchSection(ctx, r.client, "cron", unset, cfg.Hash); err != nil {
 		if isConnectionClosed(err) {
 			resp.Diagnostics.AddWarning("Gateway connection lost during delete", "The gateway may have restarted. The delete was likely applied.")
 			return
@@ -151,7 +174,11 @@ func (r *CronResource) Delete(ctx context.Context, _ resource.DeleteRequest, res
 	}
 }
 
-func (r *CronResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *CronResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "cron")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import cron config", err.Error())
@@ -162,6 +189,7 @@ func (r *CronResource) ImportState(ctx context.Context, _ resource.ImportStateRe
 		r.mapToModel(section, &state)
 	}
 	state.ID = types.StringValue("cron")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -0,0 +1,346 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &CronGlobalWindowResource{}
+var _ resource.ResourceWithImportState = &CronGlobalWindowResource{}
+var _ resource.ResourceWithValidateConfig = &CronGlobalWindowResource{}
+
+type CronGlobalWindowResource struct {
+	gatewayTarget
+}
+
+type CronGlobalWindowModel struct {
+	ID      types.String              `tfsdk:"id"`
+	Gateway types.String              `tfsdk:"gateway"`
+	Windows []CronBlackoutWindowModel `tfsdk:"windows"`
+}
+
+type CronBlackoutWindowModel struct {
+	Days     types.List   `tfsdk:"days"`
+	Start    types.String `tfsdk:"start"`
+	End      types.String `tfsdk:"end"`
+	Timezone types.String `tfsdk:"timezone"`
+}
+
+const minutesPerDay = 24 * 60
+
+func NewCronGlobalWindowResource() resource.Resource {
+	return &CronGlobalWindowResource{}
+}
+
+func (r *CronGlobalWindowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cron_global_window"
+}
+
+func (r *CronGlobalWindowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages cron blackout windows (cron.blackoutWindows): times when no scheduled job runs, such as deploy freezes.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"windows": schema.ListNestedAttribute{
+				Description: "Blackout windows. Windows in the same timezone must not overlap.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"days": schema.ListAttribute{
+							Description: "Days the window starts on: mon, tue, wed, thu, fri, sat, sun. Every day when unset.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"start": schema.StringAttribute{
+							Description: "Start time as HH:MM.",
+							Required:    true,
+						},
+						"end": schema.StringAttribute{
+							Description: "End time as HH:MM. An end before the start runs past midnight into the next day.",
+							Required:    true,
+						},
+						"timezone": schema.StringAttribute{
+							Description: "IANA timezone of start and end (e.g. Europe/Berlin). The gateway's local time when unset.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *CronGlobalWindowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// blackoutSpan is a window on one day, in minutes since the start of the
+// week. end is past start, and may run past the end of the week.
+type blackoutSpan struct {
+	window, day int
+	start, end  int
+}
+
+// ValidateConfig checks each window's days, times and timezone, and that no
+// two windows in the same timezone overlap.
+func (r *CronGlobalWindowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var windows types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("windows"), &windows)...)
+	if resp.Diagnostics.HasError() || windows.IsNull() || windows.IsUnknown() {
+		return
+	}
+	var objects []types.Object
+	resp.Diagnostics.Append(windows.ElementsAs(ctx, &objects, false)...)
+
+	spans := make(map[string][]blackoutSpan)
+	reported := make(map[[2]int]bool)
+	for i, obj := range objects {
+		if obj.IsUnknown() {
+			continue
+		}
+		var w CronBlackoutWindowModel
+		resp.Diagnostics.Append(obj.As(ctx, &w, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		p := path.Root("windows").AtListIndex(i)
+
+		days := validateListElementsAt(ctx, &resp.Diagnostics, p.AtName("days"), w.Days, strings.ToLower, validWeekday)
+		if tz := w.Timezone; !tz.IsNull() && !tz.IsUnknown() {
+			if _, err := time.LoadLocation(tz.ValueString()); err != nil || tz.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(p.AtName("timezone"), "Invalid timezone",
+					fmt.Sprintf("timezone must be an IANA timezone such as Europe/Berlin, got %q", tz.ValueString()))
+			}
+		}
+		if w.Start.IsUnknown() || w.End.IsUnknown() || w.Days.IsUnknown() || w.Timezone.IsUnknown() {
+			continue
+		}
+		start, okStart := minuteOfDay(w.Start.ValueString())
+		end, okEnd := minuteOfDay(w.End.ValueString())
+		if !okStart {
+			resp.Diagnostics.AddAttributeError(p.AtName("start"), "Invalid time",
+				fmt.Sprintf("start must be a time as HH:MM, got %q", w.Start.ValueString()))
+		}
+		if !okEnd {
+			resp.Diagnostics.AddAttributeError(p.AtName("end"), "Invalid time",
+				fmt.Sprintf("end must be a time as HH:MM, got %q", w.End.ValueString()))
+		}
+		if !okStart || !okEnd {
+			continue
+		}
+		if start == end {
+			resp.Diagnostics.AddAttributeError(p.AtName("end"), "Empty window",
+				fmt.Sprintf("start and end are both %s. To black out a whole day, use 00:00 to 23:59.", w.Start.ValueString()))
+			continue
+		}
+		if end < start {
+			end += minutesPerDay
+		}
+
+		if w.Days.IsNull() {
+			days = weekdays
+		}
+		tz := w.Timezone.ValueString()
+		for _, d := range days {
+			day := slices.Index(weekdays, d)
+			if day < 0 {
+				continue
+			}
+			span := blackoutSpan{window: i, day: day, start: day*minutesPerDay + start, end: day*minutesPerDay + end}
+			for _, other := range spans[tz] {
+				pair := [2]int{other.window, i}
+				if other.window == i || reported[pair] || !spansOverlap(span, other) {
+					continue
+				}
+				reported[pair] = true
+				resp.Diagnostics.AddAttributeError(p, "Overlapping windows",
+					fmt.Sprintf("windows[%d] on %s overlaps windows[%d] on %s. Merge them into one window.",
+						i, weekdays[day], other.window, weekdays[other.day]))
+			}
+			spans[tz] = append(spans[tz], span)
+		}
+	}
+}
+
+// minuteOfDay parses a time as HH:MM into minutes since midnight.
+func minuteOfDay(s string) (int, bool) {
+	t, err := time.Parse("15:04", s)
+	if err != nil || len(s) != 5 {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// spansOverlap reports whether two spans overlap, also where one runs past
+// the end of the week into the start of the next.
+func spansOverlap(a, b blackoutSpan) bool {
+	const week = 7 * minutesPerDay
+	for _, shift := range []int{-week, 0, week} {
+		if a.start < b.end+shift && b.start+shift < a.end {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CronGlobalWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan CronGlobalWindowModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToList(ctx, plan), cfg.Hash, "cron", "blackoutWindows"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write cron blackout windows", err, "cron", "blackoutWindows")
+		return
+	}
+	plan.ID = types.StringValue("blackoutWindows")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CronGlobalWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state CronGlobalWindowModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	list, err := r.readWindows(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read cron blackout windows", err.Error())
+		return
+	}
+	if list == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Windows = r.listToModel(ctx, list)
+	state.ID = types.StringValue("blackoutWindows")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *CronGlobalWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan CronGlobalWindowModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToList(ctx, plan), cfg.Hash, "cron", "blackoutWindows"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write cron blackout windows", err, "cron", "blackoutWindows")
+		return
+	}
+	plan.ID = types.StringValue("blackoutWindows")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CronGlobalWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "cron", "blackoutWindows"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete cron blackout windows", err.Error())
+		return
+	}
+}
+
+func (r *CronGlobalWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	list, err := r.readWindows(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import cron blackout windows", err.Error())
+		return
+	}
+	if list == nil {
+		resp.Diagnostics.AddError("Cron blackout windows not found", "No cron.blackoutWindows in the config")
+		return
+	}
+	state := CronGlobalWindowModel{Windows: r.listToModel(ctx, list)}
+	state.ID = types.StringValue("blackoutWindows")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readWindows returns cron.blackoutWindows, or nil if it is not set.
+func (r *CronGlobalWindowResource) readWindows(ctx context.Context) ([]any, error) {
+	cron, _, err := client.GetNestedSection(ctx, r.client, "cron")
+	if err != nil {
+		return nil, err
+	}
+	list, _ := cron["blackoutWindows"].([]any)
+	return list, nil
+}
+
+// ── model ↔ list conversion ─────────────────────────────────
+
+func (r *CronGlobalWindowResource) modelToList(ctx context.Context, m CronGlobalWindowModel) []any {
+	list := make([]any, 0, len(m.Windows))
+	for _, w := range m.Windows {
+		d := make(map[string]any)
+		setIfStringList(ctx, d, "days", w.Days)
+		setIfString(d, "start", w.Start)
+		setIfString(d, "end", w.End)
+		setIfString(d, "timezone", w.Timezone)
+		list = append(list, d)
+	}
+	return list
+}
+
+func (r *CronGlobalWindowResource) listToModel(ctx context.Context, list []any) []CronBlackoutWindowModel {
+	windows := make([]CronBlackoutWindowModel, 0, len(list))
+	for _, item := range list {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		w := CronBlackoutWindowModel{Days: types.ListNull(types.StringType)}
+		readStringList(ctx, entry, "days", &w.Days)
+		readString(entry, "start", &w.Start)
+		readString(entry, "end", &w.End)
+		readString(entry, "timezone", &w.Timezone)
+		windows = append(windows, w)
+	}
+	return windows
+}
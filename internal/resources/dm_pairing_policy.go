@@ -0,0 +1,305 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &DMPairingPolicyResource{}
+var _ resource.ResourceWithImportState = &DMPairingPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &DMPairingPolicyResource{}
+
+type DMPairingPolicyResource struct {
+	gatewayTarget
+}
+
+type DMPairingPolicyModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Channel         types.String `tfsdk:"channel"`
+	CodeTTL         types.String `tfsdk:"code_ttl"`
+	MaxPending      types.Int64  `tfsdk:"max_pending"`
+	ApprovalChannel types.String `tfsdk:"approval_channel"`
+}
+
+// pairingKeys are the keys of a pairing object.
+var pairingKeys = []string{"codeTtl", "maxPending", "approvalChannel"}
+
+// pairingGlobalID is the ID of the top-level pairing section.
+const pairingGlobalID = "global"
+
+func NewDMPairingPolicyResource() resource.Resource {
+	return &DMPairingPolicyResource{}
+}
+
+func (r *DMPairingPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dm_pairing_policy"
+}
+
+func (r *DMPairingPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages how DM pairing works for all channels (pairing) or for one channel (channels.<channel>.pairing).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "global, or channel:<channel> for one channel.",
+				Computed:    true,
+			},
+			"gateway": gatewayAttribute(),
+			"channel": schema.StringAttribute{
+				Description: "Channel this policy applies to (e.g. telegram). The channel must already be configured. Policy for all channels when unset.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code_ttl": schema.StringAttribute{
+				Description: "How long a pairing code stays valid, as a duration (e.g. 10m, 1h).",
+				Optional:    true,
+			},
+			"max_pending": schema.Int64Attribute{
+				Description: "Maximum number of pairing requests waiting for approval. Further requests are refused until one is approved or expires.",
+				Optional:    true,
+			},
+			"approval_channel": schema.StringAttribute{
+				Description: "Channel pairing requests are posted to for approval (e.g. slack). The gateway's default when unset.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *DMPairingPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig requires at least one setting, a positive code lifetime and
+// a positive pending limit.
+func (r *DMPairingPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DMPairingPolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.CodeTTL.IsNull() && config.MaxPending.IsNull() && config.ApprovalChannel.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("code_ttl"), "Missing settings",
+			"Set at least one of code_ttl, max_pending or approval_channel.")
+		return
+	}
+	if ttl := config.CodeTTL; !ttl.IsNull() && !ttl.IsUnknown() {
+		if d, err := time.ParseDuration(ttl.ValueString()); err != nil || !validDuration(ttl.ValueString()) || d == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("code_ttl"), "Invalid duration",
+				fmt.Sprintf("code_ttl must be a positive duration such as 10m or 1h, got %q", ttl.ValueString()))
+		}
+	}
+	if v := config.MaxPending; !v.IsNull() && !v.IsUnknown() && v.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_pending"), "Invalid limit",
+			fmt.Sprintf("max_pending must be at least 1, got %d", v.ValueInt64()))
+	}
+}
+
+func (r *DMPairingPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan DMPairingPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writePolicy(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DMPairingPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state DMPairingPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetNestedSection(ctx, r.client, pairingPath(state.Channel)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read pairing policy", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(pairingID(state.Channel))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DMPairingPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan DMPairingPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writePolicy(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DMPairingPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state DMPairingPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Channel.IsNull() {
+		// The channel may have been destroyed first, taking its policy along.
+		channel, _, err := client.GetNestedSection(ctx, r.client, "channels", state.Channel.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read channel config", err.Error())
+			return
+		}
+		if channel == nil {
+			return
+		}
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, pairingPath(state.Channel)...); err != nil {
+		resp.Diagnostics.AddError("Failed to delete pairing policy", err.Error())
+		return
+	}
+}
+
+// ImportState takes global for the policy of all channels, or
+// channel:<channel>.
+func (r *DMPairingPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, id, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	state := DMPairingPolicyModel{Channel: types.StringNull()}
+	if id != pairingGlobalID {
+		channel, found := strings.CutPrefix(id, "channel:")
+		if !found || channel == "" {
+			resp.Diagnostics.AddError("Invalid import ID",
+				fmt.Sprintf("Expected global or channel:<channel>, got %q", id))
+			return
+		}
+		state.Channel = types.StringValue(channel)
+	}
+
+	section, _, err := client.GetNestedSection(ctx, r.client, pairingPath(state.Channel)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import pairing policy", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Pairing policy not found", fmt.Sprintf("No pairing policy configured for %q", id))
+		return
+	}
+
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(id)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func pairingID(channel types.String) string {
+	if channel.IsNull() {
+		return pairingGlobalID
+	}
+	return "channel:" + channel.ValueString()
+}
+
+// pairingPath returns the config keys of the top-level pairing section when
+// channel is null, or of that channel's pairing section.
+func pairingPath(channel types.String) []string {
+	if channel.IsNull() {
+		return []string{"pairing"}
+	}
+	return []string{"channels", channel.ValueString(), "pairing"}
+}
+
+// writePolicy patches the planned policy with unset keys nulled. A channel
+// policy is only written into a channel that is already configured, so that
+// it never creates a channel section of its own.
+func (r *DMPairingPolicyResource) writePolicy(ctx context.Context, plan tfsdk.Plan, m *DMPairingPolicyModel, diags *diag.Diagnostics) bool {
+	if !m.Channel.IsNull() {
+		channel, _, err := client.GetNestedSection(ctx, r.client, "channels", m.Channel.ValueString())
+		if err != nil {
+			diags.AddError("Failed to read channel config", err.Error())
+			return false
+		}
+		if channel == nil {
+			diags.AddAttributeError(path.Root("channel"), "Channel not configured",
+				fmt.Sprintf("No channels.%s in the config. Configure the channel before setting its pairing policy.", m.Channel.ValueString()))
+			return false
+		}
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		diags.AddError("Failed to read config", err.Error())
+		return false
+	}
+	keys := pairingPath(m.Channel)
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(*m), cfg.Hash, keys...); err != nil {
+		addWriteError(ctx, diags, plan, "Failed to write pairing policy", err, keys...)
+		return false
+	}
+	m.ID = types.StringValue(pairingID(m.Channel))
+	return true
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+func (r *DMPairingPolicyResource) modelToMap(m DMPairingPolicyModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "codeTtl", m.CodeTTL)
+	setIfInt64(d, "maxPending", m.MaxPending)
+	setIfString(d, "approvalChannel", m.ApprovalChannel)
+	return withNullKeys(d, pairingKeys...)
+}
+
+func (r *DMPairingPolicyResource) mapToModel(s map[string]any, m *DMPairingPolicyModel) {
+	readString(s, "codeTtl", &m.CodeTTL)
+	readFloat64AsInt64(s, "maxPending", &m.MaxPending)
+	readString(s, "approvalChannel", &m.ApprovalChannel)
+}
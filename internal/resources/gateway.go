@@ -18,11 +18,12 @@ var _ resource.Resource = &GatewayResource{}
 var _ resource.ResourceWithImportState = &GatewayResource{}
 
 type GatewayResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type GatewayResourceModel struct {
 	ID            types.String `tfsdk:"id"`
+	Gateway       types.String `tfsdk:"gateway"`
 	Port          types.Int64  `tfsdk:"port"`
 	Bind          types.String `tfsdk:"bind"`
 	AuthMode      types.String `tfsdk:"auth_mode"`
@@ -47,6 +48,7 @@ func (r *GatewayResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Identifier (always 'gateway').",
 				Computed:    true,
 			},
+			"gateway": gatewayAttribute(),
 			"port": schema.Int64Attribute{
 				Description: "Gateway listen port. Default: 18789.",
 				Optional:    true,
@@ -60,13 +62,15 @@ func (r *GatewayResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Default:     stringdefault.StaticString("loopback"),
 			},
 			"auth_mode": schema.StringAttribute{
-				Description: "Authentication mode: 'token', 'password', or 'none'.",
-				Optional:    true,
+				Description:        "Authentication mode: 'token', 'password', or 'none'.",
+				Optional:           true,
+				DeprecationMessage: "Use the openclaw_gateway_auth resource instead.",
 			},
 			"auth_token": schema.StringAttribute{
-				Description: "Gateway auth token. Sensitive.",
-				Optional:    true,
-				Sensitive:   true,
+				Description:        "Gateway auth token. Sensitive.",
+				Optional:           true,
+				Sensitive:          true,
+				DeprecationMessage: "Use the openclaw_gateway_auth resource, which stores the token as a hash and never keeps it in state.",
 			},
 			"reload_mode": schema.StringAttribute{
 				Description: "Config reload mode: 'hybrid' (default), 'hot', 'restart', or 'off'.",
@@ -75,8 +79,9 @@ func (r *GatewayResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Default:     stringdefault.StaticString("hybrid"),
 			},
 			"tailscale_mode": schema.StringAttribute{
-				Description: "Tailscale exposure mode: 'off' (default), 'serve', or 'funnel'.",
-				Optional:    true,
+				Description:        "Tailscale exposure mode: 'off' (default), 'serve', or 'funnel'.",
+				Optional:           true,
+				DeprecationMessage: "Use the openclaw_tailscale resource instead.",
 			},
 		},
 	}
@@ -91,10 +96,13 @@ func (r *GatewayResource) Configure(_ context.Context, req resource.ConfigureReq
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *provider.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *GatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan GatewayResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -110,7 +118,7 @@ func (r *GatewayResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if err := client.PatchSection(ctx, r.client, "gateway", gw, hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write gateway config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write gateway config", err, "gateway")
 		return
 	}
 
@@ -119,6 +127,9 @@ func (r *GatewayResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *GatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state GatewayResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -141,6 +152,9 @@ func (r *GatewayResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *GatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan GatewayResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -156,7 +170,7 @@ func (r *GatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if err := client.PatchSection(ctx, r.client, "gateway", gw, hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write gateway config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write gateway config", err, "gateway")
 		return
 	}
 
@@ -164,20 +178,42 @@ func (r *GatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *GatewayResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *GatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state GatewayResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	_, hash, err := client.GetSection(ctx, r.client, "gateway")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
 		return
 	}
 
-	if err := client.DeleteSection(ctx, r.client, "gateway", hash); err != nil {
+	// Only this resource's keys are removed. gateway.auth and
+	// gateway.tailscale belong to openclaw_gateway_auth and
+	// openclaw_tailscale unless the deprecated attributes for them are set.
+	unset := map[string]any{"port": nil, "bind": nil, "reload": nil}
+	if !state.AuthMode.IsNull() || !state.AuthToken.IsNull() {
+		unset["auth"] = nil
+	}
+	if !state.TailscaleMode.IsNull() {
+		unset["tailscale"] = nil
+	}
+	if err := client.PatchSection(ctx, r.client, "gateway", unset, hash); err != nil {
 		resp.Diagnostics.AddError("Failed to delete gateway config", err.Error())
 		return
 	}
 }
 
 func (r *GatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetSection(ctx, r.client, "gateway")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import gateway config", err.Error())
@@ -186,9 +222,20 @@ func (r *GatewayResource) ImportState(ctx context.Context, req resource.ImportSt
 
 	var state GatewayResourceModel
 	if section != nil {
+		// With no state to tell whether auth and Tailscale are managed here,
+		// the deprecated attributes are read whenever the config has them.
+		state.AuthMode = types.StringUnknown()
+		state.TailscaleMode = types.StringUnknown()
 		r.mapToModel(section, &state)
+		if state.AuthMode.IsUnknown() {
+			state.AuthMode = types.StringNull()
+		}
+		if state.TailscaleMode.IsUnknown() {
+			state.TailscaleMode = types.StringNull()
+		}
 	}
 	state.ID = types.StringValue("gateway")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -243,7 +290,9 @@ func (r *GatewayResource) mapToModel(section map[string]any, m *GatewayResourceM
 			}
 		}
 	}
-	if v, ok := section["auth"]; ok {
+	// Auth is only read back while this resource manages it, so that it can
+	// be left to openclaw_gateway_auth.
+	if v, ok := section["auth"]; ok && !m.AuthMode.IsNull() {
 		if am, ok := v.(map[string]any); ok {
 			if mode, ok := am["mode"]; ok {
 				if s, ok := mode.(string); ok {
@@ -253,7 +302,8 @@ func (r *GatewayResource) mapToModel(section map[string]any, m *GatewayResourceM
 			// Don't read back auth token from config for security.
 		}
 	}
-	if v, ok := section["tailscale"]; ok {
+	// Likewise for Tailscale and openclaw_tailscale.
+	if v, ok := section["tailscale"]; ok && !m.TailscaleMode.IsNull() {
 		if ts, ok := v.(map[string]any); ok {
 			if mode, ok := ts["mode"]; ok {
 				if s, ok := mode.(string); ok {
@@ -0,0 +1,332 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &GatewayAuthResource{}
+var _ resource.ResourceWithImportState = &GatewayAuthResource{}
+var _ resource.ResourceWithValidateConfig = &GatewayAuthResource{}
+
+type GatewayAuthResource struct {
+	gatewayTarget
+}
+
+type GatewayAuthModel struct {
+	ID                types.String `tfsdk:"id"`
+	Gateway           types.String `tfsdk:"gateway"`
+	Mode              types.String `tfsdk:"mode"`
+	TokenWO           types.String `tfsdk:"token_wo"`
+	TokenWOVersion    types.Int64  `tfsdk:"token_wo_version"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	BcryptCost        types.Int64  `tfsdk:"bcrypt_cost"`
+	Hash              types.String `tfsdk:"hash"`
+}
+
+func NewGatewayAuthResource() resource.Resource {
+	return &GatewayAuthResource{}
+}
+
+func (r *GatewayAuthResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_auth"
+}
+
+func (r *GatewayAuthResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages how clients authenticate to the gateway (gateway.auth). Tokens and passwords are stored as bcrypt hashes: the plaintext is never written to the config file or to Terraform state. Requires Terraform 1.11 or later for token and password modes.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"mode": schema.StringAttribute{
+				Description: "Authentication mode: token|password|none.",
+				Required:    true,
+			},
+			"token_wo": schema.StringAttribute{
+				Description: "Gateway token, write-only. Required when mode is token.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"token_wo_version": schema.Int64Attribute{
+				Description: "Version of token_wo. Change it to rotate the token.",
+				Optional:    true,
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Gateway password, write-only. Required when mode is password.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "Version of password_wo. Change it to rotate the password.",
+				Optional:    true,
+			},
+			"bcrypt_cost": schema.Int64Attribute{
+				Description: "bcrypt cost used to hash the token or password. Default: 10.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(int64(bcrypt.DefaultCost)),
+			},
+			"hash": schema.StringAttribute{
+				Description: "bcrypt hash written to the config. Null when mode is none.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// ValidateConfig requires the credential the mode uses, and no other.
+func (r *GatewayAuthResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GatewayAuthModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if c := config.BcryptCost; !c.IsNull() && !c.IsUnknown() {
+		if v := c.ValueInt64(); v < int64(bcrypt.MinCost) || v > int64(bcrypt.MaxCost) {
+			resp.Diagnostics.AddAttributeError(path.Root("bcrypt_cost"), "Invalid bcrypt cost",
+				fmt.Sprintf("bcrypt_cost must be between %d and %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, v))
+		}
+	}
+	for _, attr := range []struct {
+		name    string
+		value   types.String
+		version types.Int64
+	}{
+		{"token_wo", config.TokenWO, config.TokenWOVersion},
+		{"password_wo", config.PasswordWO, config.PasswordWOVersion},
+	} {
+		if !attr.version.IsNull() && attr.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.name+"_version"), "Unused "+attr.name+"_version",
+				attr.name+"_version only applies together with "+attr.name+".")
+		}
+	}
+
+	if config.Mode.IsUnknown() {
+		return
+	}
+	switch mode := config.Mode.ValueString(); mode {
+	case "token":
+		requireCredential(&resp.Diagnostics, "token", config.TokenWO, "token_wo")
+		rejectCredential(&resp.Diagnostics, "token", config.PasswordWO, "password_wo")
+	case "password":
+		requireCredential(&resp.Diagnostics, "password", config.PasswordWO, "password_wo")
+		rejectCredential(&resp.Diagnostics, "password", config.TokenWO, "token_wo")
+	case "none":
+		rejectCredential(&resp.Diagnostics, "none", config.TokenWO, "token_wo")
+		rejectCredential(&resp.Diagnostics, "none", config.PasswordWO, "password_wo")
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("mode"), "Invalid mode",
+			fmt.Sprintf("mode must be token, password or none, got %q", mode))
+	}
+}
+
+func requireCredential(diags *diag.Diagnostics, mode string, v types.String, attr string) {
+	if v.IsNull() {
+		diags.AddAttributeError(path.Root(attr), "Missing credential",
+			fmt.Sprintf("Set %s when mode is %q.", attr, mode))
+	}
+}
+
+func rejectCredential(diags *diag.Diagnostics, mode string, v types.String, attr string) {
+	if !v.IsNull() {
+		diags.AddAttributeError(path.Root(attr), "Unused credential",
+			fmt.Sprintf("%s is not used when mode is %q.", attr, mode))
+	}
+}
+
+func (r *GatewayAuthResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *GatewayAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan GatewayAuthModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeAuth(ctx, req.Config, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read checks the mode and that the hash is still the one written. A hash
+// changed or removed outside Terraform clears the credential's version, so
+// that a configured version shows up as an update that writes it again.
+func (r *GatewayAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state GatewayAuthModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "gateway", "auth")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read gateway auth config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	readString(section, "mode", &state.Mode)
+	hash := authHash(section, state.Mode.ValueString())
+	if !hash.Equal(state.Hash) {
+		state.TokenWOVersion = types.Int64Null()
+		state.PasswordWOVersion = types.Int64Null()
+		state.Hash = hash
+	}
+	state.ID = types.StringValue("gateway_auth")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GatewayAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan GatewayAuthModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeAuth(ctx, req.Config, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GatewayAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "gateway", "auth"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete gateway auth config", err.Error())
+		return
+	}
+}
+
+// ImportState imports the mode and hash. The token or password cannot be
+// read back: set token_wo or password_wo in the configuration, with a
+// version, and the next apply writes it.
+func (r *GatewayAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "gateway", "auth")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import gateway auth config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Gateway auth not found", "No gateway.auth section in the config")
+		return
+	}
+	state := GatewayAuthModel{
+		ID:         types.StringValue("gateway_auth"),
+		Gateway:    gatewayValue(gw),
+		BcryptCost: types.Int64Value(int64(bcrypt.DefaultCost)),
+	}
+	readString(section, "mode", &state.Mode)
+	state.Hash = authHash(section, state.Mode.ValueString())
+	if cost, err := bcrypt.Cost([]byte(state.Hash.ValueString())); err == nil {
+		state.BcryptCost = types.Int64Value(int64(cost))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// writeAuth hashes the credential the mode uses and writes it, removing any
+// plaintext or hash left from another mode. Write-only values are never
+// part of the plan, so the credential is taken from the configuration.
+func (r *GatewayAuthResource) writeAuth(ctx context.Context, config tfsdk.Config, plan tfsdk.Plan, m *GatewayAuthModel, diags *diag.Diagnostics) bool {
+	mode := m.Mode.ValueString()
+	auth := map[string]any{
+		"mode":         mode,
+		"token":        nil,
+		"tokenHash":    nil,
+		"password":     nil,
+		"passwordHash": nil,
+	}
+	m.Hash = types.StringNull()
+
+	if key := authHashKey(mode); key != "" {
+		var secret types.String
+		diags.Append(config.GetAttribute(ctx, path.Root(mode+"_wo"), &secret)...)
+		if diags.HasError() {
+			return false
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(secret.ValueString()), int(m.BcryptCost.ValueInt64()))
+		if err != nil {
+			diags.AddAttributeError(path.Root(mode+"_wo"), "Failed to hash "+mode, err.Error())
+			return false
+		}
+		auth[key] = string(hash)
+		m.Hash = types.StringValue(string(hash))
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		diags.AddError("Failed to read config", err.Error())
+		return false
+	}
+	if err := client.PatchNestedSection(ctx, r.client, auth, cfg.Hash, "gateway", "auth"); err != nil {
+		addWriteError(ctx, diags, plan, "Failed to write gateway auth config", err, "gateway", "auth")
+		return false
+	}
+	m.ID = types.StringValue("gateway_auth")
+	return true
+}
+
+// authHashKey returns the config key holding the hash for mode, or "" for a
+// mode without a credential.
+func authHashKey(mode string) string {
+	switch mode {
+	case "token":
+		return "tokenHash"
+	case "password":
+		return "passwordHash"
+	}
+	return ""
+}
+
+func authHash(section map[string]any, mode string) types.String {
+	if h, ok := section[authHashKey(mode)].(string); ok {
+		return types.StringValue(h)
+	}
+	return types.StringNull()
+}
@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+// gatewayTarget is embedded in every resource. It keeps the provider data and
+// points client at the connection named by the resource's gateway attribute.
+type gatewayTarget struct {
+	client client.Client
+	data   *shared.ProviderData
+}
+
+// gatewayAttribute is the schema for the gateway attribute shared by all
+// resources. Moving a resource to another gateway recreates it there.
+func gatewayAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Name of a provider gateways block to manage this resource on. Defaults to the provider's primary connection.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (t *gatewayTarget) setProviderData(pd *shared.ProviderData) {
+	t.data = pd
+	t.client = pd.Client
+}
+
+// attributeGetter is satisfied by tfsdk.Plan, tfsdk.State and tfsdk.Config.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+// selectGateway points t.client at the gateway named in src. It returns false
+// if the gateway is unknown or cannot be reached.
+func (t *gatewayTarget) selectGateway(ctx context.Context, src attributeGetter, diags *diag.Diagnostics) bool {
+	var name types.String
+	diags.Append(src.GetAttribute(ctx, path.Root("gateway"), &name)...)
+	if diags.HasError() {
+		return false
+	}
+	return t.useGateway(ctx, name.ValueString(), diags)
+}
+
+// selectWritableGateway is selectGateway for Create, Update and Delete. It
+// refuses to go any further when the provider is read-only.
+func (t *gatewayTarget) selectWritableGateway(ctx context.Context, src attributeGetter, diags *diag.Diagnostics) bool {
+	if t.data != nil && t.data.ReadOnly {
+		diags.AddError(
+			"Provider is read-only",
+			"The openclaw provider is configured with read_only = true, so resources cannot be created, updated or deleted. "+
+				"Refresh, import and data sources still work; unset read_only to apply this change.",
+		)
+		return false
+	}
+	return t.selectGateway(ctx, src, diags)
+}
+
+func (t *gatewayTarget) useGateway(ctx context.Context, name string, diags *diag.Diagnostics) bool {
+	if t.data == nil {
+		return true
+	}
+	c, err := t.data.ClientFor(ctx, name)
+	if err != nil {
+		diags.AddAttributeError(path.Root("gateway"), "Failed to select gateway", err.Error())
+		return false
+	}
+	t.client = c
+	return true
+}
+
+// importGateway selects the gateway for an import. An ID of the form
+// "<gateway>:<id>" imports <id> from that gateway; for singleton resources the
+// ID may simply be a gateway name. Anything else uses the default connection.
+// It returns the gateway name ("" for the default) and the remaining ID.
+func (t *gatewayTarget) importGateway(ctx context.Context, id string, diags *diag.Diagnostics) (string, string, bool) {
+	if t.data == nil || len(t.data.Gateways) == 0 {
+		return "", id, true
+	}
+	name, rest := id, ""
+	if i := strings.Index(id, ":"); i >= 0 {
+		name, rest = id[:i], id[i+1:]
+	}
+	if _, ok := t.data.Gateways[name]; !ok {
+		return "", id, true
+	}
+	if !t.useGateway(ctx, name, diags) {
+		return "", id, false
+	}
+	return name, rest, true
+}
+
+// gatewayValue converts a gateway name to its state value.
+func gatewayValue(name string) types.String {
+	if name == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(name)
+}
@@ -0,0 +1,256 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &GroupResource{}
+var _ resource.ResourceWithImportState = &GroupResource{}
+
+type GroupResource struct {
+	gatewayTarget
+}
+
+type GroupModel struct {
+	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
+	Channel        types.String `tfsdk:"channel"`
+	GroupID        types.String `tfsdk:"group_id"`
+	RequireMention types.Bool   `tfsdk:"require_mention"`
+	AllowFrom      types.List   `tfsdk:"allow_from"`
+	ToolsAllow     types.List   `tfsdk:"tools_allow"`
+	ToolsDeny      types.List   `tfsdk:"tools_deny"`
+	ResponseStyle  types.String `tfsdk:"response_style"`
+}
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+func (r *GroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the policy overrides for one group chat, in channels.<channel>.groups.<group_id>.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"channel": schema.StringAttribute{
+				Description: "Channel the group belongs to (e.g. whatsapp, telegram, discord).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Group ID on the channel (WhatsApp group JID, Telegram chat ID, Discord channel ID).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"require_mention": schema.BoolAttribute{
+				Description: "Only respond in the group when the agent is mentioned.",
+				Optional:    true,
+			},
+			"allow_from": schema.ListAttribute{
+				Description: "Senders the agent responds to in this group. Everyone in the group when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tools_allow": schema.ListAttribute{
+				Description: "Tools allowed in this group.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tools_deny": schema.ListAttribute{
+				Description: "Tools denied in this group.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"response_style": schema.StringAttribute{
+				Description: "Response style in this group (e.g. concise, detailed).",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *GroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan GroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	keys := groupKeys(plan.Channel.ValueString(), plan.GroupID.ValueString())
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, keys...); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write group config", err, keys...)
+		return
+	}
+	plan.ID = types.StringValue(plan.Channel.ValueString() + "/" + plan.GroupID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state GroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, groupKeys(state.Channel.ValueString(), state.GroupID.ValueString())...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read group config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(state.Channel.ValueString() + "/" + state.GroupID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan GroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	keys := groupKeys(plan.Channel.ValueString(), plan.GroupID.ValueString())
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, keys...); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write group config", err, keys...)
+		return
+	}
+	plan.ID = types.StringValue(plan.Channel.ValueString() + "/" + plan.GroupID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state GroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	keys := groupKeys(state.Channel.ValueString(), state.GroupID.ValueString())
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, keys...); err != nil {
+		resp.Diagnostics.AddError("Failed to delete group config", err.Error())
+		return
+	}
+}
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, importID, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	// Import ID format: channel/groupId
+	channel, groupID, found := strings.Cut(importID, "/")
+	if !found || channel == "" || groupID == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: channel/groupId")
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, groupKeys(channel, groupID)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import group config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Group not found", fmt.Sprintf("No group %q in channels.%s.groups", groupID, channel))
+		return
+	}
+	state := GroupModel{
+		AllowFrom:  types.ListNull(types.StringType),
+		ToolsAllow: types.ListNull(types.StringType),
+		ToolsDeny:  types.ListNull(types.StringType),
+	}
+	state.Channel = types.StringValue(channel)
+	state.GroupID = types.StringValue(groupID)
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(importID)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// groupKeys returns the config path of a group's overrides.
+func groupKeys(channel, groupID string) []string {
+	return []string{"channels", channel, "groups", groupID}
+}
+
+func (r *GroupResource) modelToMap(ctx context.Context, m GroupModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "requireMention", m.RequireMention)
+	setIfStringList(ctx, d, "allowFrom", m.AllowFrom)
+	setIfString(d, "responseStyle", m.ResponseStyle)
+
+	tools := make(map[string]any)
+	setIfStringList(ctx, tools, "allow", m.ToolsAllow)
+	setIfStringList(ctx, tools, "deny", m.ToolsDeny)
+	if len(tools) > 0 {
+		d["tools"] = tools
+	}
+	return d
+}
+
+func (r *GroupResource) mapToModel(ctx context.Context, s map[string]any, m *GroupModel) {
+	readBool(s, "requireMention", &m.RequireMention)
+	readStringList(ctx, s, "allowFrom", &m.AllowFrom)
+	readString(s, "responseStyle", &m.ResponseStyle)
+
+	if tools, ok := s["tools"].(map[string]any); ok {
+		readStringList(ctx, tools, "allow", &m.ToolsAllow)
+		readStringList(ctx, tools, "deny", &m.ToolsDeny)
+	}
+}
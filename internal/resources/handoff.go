@@ -0,0 +1,309 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &HandoffResource{}
+var _ resource.ResourceWithImportState = &HandoffResource{}
+var _ resource.ResourceWithValidateConfig = &HandoffResource{}
+
+type HandoffResource struct {
+	gatewayTarget
+}
+
+type HandoffModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Gateway            types.String `tfsdk:"gateway"`
+	TriggerPhrases     types.List   `tfsdk:"trigger_phrases"`
+	OperatorChannel    types.String `tfsdk:"operator_channel"`
+	OperatorPeer       types.String `tfsdk:"operator_peer"`
+	OfficeHours        types.String `tfsdk:"office_hours"`
+	OfficeDays         types.List   `tfsdk:"office_days"`
+	OfficeTimezone     types.String `tfsdk:"office_timezone"`
+	AutoResume         types.Bool   `tfsdk:"auto_resume"`
+	ResumeAfterMinutes types.Int64  `tfsdk:"resume_after_minutes"`
+}
+
+// weekdays are the day names accepted in day lists, in week order.
+var weekdays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+func NewHandoffResource() resource.Resource {
+	return &HandoffResource{}
+}
+
+func (r *HandoffResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_handoff"
+}
+
+func (r *HandoffResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages escalation of conversations to a human operator (handoff section).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"trigger_phrases": schema.ListAttribute{
+				Description: "Phrases from a peer that hand the conversation to the operator (e.g. \"talk to a human\"), matched case-insensitively. Agents can also hand off on their own.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"operator_channel": schema.StringAttribute{
+				Description: "Channel the operator is notified on (e.g. slack).",
+				Required:    true,
+			},
+			"operator_peer": schema.StringAttribute{
+				Description: "Operator's peer on operator_channel (user, chat or channel ID, or phone number).",
+				Required:    true,
+			},
+			"office_hours": schema.StringAttribute{
+				Description: "Time of day operators are available, as HH:MM-HH:MM (e.g. 09:00-17:30). Outside it, the peer is told when to expect a reply. Always when unset.",
+				Optional:    true,
+			},
+			"office_days": schema.ListAttribute{
+				Description: "Days office_hours apply: mon, tue, wed, thu, fri, sat, sun. Every day when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"office_timezone": schema.StringAttribute{
+				Description: "IANA time zone of office_hours (e.g. Europe/London). Defaults to the gateway host's time zone.",
+				Optional:    true,
+			},
+			"auto_resume": schema.BoolAttribute{
+				Description: "Hand the conversation back to the agent when the operator goes quiet, rather than when they release it.",
+				Optional:    true,
+			},
+			"resume_after_minutes": schema.Int64Attribute{
+				Description: "Minutes without an operator reply before auto_resume hands back. Default: 30.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *HandoffResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the phrase and day lists, the office hours window,
+// and that the office and resume settings are only set with what they
+// qualify.
+func (r *HandoffResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config HandoffModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateListElements(ctx, &resp.Diagnostics, "trigger_phrases", config.TriggerPhrases, strings.ToLower, nil)
+	validateListElements(ctx, &resp.Diagnostics, "office_days", config.OfficeDays, strings.ToLower, validWeekday)
+
+	if w := config.OfficeHours; !w.IsNull() && !w.IsUnknown() && !validApplyWindow(w.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("office_hours"), "Invalid window",
+			fmt.Sprintf("office_hours must be HH:MM-HH:MM (e.g. 09:00-17:30), got %q", w.ValueString()))
+	}
+	if config.OfficeHours.IsNull() {
+		for _, attr := range []struct {
+			name string
+			set  bool
+		}{{"office_days", !config.OfficeDays.IsNull()}, {"office_timezone", !config.OfficeTimezone.IsNull()}} {
+			if attr.set {
+				resp.Diagnostics.AddAttributeError(path.Root(attr.name), "Missing office hours",
+					fmt.Sprintf("%s only applies together with office_hours.", attr.name))
+			}
+		}
+	}
+
+	v := config.ResumeAfterMinutes
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if v.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("resume_after_minutes"), "Invalid limit",
+			fmt.Sprintf("resume_after_minutes must be positive, got %d", v.ValueInt64()))
+	}
+	if !config.AutoResume.IsUnknown() && !config.AutoResume.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("resume_after_minutes"), "Unused resume delay",
+			"resume_after_minutes only applies when auto_resume is true.")
+	}
+}
+
+func validWeekday(d string) string {
+	for _, w := range weekdays {
+		if strings.EqualFold(d, w) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("Days are %s, got %q", strings.Join(weekdays, ", "), d)
+}
+
+func (r *HandoffResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan HandoffModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "handoff", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write handoff config", err, "handoff")
+		return
+	}
+
+	plan.ID = types.StringValue("handoff")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *HandoffResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state HandoffModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "handoff")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read handoff config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("handoff")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *HandoffResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan HandoffModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "handoff", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write handoff config", err, "handoff")
+		return
+	}
+
+	plan.ID = types.StringValue("handoff")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *HandoffResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "handoff", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete handoff config", err.Error())
+		return
+	}
+}
+
+func (r *HandoffResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "handoff")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import handoff config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Handoff not configured", "The config has no handoff section to import.")
+		return
+	}
+
+	state := HandoffModel{
+		TriggerPhrases: types.ListNull(types.StringType),
+		OfficeDays:     types.ListNull(types.StringType),
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("handoff")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+// modelToMap patches unset attributes to null, so that removing office
+// hours from the configuration makes operators available at any time.
+func (r *HandoffResource) modelToMap(ctx context.Context, m HandoffModel) map[string]any {
+	operator := make(map[string]any)
+	setIfString(operator, "channel", m.OperatorChannel)
+	setIfString(operator, "peer", m.OperatorPeer)
+
+	d := map[string]any{"operator": operator, "officeHours": nil}
+	setIfStringList(ctx, d, "triggers", m.TriggerPhrases)
+	setIfBool(d, "autoResume", m.AutoResume)
+	setIfInt64(d, "resumeAfterMinutes", m.ResumeAfterMinutes)
+
+	if !m.OfficeHours.IsNull() && !m.OfficeHours.IsUnknown() {
+		office := map[string]any{"window": m.OfficeHours.ValueString()}
+		setIfStringList(ctx, office, "days", m.OfficeDays)
+		setIfString(office, "timezone", m.OfficeTimezone)
+		d["officeHours"] = withNullKeys(office, "days", "timezone")
+	}
+	return withNullKeys(d, "triggers", "autoResume", "resumeAfterMinutes")
+}
+
+func (r *HandoffResource) mapToModel(ctx context.Context, s map[string]any, m *HandoffModel) {
+	readStringList(ctx, s, "triggers", &m.TriggerPhrases)
+	if operator, ok := s["operator"].(map[string]any); ok {
+		readString(operator, "channel", &m.OperatorChannel)
+		readString(operator, "peer", &m.OperatorPeer)
+	}
+	if office, ok := s["officeHours"].(map[string]any); ok {
+		readString(office, "window", &m.OfficeHours)
+		readStringList(ctx, office, "days", &m.OfficeDays)
+		readString(office, "timezone", &m.OfficeTimezone)
+	}
+	readBool(s, "autoResume", &m.AutoResume)
+	readFloat64AsInt64(s, "resumeAfterMinutes", &m.ResumeAfterMinutes)
+}
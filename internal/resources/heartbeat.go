@@ -0,0 +1,380 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &HeartbeatResource{}
+var _ resource.ResourceWithImportState = &HeartbeatResource{}
+var _ resource.ResourceWithValidateConfig = &HeartbeatResource{}
+
+type HeartbeatResource struct {
+	gatewayTarget
+}
+
+type HeartbeatModel struct {
+	ID               types.String `tfsdk:"id"`
+	Gateway          types.String `tfsdk:"gateway"`
+	AgentID          types.String `tfsdk:"agent_id"`
+	Every            types.String `tfsdk:"every"`
+	Target           types.String `tfsdk:"target"`
+	Prompt           types.String `tfsdk:"prompt"`
+	ActiveHoursStart types.String `tfsdk:"active_hours_start"`
+	ActiveHoursEnd   types.String `tfsdk:"active_hours_end"`
+}
+
+// heartbeatKeys are the keys of a heartbeat object.
+var heartbeatKeys = []string{"every", "target", "prompt", "activeHours"}
+
+// heartbeatDefaultsID is the ID of the heartbeat in agents.defaults.
+const heartbeatDefaultsID = "defaults"
+
+func NewHeartbeatResource() resource.Resource {
+	return &HeartbeatResource{}
+}
+
+func (r *HeartbeatResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_heartbeat"
+}
+
+func (r *HeartbeatResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the heartbeat of all agents (agents.defaults.heartbeat) or of one agent (its agents.list[] entry).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "defaults, or agent:<agent_id> for one agent.",
+				Computed:    true,
+			},
+			"gateway": gatewayAttribute(),
+			"agent_id": schema.StringAttribute{
+				Description: "Agent whose heartbeat this is. The agent must already exist. Default heartbeat for all agents when unset.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"every": schema.StringAttribute{
+				Description: "Interval between heartbeats, as a duration (e.g. 30m, 2h, 1h30m). 0m disables heartbeats.",
+				Required:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "Where heartbeat output is delivered: last (the last active chat), a channel name (e.g. whatsapp) or none.",
+				Optional:    true,
+			},
+			"prompt": schema.StringAttribute{
+				Description: "Prompt the agent runs on each heartbeat. The gateway's default prompt is used when unset.",
+				Optional:    true,
+			},
+			"active_hours_start": schema.StringAttribute{
+				Description: "Time of day heartbeats start, as HH:MM. Set together with active_hours_end.",
+				Optional:    true,
+			},
+			"active_hours_end": schema.StringAttribute{
+				Description: "Time of day heartbeats stop, as HH:MM. May be earlier than active_hours_start to wrap past midnight.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *HeartbeatResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the interval and the active hours, which must be set
+// together.
+func (r *HeartbeatResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config HeartbeatModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if e := config.Every; !e.IsNull() && !e.IsUnknown() && !validDuration(e.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("every"), "Invalid duration",
+			fmt.Sprintf("every must be a duration such as 30m, 2h or 1h30m, got %q", e.ValueString()))
+	}
+
+	start, end := config.ActiveHoursStart, config.ActiveHoursEnd
+	switch {
+	case start.IsNull() && end.IsNull():
+	case start.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("active_hours_start"), "Missing active hours start",
+			"Set active_hours_start together with active_hours_end.")
+	case end.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("active_hours_end"), "Missing active hours end",
+			"Set active_hours_end together with active_hours_start.")
+	case start.IsUnknown() || end.IsUnknown():
+	case !validApplyWindow(start.ValueString() + "-" + end.ValueString()):
+		resp.Diagnostics.AddAttributeError(path.Root("active_hours_start"), "Invalid active hours",
+			fmt.Sprintf("active_hours_start and active_hours_end must be different times as HH:MM (e.g. 08:00 and 22:00), got %q and %q",
+				start.ValueString(), end.ValueString()))
+	}
+}
+
+// validDuration reports whether s is a non-negative duration made of hours,
+// minutes and seconds, such as 30m or 1h30m.
+func validDuration(s string) bool {
+	if strings.ContainsAny(s, "+-.") {
+		return false
+	}
+	_, err := time.ParseDuration(s)
+	return err == nil
+}
+
+func (r *HeartbeatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan HeartbeatModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeHeartbeat(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *HeartbeatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state HeartbeatModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	heartbeat, err := r.readHeartbeat(ctx, state.AgentID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read heartbeat config", err.Error())
+		return
+	}
+	if heartbeat == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(heartbeat, &state)
+	state.ID = types.StringValue(heartbeatID(state.AgentID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *HeartbeatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan HeartbeatModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeHeartbeat(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *HeartbeatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state HeartbeatModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.AgentID.IsNull() {
+		cfg, err := r.client.GetConfig(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read config", err.Error())
+			return
+		}
+		if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "agents", "defaults", "heartbeat"); err != nil {
+			resp.Diagnostics.AddError("Failed to delete heartbeat config", err.Error())
+		}
+		return
+	}
+
+	list, hash, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read agents list", err.Error())
+		return
+	}
+	idx := findAgentIndex(list, state.AgentID.ValueString())
+	if idx < 0 {
+		return
+	}
+	entry, ok := list[idx].(map[string]any)
+	if !ok {
+		return
+	}
+	if _, ok := entry["heartbeat"]; !ok {
+		return
+	}
+	delete(entry, "heartbeat")
+
+	if err := writeAgentsList(ctx, r.client, list, hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete heartbeat config", err.Error())
+		return
+	}
+}
+
+// ImportState takes defaults for the default heartbeat, or agent:<agent_id>.
+func (r *HeartbeatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, id, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	state := HeartbeatModel{AgentID: types.StringNull()}
+	if id != heartbeatDefaultsID {
+		agentID, found := strings.CutPrefix(id, "agent:")
+		if !found || agentID == "" {
+			resp.Diagnostics.AddError("Invalid import ID",
+				fmt.Sprintf("Expected defaults or agent:<agent_id>, got %q", id))
+			return
+		}
+		state.AgentID = types.StringValue(agentID)
+	}
+
+	heartbeat, err := r.readHeartbeat(ctx, state.AgentID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import heartbeat config", err.Error())
+		return
+	}
+	if heartbeat == nil {
+		resp.Diagnostics.AddError("Heartbeat not found", fmt.Sprintf("No heartbeat configured for %q", id))
+		return
+	}
+
+	r.mapToModel(heartbeat, &state)
+	state.ID = types.StringValue(id)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func heartbeatID(agentID types.String) string {
+	if agentID.IsNull() {
+		return heartbeatDefaultsID
+	}
+	return "agent:" + agentID.ValueString()
+}
+
+// readHeartbeat returns the default heartbeat when agentID is null, or that
+// agent's heartbeat, or nil when there is none.
+func (r *HeartbeatResource) readHeartbeat(ctx context.Context, agentID types.String) (map[string]any, error) {
+	if agentID.IsNull() {
+		section, _, err := client.GetNestedSection(ctx, r.client, "agents", "defaults", "heartbeat")
+		return section, err
+	}
+	list, _, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	idx := findAgentIndex(list, agentID.ValueString())
+	if idx < 0 {
+		return nil, nil
+	}
+	entry, _ := list[idx].(map[string]any)
+	heartbeat, _ := entry["heartbeat"].(map[string]any)
+	return heartbeat, nil
+}
+
+// writeHeartbeat writes the planned heartbeat. The default heartbeat is
+// patched with unset keys nulled; an agent's heartbeat replaces the one in
+// its entry, leaving the rest of the entry as it is.
+func (r *HeartbeatResource) writeHeartbeat(ctx context.Context, plan tfsdk.Plan, m *HeartbeatModel, diags *diag.Diagnostics) bool {
+	if m.AgentID.IsNull() {
+		cfg, err := r.client.GetConfig(ctx)
+		if err != nil {
+			diags.AddError("Failed to read config", err.Error())
+			return false
+		}
+		heartbeat := withNullKeys(r.modelToMap(*m), heartbeatKeys...)
+		if err := client.PatchNestedSection(ctx, r.client, heartbeat, cfg.Hash, "agents", "defaults", "heartbeat"); err != nil {
+			addWriteError(ctx, diags, plan, "Failed to write heartbeat config", err, "agents", "defaults", "heartbeat")
+			return false
+		}
+		m.ID = types.StringValue(heartbeatDefaultsID)
+		return true
+	}
+
+	list, hash, err := getAgentsList(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read agents list", err.Error())
+		return false
+	}
+	agentID := m.AgentID.ValueString()
+	idx := findAgentIndex(list, agentID)
+	if idx < 0 {
+		diags.AddError("Agent not found",
+			fmt.Sprintf("No agent with id %q in agents.list. Create it with openclaw_agent before setting its heartbeat.", agentID))
+		return false
+	}
+	entry, ok := list[idx].(map[string]any)
+	if !ok {
+		diags.AddError("Agent entry is not an object", "")
+		return false
+	}
+	entry["heartbeat"] = r.modelToMap(*m)
+
+	if err := writeAgentsList(ctx, r.client, list, hash); err != nil {
+		addWriteError(ctx, diags, plan, "Failed to write heartbeat config", err, "agents", "list", strconv.Itoa(idx), "heartbeat")
+		return false
+	}
+	m.ID = types.StringValue(heartbeatID(m.AgentID))
+	return true
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+func (r *HeartbeatResource) modelToMap(m HeartbeatModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "every", m.Every)
+	setIfString(d, "target", m.Target)
+	setIfString(d, "prompt", m.Prompt)
+	if !m.ActiveHoursStart.IsNull() && !m.ActiveHoursEnd.IsNull() {
+		d["activeHours"] = map[string]any{
+			"start": m.ActiveHoursStart.ValueString(),
+			"end":   m.ActiveHoursEnd.ValueString(),
+		}
+	}
+	return d
+}
+
+func (r *HeartbeatResource) mapToModel(s map[string]any, m *HeartbeatModel) {
+	readString(s, "every", &m.Every)
+	readString(s, "target", &m.Target)
+	readString(s, "prompt", &m.Prompt)
+	if hours, ok := s["activeHours"].(map[string]any); ok {
+		readString(hours, "start", &m.ActiveHoursStart)
+		readString(hours, "end", &m.ActiveHoursEnd)
+	}
+}
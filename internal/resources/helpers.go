@@ -2,9 +2,16 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"strings"
+	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
 )
 
 // isConnectionClosed returns true if the error indicates the WebSocket
@@ -14,12 +21,85 @@ func isConnectionClosed(err error) bool {
 	if err == nil {
 		return false
 	}
+	if errors.Is(err, client.ErrConnectionLost) {
+		return true
+	}
 	msg := err.Error()
 	return strings.Contains(msg, "connection closed") ||
 		strings.Contains(msg, "websocket: close") ||
 		strings.Contains(msg, "use of closed network connection")
 }
 
+// addWriteError reports a failed config write. A strict_concurrency
+// rejection is reported as drift. When the gateway's config.validate
+// rejected the write, each issue inside section (the config keys the
+// resource writes under) is attached to the attribute it concerns, so
+// Terraform points at the offending line.
+func addWriteError(ctx context.Context, diags *diag.Diagnostics, plan tfsdk.Plan, summary string, err error, section ...string) {
+	var changed *client.ConfigChangedError
+	if errors.As(err, &changed) {
+		diags.AddError("Config changed outside Terraform", err.Error()+". Run terraform plan again to "+
+			"review the change, or set strict_concurrency = false to merge over it.")
+		return
+	}
+	var verr *client.ValidationError
+	if !errors.As(err, &verr) {
+		diags.AddError(summary, err.Error())
+		return
+	}
+	for _, issue := range verr.Issues {
+		if p, ok := issueAttrPath(ctx, plan, issue.Path, section); ok {
+			diags.AddAttributeError(p, summary, issue.Message)
+			continue
+		}
+		detail := issue.Message
+		if len(issue.Path) > 0 {
+			detail = strings.Join(issue.Path, ".") + ": " + detail
+		}
+		diags.AddError(summary, detail)
+	}
+}
+
+// issueAttrPath maps a config path under section to the deepest attribute
+// whose name is the snake_case form of the keys along it.
+func issueAttrPath(ctx context.Context, plan tfsdk.Plan, keys, section []string) (path.Path, bool) {
+	if len(section) == 0 || len(keys) <= len(section) {
+		return path.Empty(), false
+	}
+	for i, k := range section {
+		if keys[i] != k {
+			return path.Empty(), false
+		}
+	}
+	var p path.Path
+	for i, k := range keys[len(section):] {
+		next := path.Root(snakeCase(k))
+		if i > 0 {
+			next = p.AtName(snakeCase(k))
+		}
+		if _, diags := plan.Schema.AttributeAtPath(ctx, next); diags.HasError() {
+			return p, i > 0
+		}
+		p = next
+	}
+	return p, true
+}
+
+// snakeCase converts a camelCase config key to its attribute name.
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 // ── Model → Map helpers (for writing config) ────────────────
 
 func setIfString(m map[string]any, key string, val types.String) {
@@ -40,6 +120,12 @@ func setIfInt64(m map[string]any, key string, val types.Int64) {
 	}
 }
 
+func setIfFloat64(m map[string]any, key string, val types.Float64) {
+	if !val.IsNull() && !val.IsUnknown() {
+		m[key] = val.ValueFloat64()
+	}
+}
+
 func setIfStringList(ctx context.Context, m map[string]any, key string, val types.List) {
 	if !val.IsNull() && !val.IsUnknown() {
 		var strs []string
@@ -48,6 +134,30 @@ func setIfStringList(ctx context.Context, m map[string]any, key string, val type
 	}
 }
 
+func setIfStringMap(ctx context.Context, m map[string]any, key string, val types.Map) {
+	if !val.IsNull() && !val.IsUnknown() {
+		var strs map[string]string
+		val.ElementsAs(ctx, &strs, false)
+		m[key] = strs
+	}
+}
+
+func setIfFloat64Map(ctx context.Context, m map[string]any, key string, val types.Map) {
+	if !val.IsNull() && !val.IsUnknown() {
+		var nums map[string]float64
+		val.ElementsAs(ctx, &nums, false)
+		m[key] = nums
+	}
+}
+
+func setIfBoolMap(ctx context.Context, m map[string]any, key string, val types.Map) {
+	if !val.IsNull() && !val.IsUnknown() {
+		var bools map[string]bool
+		val.ElementsAs(ctx, &bools, false)
+		m[key] = bools
+	}
+}
+
 // ── Map → Model helpers (for reading config) ────────────────
 
 func readString(m map[string]any, key string, target *types.String) {
@@ -68,6 +178,12 @@ func readFloat64AsInt64(m map[string]any, key string, target *types.Int64) {
 	}
 }
 
+func readFloat64(m map[string]any, key string, target *types.Float64) {
+	if v, ok := m[key].(float64); ok {
+		*target = types.Float64Value(v)
+	}
+}
+
 func readStringList(ctx context.Context, m map[string]any, key string, target *types.List) {
 	if v, ok := m[key].([]any); ok {
 		strs := make([]string, 0, len(v))
@@ -80,3 +196,57 @@ func readStringList(ctx context.Context, m map[string]any, key string, target *t
 		*target = list
 	}
 }
+
+// readStringListIfSet is readStringList for a list other resources may also
+// edit, such as allowFrom with openclaw_allowlist_entry: a list left unset in
+// the configuration stays null rather than taking on their entries. On
+// import there is no configuration to go by, so ImportState marks the list
+// unknown and it is read in full, or set null if the config has none.
+func readStringListIfSet(ctx context.Context, m map[string]any, key string, target *types.List) {
+	if target.IsNull() {
+		return
+	}
+	readStringList(ctx, m, key, target)
+	if target.IsUnknown() {
+		*target = types.ListNull(types.StringType)
+	}
+}
+
+func readStringMap(ctx context.Context, m map[string]any, key string, target *types.Map) {
+	if v, ok := m[key].(map[string]any); ok {
+		strs := make(map[string]string, len(v))
+		for k, s := range v {
+			if str, ok := s.(string); ok {
+				strs[k] = str
+			}
+		}
+		mv, _ := types.MapValueFrom(ctx, types.StringType, strs)
+		*target = mv
+	}
+}
+
+func readFloat64Map(ctx context.Context, m map[string]any, key string, target *types.Map) {
+	if v, ok := m[key].(map[string]any); ok {
+		nums := make(map[string]float64, len(v))
+		for k, n := range v {
+			if f, ok := n.(float64); ok {
+				nums[k] = f
+			}
+		}
+		mv, _ := types.MapValueFrom(ctx, types.Float64Type, nums)
+		*target = mv
+	}
+}
+
+func readBoolMap(ctx context.Context, m map[string]any, key string, target *types.Map) {
+	if v, ok := m[key].(map[string]any); ok {
+		bools := make(map[string]bool, len(v))
+		for k, b := range v {
+			if b, ok := b.(bool); ok {
+				bools[k] = b
+			}
+		}
+		mv, _ := types.MapValueFrom(ctx, types.BoolType, bools)
+		*target = mv
+	}
+}
@@ -17,11 +17,12 @@ var _ resource.Resource = &HookResource{}
 var _ resource.ResourceWithImportState = &HookResource{}
 
 type HookResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type HookModel struct {
 	ID                types.String `tfsdk:"id"`
+	Gateway           types.String `tfsdk:"gateway"`
 	Enabled           types.Bool   `tfsdk:"enabled"`
 	Token             types.String `tfsdk:"token"`
 	Path              types.String `tfsdk:"path"`
@@ -40,7 +41,8 @@ func (r *HookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw hooks configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Enable or disable hooks.",
 				Optional:    true,
@@ -73,10 +75,13 @@ func (r *HookResource) Configure(_ context.Context, req resource.ConfigureReques
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *HookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan HookModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -88,7 +93,7 @@ func (r *HookResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "hooks"); err != nil {
-		resp.Diagnostics.AddError("Failed to write hooks config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write hooks config", err, "hooks")
 		return
 	}
 	plan.ID = types.StringValue("hooks")
@@ -96,6 +101,9 @@ func (r *HookResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *HookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state HookModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -116,6 +124,9 @@ func (r *HookResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *HookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan HookModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -127,26 +138,36 @@ func (r *HookResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "hooks"); err != nil {
-		resp.Diagnostics.AddError("Failed to write hooks config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write hooks config", err, "hooks")
 		return
 	}
 	plan.ID = types.StringValue("hooks")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *HookResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *HookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
 		return
 	}
-	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "hooks"); err != nil {
+	// Clear only the settings this resource manages: endpoints under
+	// hooks.endpoints belong to openclaw_hook_endpoint resources.
+	unset := map[string]any{"enabled": nil, "token": nil, "path": nil, "defaultSessionKey": nil}
+	if err := client.PatchNestedSection(ctx, r.client, unset, cfg.Hash, "hooks"); err != nil {
 		resp.Diagnostics.AddError("Failed to delete hooks config", err.Error())
 		return
 	}
 }
 
-func (r *HookResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *HookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "hooks")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import hooks config", err.Error())
@@ -157,6 +178,7 @@ func (r *HookResource) ImportState(ctx context.Context, _ resource.ImportStateRe
 		r.mapToModel(section, &state)
 	}
 	state.ID = types.StringValue("hooks")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
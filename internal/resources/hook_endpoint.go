@@ -0,0 +1,230 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &HookEndpointResource{}
+var _ resource.ResourceWithImportState = &HookEndpointResource{}
+
+type HookEndpointResource struct {
+	gatewayTarget
+}
+
+type HookEndpointModel struct {
+	ID         types.String `tfsdk:"id"`
+	Gateway    types.String `tfsdk:"gateway"`
+	Name       types.String `tfsdk:"name"`
+	Path       types.String `tfsdk:"path"`
+	Token      types.String `tfsdk:"token"`
+	AgentID    types.String `tfsdk:"agent_id"`
+	SessionKey types.String `tfsdk:"session_key"`
+	Methods    types.List   `tfsdk:"methods"`
+	Template   types.String `tfsdk:"template"`
+}
+
+func NewHookEndpointResource() resource.Resource {
+	return &HookEndpointResource{}
+}
+
+func (r *HookEndpointResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hook_endpoint"
+}
+
+func (r *HookEndpointResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single OpenClaw webhook endpoint under hooks.endpoints.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Unique endpoint name. Used as the key under hooks.endpoints.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the endpoint, relative to the hooks path prefix (e.g. github).",
+				Required:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Token required by this endpoint, in place of the global hooks token. Sensitive.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"agent_id": schema.StringAttribute{
+				Description: "Agent that receives requests to this endpoint.",
+				Optional:    true,
+			},
+			"session_key": schema.StringAttribute{
+				Description: "Session key for requests to this endpoint. Overrides the hooks default_session_key.",
+				Optional:    true,
+			},
+			"methods": schema.ListAttribute{
+				Description: "HTTP methods the endpoint accepts (e.g. [\"POST\"]). The gateway default applies when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"template": schema.StringAttribute{
+				Description: "Template that turns the request payload into the message passed to the agent.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *HookEndpointResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *HookEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan HookEndpointModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "hooks", "endpoints", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write hook endpoint config", err, "hooks", "endpoints", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *HookEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state HookEndpointModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := state.Name.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "hooks", "endpoints", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read hook endpoint config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *HookEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan HookEndpointModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "hooks", "endpoints", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write hook endpoint config", err, "hooks", "endpoints", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *HookEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state HookEndpointModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := state.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "hooks", "endpoints", name); err != nil {
+		resp.Diagnostics.AddError("Failed to delete hook endpoint config", err.Error())
+		return
+	}
+}
+
+func (r *HookEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "hooks", "endpoints", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import hook endpoint config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Hook endpoint not found", fmt.Sprintf("No hook endpoint named %q in hooks.endpoints", name))
+		return
+	}
+	state := HookEndpointModel{Methods: types.ListNull(types.StringType)}
+	state.Name = types.StringValue(name)
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *HookEndpointResource) modelToMap(ctx context.Context, m HookEndpointModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "path", m.Path)
+	setIfString(d, "token", m.Token)
+	setIfString(d, "agentId", m.AgentID)
+	setIfString(d, "sessionKey", m.SessionKey)
+	setIfStringList(ctx, d, "methods", m.Methods)
+	setIfString(d, "template", m.Template)
+	return d
+}
+
+func (r *HookEndpointResource) mapToModel(ctx context.Context, s map[string]any, m *HookEndpointModel) {
+	readString(s, "path", &m.Path)
+	readString(s, "token", &m.Token)
+	readString(s, "agentId", &m.AgentID)
+	readString(s, "sessionKey", &m.SessionKey)
+	readStringList(ctx, s, "methods", &m.Methods)
+	readString(s, "template", &m.Template)
+}
@@ -0,0 +1,285 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ImageGenerationResource{}
+var _ resource.ResourceWithImportState = &ImageGenerationResource{}
+var _ resource.ResourceWithValidateConfig = &ImageGenerationResource{}
+
+type ImageGenerationResource struct {
+	gatewayTarget
+}
+
+type ImageGenerationModel struct {
+	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
+	ImageProvider  types.String `tfsdk:"image_provider"`
+	Model          types.String `tfsdk:"model"`
+	DefaultSize    types.String `tfsdk:"default_size"`
+	DefaultQuality types.String `tfsdk:"default_quality"`
+	Channels       types.Map    `tfsdk:"channels"`
+	DailyLimit     types.Int64  `tfsdk:"daily_limit"`
+}
+
+// imageGenerationKeys are the tools.imageGeneration settings this resource
+// manages.
+var imageGenerationKeys = []string{"provider", "model", "defaults", "channels", "dailyLimit"}
+
+func NewImageGenerationResource() resource.Resource {
+	return &ImageGenerationResource{}
+}
+
+func (r *ImageGenerationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_generation"
+}
+
+func (r *ImageGenerationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw image generation tool configuration under tools.imageGeneration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"image_provider": schema.StringAttribute{
+				Description: "Image generation provider (e.g. openai, google, stability).",
+				Optional:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "Image model (e.g. gpt-image-1).",
+				Optional:    true,
+			},
+			"default_size": schema.StringAttribute{
+				Description: "Size of generated images unless the agent asks for another, as WIDTHxHEIGHT (e.g. 1024x1024).",
+				Optional:    true,
+			},
+			"default_quality": schema.StringAttribute{
+				Description: "Quality of generated images unless the agent asks for another: low|medium|high.",
+				Optional:    true,
+			},
+			"channels": schema.MapAttribute{
+				Description: "Whether the tool is available, keyed by channel name (e.g. discord = false). Channels left out use the gateway default.",
+				Optional:    true,
+				ElementType: types.BoolType,
+			},
+			"daily_limit": schema.Int64Attribute{
+				Description: "Maximum images generated per day across all agents. Unlimited when unset.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ImageGenerationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the size syntax, the quality and the daily limit.
+func (r *ImageGenerationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ImageGenerationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if s := config.DefaultSize; !s.IsNull() && !s.IsUnknown() && !validImageSize(s.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("default_size"), "Invalid size",
+			fmt.Sprintf("default_size must be WIDTHxHEIGHT in pixels (e.g. 1024x1024), got %q", s.ValueString()))
+	}
+	if q := config.DefaultQuality; !q.IsNull() && !q.IsUnknown() {
+		switch q.ValueString() {
+		case "low", "medium", "high":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("default_quality"), "Invalid quality",
+				fmt.Sprintf("default_quality must be low, medium or high, got %q", q.ValueString()))
+		}
+	}
+	if v := config.DailyLimit; !v.IsNull() && !v.IsUnknown() && v.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("daily_limit"), "Invalid limit",
+			fmt.Sprintf("daily_limit must not be negative, got %d", v.ValueInt64()))
+	}
+}
+
+func (r *ImageGenerationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ImageGenerationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "tools", "imageGeneration"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write image generation config", err, "tools", "imageGeneration")
+		return
+	}
+	plan.ID = types.StringValue("image_generation")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ImageGenerationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ImageGenerationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "tools", "imageGeneration")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read image generation config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("image_generation")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ImageGenerationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan, state ImageGenerationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	m := r.modelToMap(ctx, plan)
+	// A merge patch keeps keys it doesn't mention, so channels dropped from
+	// the map are removed explicitly.
+	if cur, ok := m["channels"].(map[string]bool); ok {
+		channels := make(map[string]any)
+		for k, v := range cur {
+			channels[k] = v
+		}
+		for _, k := range removedMapKeys(ctx, state.Channels, plan.Channels) {
+			channels[k] = nil
+		}
+		m["channels"] = channels
+	}
+
+	if err := client.PatchNestedSection(ctx, r.client, m, cfg.Hash, "tools", "imageGeneration"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write image generation config", err, "tools", "imageGeneration")
+		return
+	}
+	plan.ID = types.StringValue("image_generation")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ImageGenerationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	unset := make(map[string]any, len(imageGenerationKeys))
+	for _, k := range imageGenerationKeys {
+		unset[k] = nil
+	}
+	if err := client.PatchNestedSection(ctx, r.client, unset, cfg.Hash, "tools", "imageGeneration"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete image generation config", err.Error())
+		return
+	}
+}
+
+func (r *ImageGenerationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "tools", "imageGeneration")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import image generation config", err.Error())
+		return
+	}
+	state := ImageGenerationModel{Channels: types.MapNull(types.BoolType)}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("image_generation")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// modelToMap patches unset attributes to null, so that removing one from the
+// configuration returns the setting to the gateway's default.
+func (r *ImageGenerationResource) modelToMap(ctx context.Context, m ImageGenerationModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "provider", m.ImageProvider)
+	setIfString(d, "model", m.Model)
+	setIfBoolMap(ctx, d, "channels", m.Channels)
+	setIfInt64(d, "dailyLimit", m.DailyLimit)
+
+	defaults := make(map[string]any)
+	setIfString(defaults, "size", m.DefaultSize)
+	setIfString(defaults, "quality", m.DefaultQuality)
+	if len(defaults) > 0 {
+		d["defaults"] = withNullKeys(defaults, "size", "quality")
+	}
+	return withNullKeys(d, imageGenerationKeys...)
+}
+
+func (r *ImageGenerationResource) mapToModel(ctx context.Context, s map[string]any, m *ImageGenerationModel) {
+	readString(s, "provider", &m.ImageProvider)
+	readString(s, "model", &m.Model)
+	readBoolMap(ctx, s, "channels", &m.Channels)
+	readFloat64AsInt64(s, "dailyLimit", &m.DailyLimit)
+
+	if defaults, ok := s["defaults"].(map[string]any); ok {
+		readString(defaults, "size", &m.DefaultSize)
+		readString(defaults, "quality", &m.DefaultQuality)
+	}
+}
+
+// validImageSize reports whether s is WIDTHxHEIGHT with positive sizes.
+func validImageSize(s string) bool {
+	w, h, ok := strings.Cut(s, "x")
+	if !ok {
+		return false
+	}
+	for _, n := range []string{w, h} {
+		if v, err := strconv.Atoi(n); err != nil || v <= 0 {
+			return false
+		}
+	}
+	return true
+}
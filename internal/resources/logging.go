@@ -0,0 +1,261 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &LoggingResource{}
+var _ resource.ResourceWithImportState = &LoggingResource{}
+
+type LoggingResource struct {
+	gatewayTarget
+}
+
+type LoggingModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Level           types.String `tfsdk:"level"`
+	Format          types.String `tfsdk:"format"`
+	File            types.String `tfsdk:"file"`
+	MaxSizeMB       types.Int64  `tfsdk:"max_size_mb"`
+	MaxAgeDays      types.Int64  `tfsdk:"max_age_days"`
+	SubsystemLevels types.Map    `tfsdk:"subsystem_levels"`
+}
+
+func NewLoggingResource() resource.Resource {
+	return &LoggingResource{}
+}
+
+func (r *LoggingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_logging"
+}
+
+func (r *LoggingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw gateway logging configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"level": schema.StringAttribute{
+				Description: "Log level: trace|debug|info|warn|error.",
+				Optional:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Log format: json|text.",
+				Optional:    true,
+			},
+			"file": schema.StringAttribute{
+				Description: "Path of the log file. Logs go to stdout when unset.",
+				Optional:    true,
+			},
+			"max_size_mb": schema.Int64Attribute{
+				Description: "Size in megabytes at which the log file is rotated.",
+				Optional:    true,
+			},
+			"max_age_days": schema.Int64Attribute{
+				Description: "Days to keep rotated log files.",
+				Optional:    true,
+			},
+			"subsystem_levels": schema.MapAttribute{
+				Description: "Log level overrides by subsystem (e.g. { \"channels.telegram\" = \"debug\" }).",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *LoggingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *LoggingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan LoggingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "logging", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write logging config", err, "logging")
+		return
+	}
+
+	plan.ID = types.StringValue("logging")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LoggingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state LoggingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "logging")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read logging config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("logging")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LoggingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan, state LoggingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	m := r.modelToMap(ctx, plan)
+	// A merge patch keeps keys it doesn't mention, so overrides dropped from
+	// the configuration are removed explicitly.
+	if removed := removedMapKeys(ctx, state.SubsystemLevels, plan.SubsystemLevels); len(removed) > 0 {
+		subs := make(map[string]any)
+		if cur, ok := m["subsystems"].(map[string]string); ok {
+			for k, v := range cur {
+				subs[k] = v
+			}
+		}
+		for _, k := range removed {
+			subs[k] = nil
+		}
+		m["subsystems"] = subs
+	}
+
+	if err := client.PatchSection(ctx, r.client, "logging", m, cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write logging config", err, "logging")
+		return
+	}
+
+	plan.ID = types.StringValue("logging")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LoggingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "logging", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete logging config", err.Error())
+		return
+	}
+}
+
+func (r *LoggingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "logging")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import logging config", err.Error())
+		return
+	}
+
+	state := LoggingModel{SubsystemLevels: types.MapNull(types.StringType)}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("logging")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+func (r *LoggingResource) modelToMap(ctx context.Context, m LoggingModel) map[string]any {
+	d := make(map[string]any)
+
+	setIfString(d, "level", m.Level)
+	setIfString(d, "format", m.Format)
+	setIfString(d, "file", m.File)
+	setIfStringMap(ctx, d, "subsystems", m.SubsystemLevels)
+
+	rotation := make(map[string]any)
+	setIfInt64(rotation, "maxSizeMb", m.MaxSizeMB)
+	setIfInt64(rotation, "maxAgeDays", m.MaxAgeDays)
+	if len(rotation) > 0 {
+		d["rotation"] = rotation
+	}
+
+	return d
+}
+
+func (r *LoggingResource) mapToModel(ctx context.Context, s map[string]any, m *LoggingModel) {
+	readString(s, "level", &m.Level)
+	readString(s, "format", &m.Format)
+	readString(s, "file", &m.File)
+	readStringMap(ctx, s, "subsystems", &m.SubsystemLevels)
+
+	if rotation, ok := s["rotation"].(map[string]any); ok {
+		readFloat64AsInt64(rotation, "maxSizeMb", &m.MaxSizeMB)
+		readFloat64AsInt64(rotation, "maxAgeDays", &m.MaxAgeDays)
+	}
+}
+
+// removedMapKeys returns the keys of prior that are missing from next.
+func removedMapKeys(_ context.Context, prior, next types.Map) []string {
+	if prior.IsNull() || prior.IsUnknown() {
+		return nil
+	}
+	after := next.Elements()
+	var removed []string
+	for k := range prior.Elements() {
+		if _, ok := after[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	return removed
+}
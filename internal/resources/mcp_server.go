@@ -0,0 +1,282 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &MCPServerResource{}
+var _ resource.ResourceWithImportState = &MCPServerResource{}
+var _ resource.ResourceWithValidateConfig = &MCPServerResource{}
+
+type MCPServerResource struct {
+	gatewayTarget
+}
+
+type MCPServerModel struct {
+	ID           types.String `tfsdk:"id"`
+	Gateway      types.String `tfsdk:"gateway"`
+	Name         types.String `tfsdk:"name"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Transport    types.String `tfsdk:"transport"`
+	Command      types.String `tfsdk:"command"`
+	Args         types.List   `tfsdk:"args"`
+	URL          types.String `tfsdk:"url"`
+	Env          types.Map    `tfsdk:"env"`
+	AllowedTools types.List   `tfsdk:"allowed_tools"`
+}
+
+func NewMCPServerResource() resource.Resource {
+	return &MCPServerResource{}
+}
+
+func (r *MCPServerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mcp_server"
+}
+
+func (r *MCPServerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an MCP server entry under mcp.servers.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Server name. Used as the key under mcp.servers.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable this server.",
+				Optional:    true,
+			},
+			"transport": schema.StringAttribute{
+				Description: "How the gateway talks to the server: stdio (default) or sse.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("stdio"),
+			},
+			"command": schema.StringAttribute{
+				Description: "Command that starts the server. Required for the stdio transport.",
+				Optional:    true,
+			},
+			"args": schema.ListAttribute{
+				Description: "Arguments passed to command.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"url": schema.StringAttribute{
+				Description: "Server endpoint. Required for the sse transport.",
+				Optional:    true,
+			},
+			"env": schema.MapAttribute{
+				Description: "Environment variables set for the server process. Sensitive.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"allowed_tools": schema.ListAttribute{
+				Description: "Tools agents may call on this server. All of its tools when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the server has the command or URL its
+// transport needs.
+func (r *MCPServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config MCPServerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Transport.IsUnknown() {
+		return
+	}
+
+	switch transport := config.Transport.ValueString(); transport {
+	case "", "stdio":
+		if config.Command.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("command"), "Missing command",
+				"The stdio transport starts the server as a process. Set command.")
+		}
+		if !config.URL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "Unexpected url",
+				"url is only used by the sse transport.")
+		}
+	case "sse":
+		if config.URL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "Missing url",
+				"The sse transport connects to a running server. Set url.")
+		}
+		if !config.Command.IsNull() || !config.Args.IsNull() || !config.Env.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("command"), "Unexpected command",
+				"command, args and env are only used by the stdio transport.")
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("transport"), "Invalid transport",
+			fmt.Sprintf("transport must be stdio or sse, got %q.", transport))
+	}
+}
+
+func (r *MCPServerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan MCPServerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "mcp", "servers", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write MCP server config", err, "mcp", "servers", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MCPServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state MCPServerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := state.Name.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "mcp", "servers", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read MCP server config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan MCPServerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "mcp", "servers", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write MCP server config", err, "mcp", "servers", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state MCPServerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "mcp", "servers", state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete MCP server config", err.Error())
+		return
+	}
+}
+
+func (r *MCPServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "mcp", "servers", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import MCP server config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("MCP server not found", fmt.Sprintf("No server %q in mcp.servers", name))
+		return
+	}
+	state := MCPServerModel{
+		Args:         types.ListNull(types.StringType),
+		Env:          types.MapNull(types.StringType),
+		AllowedTools: types.ListNull(types.StringType),
+		Transport:    types.StringValue("stdio"),
+	}
+	state.Name = types.StringValue(name)
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *MCPServerResource) modelToMap(ctx context.Context, m MCPServerModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "transport", m.Transport)
+	setIfString(d, "command", m.Command)
+	setIfStringList(ctx, d, "args", m.Args)
+	setIfString(d, "url", m.URL)
+	setIfStringMap(ctx, d, "env", m.Env)
+	setIfStringList(ctx, d, "allowedTools", m.AllowedTools)
+	return d
+}
+
+func (r *MCPServerResource) mapToModel(ctx context.Context, s map[string]any, m *MCPServerModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "transport", &m.Transport)
+	readString(s, "command", &m.Command)
+	readStringList(ctx, s, "args", &m.Args)
+	readString(s, "url", &m.URL)
+	readStringMap(ctx, s, "env", &m.Env)
+	readStringList(ctx, s, "allowedTools", &m.AllowedTools)
+}
@@ -0,0 +1,228 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &MemoryResource{}
+var _ resource.ResourceWithImportState = &MemoryResource{}
+
+type MemoryResource struct {
+	gatewayTarget
+}
+
+type MemoryModel struct {
+	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Backend        types.String `tfsdk:"backend"`
+	EmbeddingModel types.String `tfsdk:"embedding_model"`
+	MaxEntries     types.Int64  `tfsdk:"max_entries"`
+	Retention      types.String `tfsdk:"retention"`
+	Scope          types.String `tfsdk:"scope"`
+}
+
+func NewMemoryResource() resource.Resource {
+	return &MemoryResource{}
+}
+
+func (r *MemoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_memory"
+}
+
+func (r *MemoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw memory configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable long-term memory.",
+				Optional:    true,
+			},
+			"backend": schema.StringAttribute{
+				Description: "Storage backend for memories (e.g. builtin, lancedb).",
+				Optional:    true,
+			},
+			"embedding_model": schema.StringAttribute{
+				Description: "Embedding model in provider/model format (e.g. openai/text-embedding-3-small).",
+				Optional:    true,
+			},
+			"max_entries": schema.Int64Attribute{
+				Description: "Maximum number of stored memories. The oldest are evicted first.",
+				Optional:    true,
+			},
+			"retention": schema.StringAttribute{
+				Description: "How long memories are kept, as a duration string (e.g. 90d).",
+				Optional:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Memory scope: agent (each agent has its own memories)|shared.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *MemoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *MemoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan MemoryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "memory", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write memory config", err, "memory")
+		return
+	}
+
+	plan.ID = types.StringValue("memory")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MemoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state MemoryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "memory")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read memory config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue("memory")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *MemoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan MemoryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "memory", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write memory config", err, "memory")
+		return
+	}
+
+	plan.ID = types.StringValue("memory")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MemoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "memory", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete memory config", err.Error())
+		return
+	}
+}
+
+func (r *MemoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "memory")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import memory config", err.Error())
+		return
+	}
+
+	var state MemoryModel
+	if section != nil {
+		r.mapToModel(section, &state)
+	}
+	state.ID = types.StringValue("memory")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+func (r *MemoryResource) modelToMap(m MemoryModel) map[string]any {
+	d := make(map[string]any)
+
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "backend", m.Backend)
+	setIfInt64(d, "maxEntries", m.MaxEntries)
+	setIfString(d, "retention", m.Retention)
+	setIfString(d, "scope", m.Scope)
+
+	embedding := make(map[string]any)
+	setIfString(embedding, "model", m.EmbeddingModel)
+	if len(embedding) > 0 {
+		d["embedding"] = embedding
+	}
+
+	return d
+}
+
+func (r *MemoryResource) mapToModel(s map[string]any, m *MemoryModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "backend", &m.Backend)
+	readFloat64AsInt64(s, "maxEntries", &m.MaxEntries)
+	readString(s, "retention", &m.Retention)
+	readString(s, "scope", &m.Scope)
+
+	if embedding, ok := s["embedding"].(map[string]any); ok {
+		readString(embedding, "model", &m.EmbeddingModel)
+	}
+}
@@ -17,11 +17,12 @@ var _ resource.Resource = &MessagesResource{}
 var _ resource.ResourceWithImportState = &MessagesResource{}
 
 type MessagesResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type MessagesModel struct {
 	ID                types.String `tfsdk:"id"`
+	Gateway           types.String `tfsdk:"gateway"`
 	ResponsePrefix    types.String `tfsdk:"response_prefix"`
 	AckReaction       types.String `tfsdk:"ack_reaction"`
 	AckReactionScope  types.String `tfsdk:"ack_reaction_scope"`
@@ -43,7 +44,8 @@ func (r *MessagesResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw messages configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"response_prefix": schema.StringAttribute{
 				Description: "Prefix prepended to every agent response.",
 				Optional:    true,
@@ -91,10 +93,13 @@ func (r *MessagesResource) Configure(_ context.Context, req resource.ConfigureRe
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *MessagesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan MessagesModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -108,7 +113,7 @@ func (r *MessagesResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	if err := client.PatchSection(ctx, r.client, "messages", r.modelToMap(plan), cfg.Hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write messages config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write messages config", err, "messages")
 		return
 	}
 
@@ -117,6 +122,9 @@ func (r *MessagesResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *MessagesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state MessagesModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -139,6 +147,9 @@ func (r *MessagesResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *MessagesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan MessagesModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -152,7 +163,7 @@ func (r *MessagesResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	if err := client.PatchSection(ctx, r.client, "messages", r.modelToMap(plan), cfg.Hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write messages config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write messages config", err, "messages")
 		return
 	}
 
@@ -160,7 +171,10 @@ func (r *MessagesResource) Update(ctx context.Context, req resource.UpdateReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *MessagesResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *MessagesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
@@ -173,7 +187,11 @@ func (r *MessagesResource) Delete(ctx context.Context, _ resource.DeleteRequest,
 	}
 }
 
-func (r *MessagesResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *MessagesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetSection(ctx, r.client, "messages")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import messages config", err.Error())
@@ -185,6 +203,7 @@ func (r *MessagesResource) ImportState(ctx context.Context, _ resource.ImportSta
 		r.mapToModel(section, &state)
 	}
 	state.ID = types.StringValue("messages")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
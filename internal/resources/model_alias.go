@@ -0,0 +1,185 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ModelAliasResource{}
+var _ resource.ResourceWithImportState = &ModelAliasResource{}
+
+type ModelAliasResource struct {
+	gatewayTarget
+}
+
+type ModelAliasModel struct {
+	ID      types.String `tfsdk:"id"`
+	Gateway types.String `tfsdk:"gateway"`
+	Alias   types.String `tfsdk:"alias"`
+	Model   types.String `tfsdk:"model"`
+}
+
+func NewModelAliasResource() resource.Resource {
+	return &ModelAliasResource{}
+}
+
+func (r *ModelAliasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_alias"
+}
+
+func (r *ModelAliasResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an OpenClaw model alias under models.aliases.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"alias": schema.StringAttribute{
+				Description: "Alias name (e.g. fast). Used as the key under models.aliases.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				Description: "Model the alias resolves to, in provider/model format (e.g. anthropic/claude-haiku-4-5).",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *ModelAliasResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ModelAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ModelAliasModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	alias := plan.Alias.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, plan.Model.ValueString(), cfg.Hash, "models", "aliases", alias); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write model alias", err, "models", "aliases", alias)
+		return
+	}
+	plan.ID = types.StringValue(alias)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ModelAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ModelAliasModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	alias := state.Alias.ValueString()
+	aliases, _, err := client.GetNestedSection(ctx, r.client, "models", "aliases")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read model aliases", err.Error())
+		return
+	}
+	model, ok := aliases[alias].(string)
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Model = types.StringValue(model)
+	state.ID = types.StringValue(alias)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ModelAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ModelAliasModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	alias := plan.Alias.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, plan.Model.ValueString(), cfg.Hash, "models", "aliases", alias); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write model alias", err, "models", "aliases", alias)
+		return
+	}
+	plan.ID = types.StringValue(alias)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ModelAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ModelAliasModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "models", "aliases", state.Alias.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete model alias", err.Error())
+		return
+	}
+}
+
+func (r *ModelAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, alias, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	aliases, _, err := client.GetNestedSection(ctx, r.client, "models", "aliases")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import model alias", err.Error())
+		return
+	}
+	model, found := aliases[alias].(string)
+	if !found {
+		resp.Diagnostics.AddError("Model alias not found", fmt.Sprintf("No model alias %q in models.aliases", alias))
+		return
+	}
+	state := ModelAliasModel{
+		ID:      types.StringValue(alias),
+		Gateway: gatewayValue(gw),
+		Alias:   types.StringValue(alias),
+		Model:   types.StringValue(model),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,261 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &NotificationRuleResource{}
+var _ resource.ResourceWithImportState = &NotificationRuleResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationRuleResource{}
+
+type NotificationRuleResource struct {
+	gatewayTarget
+}
+
+type NotificationRuleModel struct {
+	ID            types.String `tfsdk:"id"`
+	Gateway       types.String `tfsdk:"gateway"`
+	Name          types.String `tfsdk:"name"`
+	Event         types.String `tfsdk:"event"`
+	TargetChannel types.String `tfsdk:"target_channel"`
+	TargetPeer    types.String `tfsdk:"target_peer"`
+	TargetWebhook types.String `tfsdk:"target_webhook"`
+	Template      types.String `tfsdk:"template"`
+}
+
+func NewNotificationRuleResource() resource.Resource {
+	return &NotificationRuleResource{}
+}
+
+func (r *NotificationRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_rule"
+}
+
+func (r *NotificationRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a notification routing rule under notifications.rules: when an event happens, notify a channel peer or a webhook.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Unique rule name. Used as the key under notifications.rules.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"event": schema.StringAttribute{
+				Description: "Event that triggers the rule, e.g. run.error, budget.threshold, channel.disconnect. A trailing .* matches a group (channel.*).",
+				Required:    true,
+			},
+			"target_channel": schema.StringAttribute{
+				Description: "Channel the notification is sent on (e.g. telegram). Set together with target_peer.",
+				Optional:    true,
+			},
+			"target_peer": schema.StringAttribute{
+				Description: "Peer on target_channel that receives the notification (phone number, chat or user ID).",
+				Optional:    true,
+			},
+			"target_webhook": schema.StringAttribute{
+				Description: "Name of the openclaw_webhook_subscription the notification is sent to, in place of a channel peer.",
+				Optional:    true,
+			},
+			"template": schema.StringAttribute{
+				Description: "Message template. Event fields are available as {{field}} (e.g. {{agentId}}). The gateway's default message is used when unset.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *NotificationRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the event name and requires exactly one target:
+// a channel and peer, or a webhook.
+func (r *NotificationRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config NotificationRuleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if e := config.Event; !e.IsNull() && !e.IsUnknown() && !eventPattern.MatchString(e.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("event"), "Invalid event",
+			fmt.Sprintf("Event names are dotted lowercase words such as run.error, got %q", e.ValueString()))
+	}
+
+	channel, peer, webhook := !config.TargetChannel.IsNull(), !config.TargetPeer.IsNull(), !config.TargetWebhook.IsNull()
+	switch {
+	case webhook && (channel || peer):
+		resp.Diagnostics.AddAttributeError(path.Root("target_webhook"), "Conflicting targets",
+			"Set either target_webhook, or target_channel and target_peer, not both.")
+	case channel && !peer:
+		resp.Diagnostics.AddAttributeError(path.Root("target_peer"), "Missing target peer",
+			"Set target_peer together with target_channel.")
+	case peer && !channel:
+		resp.Diagnostics.AddAttributeError(path.Root("target_channel"), "Missing target channel",
+			"Set target_channel together with target_peer.")
+	case !webhook && !channel:
+		resp.Diagnostics.AddError("Missing target",
+			"Set target_webhook, or target_channel and target_peer.")
+	}
+}
+
+func (r *NotificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan NotificationRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "notifications", "rules", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write notification rule", err, "notifications", "rules", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state NotificationRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := state.Name.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "notifications", "rules", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read notification rule", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *NotificationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan NotificationRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "notifications", "rules", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write notification rule", err, "notifications", "rules", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state NotificationRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := state.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "notifications", "rules", name); err != nil {
+		resp.Diagnostics.AddError("Failed to delete notification rule", err.Error())
+		return
+	}
+}
+
+func (r *NotificationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "notifications", "rules", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import notification rule", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Notification rule not found", fmt.Sprintf("No notification rule named %q in notifications.rules", name))
+		return
+	}
+	var state NotificationRuleModel
+	state.Name = types.StringValue(name)
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(name)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// modelToMap writes every key, with unset ones as null, so that switching
+// the target from a peer to a webhook leaves no stale peer behind.
+func (r *NotificationRuleResource) modelToMap(m NotificationRuleModel) map[string]any {
+	target := make(map[string]any)
+	setIfString(target, "channel", m.TargetChannel)
+	setIfString(target, "peer", m.TargetPeer)
+	setIfString(target, "webhook", m.TargetWebhook)
+
+	d := map[string]any{"target": withNullKeys(target, "channel", "peer", "webhook")}
+	setIfString(d, "event", m.Event)
+	setIfString(d, "template", m.Template)
+	return withNullKeys(d, "event", "template")
+}
+
+func (r *NotificationRuleResource) mapToModel(s map[string]any, m *NotificationRuleModel) {
+	readString(s, "event", &m.Event)
+	readString(s, "template", &m.Template)
+	if target, ok := s["target"].(map[string]any); ok {
+		m.TargetChannel, m.TargetPeer, m.TargetWebhook = types.StringNull(), types.StringNull(), types.StringNull()
+		readString(target, "channel", &m.TargetChannel)
+		readString(target, "peer", &m.TargetPeer)
+		readString(target, "webhook", &m.TargetWebhook)
+	}
+}
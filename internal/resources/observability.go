@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ObservabilityResource{}
+var _ resource.ResourceWithImportState = &ObservabilityResource{}
+var _ resource.ResourceWithValidateConfig = &ObservabilityResource{}
+
+type ObservabilityResource struct {
+	gatewayTarget
+}
+
+type ObservabilityModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Gateway        types.String  `tfsdk:"gateway"`
+	ServiceName    types.String  `tfsdk:"service_name"`
+	MetricsEnabled types.Bool    `tfsdk:"metrics_enabled"`
+	MetricsPort    types.Int64   `tfsdk:"metrics_port"`
+	OTLPEndpoint   types.String  `tfsdk:"otlp_endpoint"`
+	SamplingRatio  types.Float64 `tfsdk:"sampling_ratio"`
+}
+
+func NewObservabilityResource() resource.Resource {
+	return &ObservabilityResource{}
+}
+
+func (r *ObservabilityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability"
+}
+
+func (r *ObservabilityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw observability configuration (metrics and tracing exporters).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"service_name": schema.StringAttribute{
+				Description: "Service name reported with metrics and traces. Default: openclaw-gateway.",
+				Optional:    true,
+			},
+			"metrics_enabled": schema.BoolAttribute{
+				Description: "Serve Prometheus metrics.",
+				Optional:    true,
+			},
+			"metrics_port": schema.Int64Attribute{
+				Description: "Port of the Prometheus metrics endpoint.",
+				Optional:    true,
+			},
+			"otlp_endpoint": schema.StringAttribute{
+				Description: "OTLP collector endpoint traces are exported to (e.g. http://otel-collector:4318). Tracing is off when unset.",
+				Optional:    true,
+			},
+			"sampling_ratio": schema.Float64Attribute{
+				Description: "Fraction of requests traced, from 0 to 1.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ObservabilityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig rejects a sampling ratio or port the gateway cannot use.
+func (r *ObservabilityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ObservabilityModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.SamplingRatio; !v.IsNull() && !v.IsUnknown() && (v.ValueFloat64() < 0 || v.ValueFloat64() > 1) {
+		resp.Diagnostics.AddAttributeError(path.Root("sampling_ratio"), "Invalid sampling ratio",
+			fmt.Sprintf("sampling_ratio must be between 0 and 1, got %g", v.ValueFloat64()))
+	}
+	if v := config.MetricsPort; !v.IsNull() && !v.IsUnknown() && (v.ValueInt64() < 1 || v.ValueInt64() > 65535) {
+		resp.Diagnostics.AddAttributeError(path.Root("metrics_port"), "Invalid port",
+			fmt.Sprintf("metrics_port must be between 1 and 65535, got %d", v.ValueInt64()))
+	}
+}
+
+func (r *ObservabilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ObservabilityModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "observability", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write observability config", err, "observability")
+		return
+	}
+
+	plan.ID = types.StringValue("observability")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ObservabilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ObservabilityModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "observability")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read observability config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue("observability")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ObservabilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ObservabilityModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "observability", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write observability config", err, "observability")
+		return
+	}
+
+	plan.ID = types.StringValue("observability")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ObservabilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "observability", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete observability config", err.Error())
+		return
+	}
+}
+
+func (r *ObservabilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "observability")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import observability config", err.Error())
+		return
+	}
+
+	var state ObservabilityModel
+	if section != nil {
+		r.mapToModel(section, &state)
+	}
+	state.ID = types.StringValue("observability")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+func (r *ObservabilityResource) modelToMap(m ObservabilityModel) map[string]any {
+	d := make(map[string]any)
+
+	setIfString(d, "serviceName", m.ServiceName)
+
+	metrics := make(map[string]any)
+	setIfBool(metrics, "enabled", m.MetricsEnabled)
+	setIfInt64(metrics, "port", m.MetricsPort)
+	if len(metrics) > 0 {
+		d["metrics"] = metrics
+	}
+
+	tracing := make(map[string]any)
+	setIfString(tracing, "otlpEndpoint", m.OTLPEndpoint)
+	setIfFloat64(tracing, "sampleRatio", m.SamplingRatio)
+	if len(tracing) > 0 {
+		d["tracing"] = tracing
+	}
+
+	return d
+}
+
+func (r *ObservabilityResource) mapToModel(s map[string]any, m *ObservabilityModel) {
+	readString(s, "serviceName", &m.ServiceName)
+
+	if metrics, ok := s["metrics"].(map[string]any); ok {
+		readBool(metrics, "enabled", &m.MetricsEnabled)
+		readFloat64AsInt64(metrics, "port", &m.MetricsPort)
+	}
+	if tracing, ok := s["tracing"].(map[string]any); ok {
+		readString(tracing, "otlpEndpoint", &m.OTLPEndpoint)
+		readFloat64(tracing, "sampleRatio", &m.SamplingRatio)
+	}
+}
@@ -0,0 +1,261 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &PairedDeviceResource{}
+var _ resource.ResourceWithImportState = &PairedDeviceResource{}
+var _ resource.ResourceWithValidateConfig = &PairedDeviceResource{}
+
+type PairedDeviceResource struct {
+	gatewayTarget
+}
+
+type PairedDeviceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Gateway     types.String `tfsdk:"gateway"`
+	DeviceID    types.String `tfsdk:"device_id"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Role        types.String `tfsdk:"role"`
+	Scopes      types.List   `tfsdk:"scopes"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+func NewPairedDeviceResource() resource.Resource {
+	return &PairedDeviceResource{}
+}
+
+func (r *PairedDeviceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_paired_device"
+}
+
+func (r *PairedDeviceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Approves a device's pairing request with the gateway and revokes the pairing on destroy. Requires a gateway connection (WS mode).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"device_id": schema.StringAttribute{
+				Description: "ID of the device to approve. Either device_id or public_key must be set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "Public key of the device to approve. Either device_id or public_key must be set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role granted to the device: operator (default) or node.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("operator"),
+			},
+			"scopes": schema.ListAttribute{
+				Description: "Scopes granted to the device (e.g. operator.read, operator.write). The gateway's defaults for the role when unset.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Name the device reported when it asked to pair.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig requires the device to be identified.
+func (r *PairedDeviceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PairedDeviceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DeviceID.IsNull() && config.PublicKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("device_id"), "Missing device",
+			"Set device_id or public_key to identify the device to approve.")
+	}
+}
+
+func (r *PairedDeviceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *PairedDeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan PairedDeviceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	approval := client.DeviceApproval{
+		DeviceID:  stringOrEmpty(plan.DeviceID),
+		PublicKey: stringOrEmpty(plan.PublicKey),
+		Role:      plan.Role.ValueString(),
+	}
+	if !plan.Scopes.IsNull() && !plan.Scopes.IsUnknown() {
+		resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &approval.Scopes, false)...)
+	}
+	device, err := client.ApproveDevice(ctx, r.client, approval)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to approve device", err.Error())
+		return
+	}
+
+	r.deviceToModel(ctx, device, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PairedDeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state PairedDeviceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	device, err := client.FindDevice(ctx, r.client, state.DeviceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read paired devices", err.Error())
+		return
+	}
+	if device == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.deviceToModel(ctx, device, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PairedDeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan PairedDeviceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	if !plan.Scopes.IsNull() && !plan.Scopes.IsUnknown() {
+		resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &scopes, false)...)
+	}
+	deviceID := plan.DeviceID.ValueString()
+	if err := client.UpdateDevice(ctx, r.client, deviceID, plan.Role.ValueString(), scopes); err != nil {
+		resp.Diagnostics.AddError("Failed to update device", err.Error())
+		return
+	}
+	device, err := client.FindDevice(ctx, r.client, deviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read paired devices", err.Error())
+		return
+	}
+	if device == nil {
+		resp.Diagnostics.AddError("Device not found", fmt.Sprintf("Device %q is no longer paired", deviceID))
+		return
+	}
+	r.deviceToModel(ctx, device, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PairedDeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state PairedDeviceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := client.RevokeDevice(ctx, r.client, state.DeviceID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to revoke device", err.Error())
+		return
+	}
+}
+
+func (r *PairedDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, deviceID, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	device, err := client.FindDevice(ctx, r.client, deviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read paired devices", err.Error())
+		return
+	}
+	if device == nil {
+		resp.Diagnostics.AddError("Device not found", fmt.Sprintf("No paired device with id %q", deviceID))
+		return
+	}
+	var state PairedDeviceModel
+	r.deviceToModel(ctx, device, &state)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PairedDeviceResource) deviceToModel(ctx context.Context, d *client.Device, m *PairedDeviceModel) {
+	m.ID = types.StringValue(d.DeviceID)
+	m.DeviceID = types.StringValue(d.DeviceID)
+	m.PublicKey = types.StringValue(d.PublicKey)
+	m.DisplayName = types.StringValue(d.DisplayName)
+	if d.Role != "" {
+		m.Role = types.StringValue(d.Role)
+	}
+	scopes := d.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	m.Scopes, _ = types.ListValueFrom(ctx, types.StringType, scopes)
+}
+
+// stringOrEmpty returns the value of a known string, or "" for a null or
+// unknown one.
+func stringOrEmpty(v types.String) string {
+	if v.IsNull() || v.IsUnknown() {
+		return ""
+	}
+	return v.ValueString()
+}
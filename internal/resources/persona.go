@@ -0,0 +1,213 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &PersonaResource{}
+var _ resource.ResourceWithImportState = &PersonaResource{}
+
+type PersonaResource struct {
+	gatewayTarget
+}
+
+type PersonaModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Prompt          types.String `tfsdk:"prompt"`
+	ThinkingDefault types.String `tfsdk:"thinking_default"`
+	Model           types.String `tfsdk:"model"`
+}
+
+func NewPersonaResource() resource.Resource {
+	return &PersonaResource{}
+}
+
+func (r *PersonaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persona"
+}
+
+func (r *PersonaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a named persona under personas.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Unique persona name. Used as the key under personas, and referenced by persona on agents.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Short description of the persona.",
+				Optional:    true,
+			},
+			"prompt": schema.StringAttribute{
+				Description: "Prompt text that sets the persona's voice and behavior.",
+				Optional:    true,
+			},
+			"thinking_default": schema.StringAttribute{
+				Description: "Default thinking level for agents using the persona: off|minimal|low|medium|high|xhigh.",
+				Optional:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "Preferred model (e.g. anthropic/claude-sonnet-4-5) or model alias.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *PersonaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *PersonaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan PersonaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "personas", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write persona config", err, "personas", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PersonaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state PersonaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := state.Name.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "personas", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read persona config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PersonaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan PersonaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "personas", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write persona config", err, "personas", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PersonaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state PersonaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "personas", state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete persona config", err.Error())
+		return
+	}
+}
+
+func (r *PersonaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "personas", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import persona config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Persona not found", fmt.Sprintf("No persona named %q in personas", name))
+		return
+	}
+	var state PersonaModel
+	state.Name = types.StringValue(name)
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(name)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PersonaResource) modelToMap(m PersonaModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "description", m.Description)
+	setIfString(d, "prompt", m.Prompt)
+	setIfString(d, "thinkingDefault", m.ThinkingDefault)
+	setIfString(d, "model", m.Model)
+	return d
+}
+
+func (r *PersonaResource) mapToModel(s map[string]any, m *PersonaModel) {
+	readString(s, "description", &m.Description)
+	readString(s, "prompt", &m.Prompt)
+	readString(s, "thinkingDefault", &m.ThinkingDefault)
+	readString(s, "model", &m.Model)
+}
@@ -19,11 +19,12 @@ var _ resource.Resource = &PluginResource{}
 var _ resource.ResourceWithImportState = &PluginResource{}
 
 type PluginResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type PluginModel struct {
 	ID         types.String `tfsdk:"id"`
+	Gateway    types.String `tfsdk:"gateway"`
 	PluginID   types.String `tfsdk:"plugin_id"`
 	Enabled    types.Bool   `tfsdk:"enabled"`
 	ConfigJSON types.String `tfsdk:"config_json"`
@@ -41,7 +42,8 @@ func (r *PluginResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	resp.Schema = schema.Schema{
 		Description: "Manages an OpenClaw plugin entry.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"plugin_id": schema.StringAttribute{
 				Description: "Unique plugin identifier. Used as the key under plugins.entries.",
 				Required:    true,
@@ -70,10 +72,13 @@ func (r *PluginResource) Configure(_ context.Context, req resource.ConfigureRequ
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *PluginResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan PluginModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -91,7 +96,7 @@ func (r *PluginResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	pluginID := plan.PluginID.ValueString()
 	if err := client.PatchNestedSection(ctx, r.client, m, cfg.Hash, "plugins", "entries", pluginID); err != nil {
-		resp.Diagnostics.AddError("Failed to write plugin config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write plugin config", err, "plugins", "entries", pluginID)
 		return
 	}
 	plan.ID = types.StringValue(pluginID)
@@ -99,6 +104,9 @@ func (r *PluginResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *PluginResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state PluginModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -120,6 +128,9 @@ func (r *PluginResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *PluginResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan PluginModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -137,7 +148,7 @@ func (r *PluginResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 	pluginID := plan.PluginID.ValueString()
 	if err := client.PatchNestedSection(ctx, r.client, m, cfg.Hash, "plugins", "entries", pluginID); err != nil {
-		resp.Diagnostics.AddError("Failed to write plugin config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write plugin config", err, "plugins", "entries", pluginID)
 		return
 	}
 	plan.ID = types.StringValue(pluginID)
@@ -145,6 +156,9 @@ func (r *PluginResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *PluginResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state PluginModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -163,7 +177,10 @@ func (r *PluginResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *PluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	pluginID := req.ID
+	gw, pluginID, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "plugins", "entries", pluginID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import plugin config", err.Error())
@@ -175,6 +192,7 @@ func (r *PluginResource) ImportState(ctx context.Context, req resource.ImportSta
 		r.mapToModel(section, &state)
 	}
 	state.ID = types.StringValue(pluginID)
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -0,0 +1,256 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &PluginInstallResource{}
+var _ resource.ResourceWithImportState = &PluginInstallResource{}
+var _ resource.ResourceWithValidateConfig = &PluginInstallResource{}
+
+type PluginInstallResource struct {
+	gatewayTarget
+}
+
+type PluginInstallModel struct {
+	ID               types.String `tfsdk:"id"`
+	Gateway          types.String `tfsdk:"gateway"`
+	Source           types.String `tfsdk:"source"`
+	Version          types.String `tfsdk:"version"`
+	Checksum         types.String `tfsdk:"checksum"`
+	PluginID         types.String `tfsdk:"plugin_id"`
+	InstalledVersion types.String `tfsdk:"installed_version"`
+}
+
+// registryNamePattern matches a plugin registry name, optionally scoped
+// (e.g. voice-call or @openclaw/voice-call).
+var registryNamePattern = regexp.MustCompile(`^(@[a-z0-9][a-z0-9._-]*/)?[a-z0-9][a-z0-9._-]*$`)
+
+var checksumPattern = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+func NewPluginInstallResource() resource.Resource {
+	return &PluginInstallResource{}
+}
+
+func (r *PluginInstallResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin_install"
+}
+
+func (r *PluginInstallResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Installs a plugin on the gateway and uninstalls it on destroy. Configure the installed plugin with openclaw_plugin. Requires a gateway connection (WS mode).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"source": schema.StringAttribute{
+				Description: "Where to install from: a registry name (e.g. @openclaw/voice-call), a git URL, or an absolute or ./-relative path on the gateway host.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version constraint for a registry source (e.g. ^1.2.0). Latest version when unset.",
+				Optional:    true,
+			},
+			"checksum": schema.StringAttribute{
+				Description: "Expected checksum of the plugin package, as sha256:<hex>. The install fails if it does not match.",
+				Optional:    true,
+			},
+			"plugin_id": schema.StringAttribute{
+				Description: "ID of the installed plugin, the key to use with openclaw_plugin.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"installed_version": schema.StringAttribute{
+				Description: "Version the gateway installed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *PluginInstallResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the source and the checksum, and that a version
+// constraint is only set for a registry source.
+func (r *PluginInstallResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PluginInstallModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if s := config.Source; !s.IsNull() && !s.IsUnknown() {
+		kind := pluginSourceKind(s.ValueString())
+		switch {
+		case kind == "":
+			resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid source",
+				fmt.Sprintf("source must be a registry name, a git URL or a path on the gateway host, got %q", s.ValueString()))
+		case kind != "registry" && !config.Version.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("version"), "Unused version",
+				"version only applies to registry sources. Pin a git source with a ref in the URL (e.g. #v1.2.0).")
+		}
+	}
+
+	if c := config.Checksum; !c.IsNull() && !c.IsUnknown() && !checksumPattern.MatchString(c.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("checksum"), "Invalid checksum",
+			fmt.Sprintf("checksum must be sha256: followed by 64 lowercase hex digits, got %q", c.ValueString()))
+	}
+}
+
+// pluginSourceKind returns "path", "git" or "registry" for a plugin source,
+// or "" if it is none of them.
+func pluginSourceKind(s string) string {
+	switch {
+	case strings.HasPrefix(s, "/") || strings.HasPrefix(s, "./") || strings.HasPrefix(s, "../"):
+		return "path"
+	case registryNamePattern.MatchString(s):
+		return "registry"
+	}
+	base, _, _ := strings.Cut(s, "#")
+	if _, ok := repositoryURLScheme(base); ok {
+		return "git"
+	}
+	return ""
+}
+
+func (r *PluginInstallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan PluginInstallModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plugin, err := client.InstallPlugin(ctx, r.client, r.modelToInstall(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to install plugin", err.Error())
+		return
+	}
+	r.pluginToModel(plugin, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PluginInstallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state PluginInstallModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plugin, err := client.FindPlugin(ctx, r.client, state.PluginID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read installed plugins", err.Error())
+		return
+	}
+	if plugin == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.pluginToModel(plugin, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update installs again over the existing plugin, which upgrades or
+// downgrades it in place to match a new version constraint or checksum.
+func (r *PluginInstallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan PluginInstallModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plugin, err := client.InstallPlugin(ctx, r.client, r.modelToInstall(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to install plugin", err.Error())
+		return
+	}
+	r.pluginToModel(plugin, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PluginInstallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state PluginInstallModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := client.UninstallPlugin(ctx, r.client, state.PluginID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to uninstall plugin", err.Error())
+		return
+	}
+}
+
+// ImportState imports an installed plugin by ID. The version constraint and
+// checksum are not recorded by the gateway: set them in the configuration,
+// and the next apply installs again to match.
+func (r *PluginInstallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, pluginID, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	plugin, err := client.FindPlugin(ctx, r.client, pluginID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read installed plugins", err.Error())
+		return
+	}
+	if plugin == nil {
+		resp.Diagnostics.AddError("Plugin not found", fmt.Sprintf("No installed plugin with id %q", pluginID))
+		return
+	}
+	var state PluginInstallModel
+	if plugin.Source != "" {
+		state.Source = types.StringValue(plugin.Source)
+	}
+	r.pluginToModel(plugin, &state)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PluginInstallResource) modelToInstall(m PluginInstallModel) client.PluginInstall {
+	return client.PluginInstall{
+		Source:   m.Source.ValueString(),
+		Version:  stringOrEmpty(m.Version),
+		Checksum: stringOrEmpty(m.Checksum),
+	}
+}
+
+func (r *PluginInstallResource) pluginToModel(p *client.Plugin, m *PluginInstallModel) {
+	m.ID = types.StringValue(p.ID)
+	m.PluginID = types.StringValue(p.ID)
+	m.InstalledVersion = types.StringValue(p.Version)
+}
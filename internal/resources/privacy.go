@@ -0,0 +1,257 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &PrivacyResource{}
+var _ resource.ResourceWithImportState = &PrivacyResource{}
+var _ resource.ResourceWithValidateConfig = &PrivacyResource{}
+
+type PrivacyResource struct {
+	gatewayTarget
+}
+
+type PrivacyModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Gateway             types.String `tfsdk:"gateway"`
+	RedactionPatterns   types.List   `tfsdk:"redaction_patterns"`
+	RedactionTargets    types.List   `tfsdk:"redaction_targets"`
+	MaskPII             types.Bool   `tfsdk:"mask_pii"`
+	TranscriptRetention types.String `tfsdk:"transcript_retention"`
+}
+
+func NewPrivacyResource() resource.Resource {
+	return &PrivacyResource{}
+}
+
+func (r *PrivacyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privacy"
+}
+
+func (r *PrivacyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages redaction and retention of logs and transcripts (privacy section).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"redaction_patterns": schema.ListAttribute{
+				Description: "Regular expressions whose matches are replaced with [REDACTED]. RE2 syntax: lookarounds and backreferences are not supported.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"redaction_targets": schema.ListAttribute{
+				Description: "Where redaction_patterns apply: logs, transcripts. Both when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"mask_pii": schema.BoolAttribute{
+				Description: "Mask detected personal data (email addresses, phone numbers, card numbers) in logs and transcripts.",
+				Optional:    true,
+			},
+			"transcript_retention": schema.StringAttribute{
+				Description: "How long session transcripts are kept, as a duration string (e.g. 30d). Kept forever when unset.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *PrivacyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig compiles each pattern, so that a typo fails at plan time
+// rather than leaving data unredacted, and checks the targets.
+func (r *PrivacyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PrivacyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RedactionPatterns.IsNull() && !config.RedactionPatterns.IsUnknown() {
+		var patterns []types.String
+		resp.Diagnostics.Append(config.RedactionPatterns.ElementsAs(ctx, &patterns, false)...)
+		for i, p := range patterns {
+			if p.IsUnknown() {
+				continue
+			}
+			if _, err := regexp.Compile(p.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("redaction_patterns").AtListIndex(i), "Invalid pattern", err.Error())
+			}
+		}
+	}
+	if !config.RedactionTargets.IsNull() && !config.RedactionTargets.IsUnknown() {
+		var targets []types.String
+		resp.Diagnostics.Append(config.RedactionTargets.ElementsAs(ctx, &targets, false)...)
+		for i, t := range targets {
+			switch t.ValueString() {
+			case "logs", "transcripts":
+			default:
+				if !t.IsUnknown() {
+					resp.Diagnostics.AddAttributeError(path.Root("redaction_targets").AtListIndex(i), "Invalid target",
+						fmt.Sprintf("Redaction targets are logs and transcripts, got %q", t.ValueString()))
+				}
+			}
+		}
+	}
+}
+
+func (r *PrivacyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan PrivacyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "privacy", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write privacy config", err, "privacy")
+		return
+	}
+
+	plan.ID = types.StringValue("privacy")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PrivacyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state PrivacyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "privacy")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read privacy config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("privacy")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PrivacyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan PrivacyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "privacy", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write privacy config", err, "privacy")
+		return
+	}
+
+	plan.ID = types.StringValue("privacy")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PrivacyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "privacy", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete privacy config", err.Error())
+		return
+	}
+}
+
+func (r *PrivacyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "privacy")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import privacy config", err.Error())
+		return
+	}
+
+	state := PrivacyModel{
+		RedactionPatterns: types.ListNull(types.StringType),
+		RedactionTargets:  types.ListNull(types.StringType),
+	}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("privacy")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+// modelToMap patches unset attributes to null, so that a pattern or
+// retention removed from the configuration stops applying.
+func (r *PrivacyResource) modelToMap(ctx context.Context, m PrivacyModel) map[string]any {
+	d := map[string]any{"redact": nil}
+	redact := make(map[string]any)
+	setIfStringList(ctx, redact, "patterns", m.RedactionPatterns)
+	setIfStringList(ctx, redact, "targets", m.RedactionTargets)
+	if len(redact) > 0 {
+		d["redact"] = withNullKeys(redact, "patterns", "targets")
+	}
+	setIfBool(d, "maskPii", m.MaskPII)
+	setIfString(d, "transcriptRetention", m.TranscriptRetention)
+	return withNullKeys(d, "maskPii", "transcriptRetention")
+}
+
+func (r *PrivacyResource) mapToModel(ctx context.Context, s map[string]any, m *PrivacyModel) {
+	if redact, ok := s["redact"].(map[string]any); ok {
+		readStringList(ctx, redact, "patterns", &m.RedactionPatterns)
+		readStringList(ctx, redact, "targets", &m.RedactionTargets)
+	}
+	readBool(s, "maskPii", &m.MaskPII)
+	readString(s, "transcriptRetention", &m.TranscriptRetention)
+}
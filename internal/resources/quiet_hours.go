@@ -0,0 +1,266 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &QuietHoursResource{}
+var _ resource.ResourceWithImportState = &QuietHoursResource{}
+var _ resource.ResourceWithValidateConfig = &QuietHoursResource{}
+
+type QuietHoursResource struct {
+	gatewayTarget
+}
+
+type QuietHoursModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Name            types.String `tfsdk:"name"`
+	Window          types.String `tfsdk:"window"`
+	Days            types.List   `tfsdk:"days"`
+	Timezone        types.String `tfsdk:"timezone"`
+	Channels        types.List   `tfsdk:"channels"`
+	Action          types.String `tfsdk:"action"`
+	DeferHeartbeats types.Bool   `tfsdk:"defer_heartbeats"`
+}
+
+// quietHoursKeys are the keys of a quietHours entry.
+var quietHoursKeys = []string{"window", "days", "timezone", "channels", "action", "deferHeartbeats"}
+
+func NewQuietHoursResource() resource.Resource {
+	return &QuietHoursResource{}
+}
+
+func (r *QuietHoursResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quiet_hours"
+}
+
+func (r *QuietHoursResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a named do-not-disturb window under quietHours, during which outbound messages are held back.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Unique window name. Used as the key under quietHours.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"window": schema.StringAttribute{
+				Description: "Time of day the window covers, as HH:MM-HH:MM (e.g. 22:00-07:00). May wrap past midnight.",
+				Required:    true,
+			},
+			"days": schema.ListAttribute{
+				Description: "Days the window starts on: mon, tue, wed, thu, fri, sat, sun. Every day when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone of window (e.g. America/New_York). Defaults to the gateway host's time zone.",
+				Optional:    true,
+			},
+			"channels": schema.ListAttribute{
+				Description: "Channels the window applies to (e.g. whatsapp, sms). All channels when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"action": schema.StringAttribute{
+				Description: "What happens to outbound messages during the window: queue (sent when it ends) or drop. Default: queue.",
+				Optional:    true,
+			},
+			"defer_heartbeats": schema.BoolAttribute{
+				Description: "Run heartbeats that fall due during the window once it ends, rather than skipping them. Heartbeats are always held back during the window.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *QuietHoursResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the window, the day and channel lists, and the action.
+func (r *QuietHoursResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config QuietHoursModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if w := config.Window; !w.IsNull() && !w.IsUnknown() && !validApplyWindow(w.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("window"), "Invalid window",
+			fmt.Sprintf("window must be HH:MM-HH:MM (e.g. 22:00-07:00), got %q", w.ValueString()))
+	}
+	validateListElements(ctx, &resp.Diagnostics, "days", config.Days, strings.ToLower, validWeekday)
+	validateListElements(ctx, &resp.Diagnostics, "channels", config.Channels, strings.ToLower, nil)
+
+	if a := config.Action; !a.IsNull() && !a.IsUnknown() {
+		switch a.ValueString() {
+		case "queue", "drop":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("action"), "Invalid action",
+				fmt.Sprintf("action must be queue or drop, got %q", a.ValueString()))
+		}
+	}
+}
+
+func (r *QuietHoursResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan QuietHoursModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "quietHours", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write quiet hours", err, "quietHours", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *QuietHoursResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state QuietHoursModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := state.Name.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "quietHours", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read quiet hours", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *QuietHoursResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan QuietHoursModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "quietHours", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write quiet hours", err, "quietHours", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *QuietHoursResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state QuietHoursModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := state.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "quietHours", name); err != nil {
+		resp.Diagnostics.AddError("Failed to delete quiet hours", err.Error())
+		return
+	}
+}
+
+func (r *QuietHoursResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "quietHours", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import quiet hours", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Quiet hours not found", fmt.Sprintf("No window named %q in quietHours", name))
+		return
+	}
+	state := QuietHoursModel{
+		Days:     types.ListNull(types.StringType),
+		Channels: types.ListNull(types.StringType),
+	}
+	state.Name = types.StringValue(name)
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// modelToMap patches unset attributes to null, so that removing days or
+// channels from the configuration widens the window to all of them again.
+func (r *QuietHoursResource) modelToMap(ctx context.Context, m QuietHoursModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "window", m.Window)
+	setIfStringList(ctx, d, "days", m.Days)
+	setIfString(d, "timezone", m.Timezone)
+	setIfStringList(ctx, d, "channels", m.Channels)
+	setIfString(d, "action", m.Action)
+	setIfBool(d, "deferHeartbeats", m.DeferHeartbeats)
+	return withNullKeys(d, quietHoursKeys...)
+}
+
+func (r *QuietHoursResource) mapToModel(ctx context.Context, s map[string]any, m *QuietHoursModel) {
+	readString(s, "window", &m.Window)
+	readStringList(ctx, s, "days", &m.Days)
+	readString(s, "timezone", &m.Timezone)
+	readStringList(ctx, s, "channels", &m.Channels)
+	readString(s, "action", &m.Action)
+	readBool(s, "deferHeartbeats", &m.DeferHeartbeats)
+}
@@ -0,0 +1,246 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &RateLimitResource{}
+var _ resource.ResourceWithImportState = &RateLimitResource{}
+var _ resource.ResourceWithValidateConfig = &RateLimitResource{}
+
+type RateLimitResource struct {
+	gatewayTarget
+}
+
+type RateLimitModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Gateway               types.String `tfsdk:"gateway"`
+	PeerMessagesPerMinute types.Int64  `tfsdk:"peer_messages_per_minute"`
+	PeerBurst             types.Int64  `tfsdk:"peer_burst"`
+	ChannelConcurrentRuns types.Int64  `tfsdk:"channel_concurrent_runs"`
+	CooldownMessage       types.String `tfsdk:"cooldown_message"`
+}
+
+func NewRateLimitResource() resource.Resource {
+	return &RateLimitResource{}
+}
+
+func (r *RateLimitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rate_limit"
+}
+
+func (r *RateLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw rate limits (rateLimit section).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"peer_messages_per_minute": schema.Int64Attribute{
+				Description: "Messages each sender may send per minute before they are throttled.",
+				Optional:    true,
+			},
+			"peer_burst": schema.Int64Attribute{
+				Description: "Messages a sender may send at once above the per-minute rate.",
+				Optional:    true,
+			},
+			"channel_concurrent_runs": schema.Int64Attribute{
+				Description: "Agent runs each channel may have in progress at once. Further messages wait their turn.",
+				Optional:    true,
+			},
+			"cooldown_message": schema.StringAttribute{
+				Description: "Reply sent to a throttled sender. {retryAfter} is replaced with the wait in seconds. No reply when unset.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *RateLimitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig rejects limits that would block every message.
+func (r *RateLimitResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RateLimitModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attr := range []struct {
+		name string
+		min  int64
+		v    types.Int64
+	}{
+		{"peer_messages_per_minute", 1, config.PeerMessagesPerMinute},
+		{"peer_burst", 0, config.PeerBurst},
+		{"channel_concurrent_runs", 1, config.ChannelConcurrentRuns},
+	} {
+		if !attr.v.IsNull() && !attr.v.IsUnknown() && attr.v.ValueInt64() < attr.min {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.name), "Invalid limit",
+				fmt.Sprintf("%s must be at least %d, got %d", attr.name, attr.min, attr.v.ValueInt64()))
+		}
+	}
+}
+
+func (r *RateLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan RateLimitModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "rateLimit", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write rate limit config", err, "rateLimit")
+		return
+	}
+
+	plan.ID = types.StringValue("rate_limit")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RateLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state RateLimitModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "rateLimit")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read rate limit config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue("rate_limit")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RateLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan RateLimitModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "rateLimit", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write rate limit config", err, "rateLimit")
+		return
+	}
+
+	plan.ID = types.StringValue("rate_limit")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RateLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "rateLimit", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete rate limit config", err.Error())
+		return
+	}
+}
+
+func (r *RateLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "rateLimit")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import rate limit config", err.Error())
+		return
+	}
+
+	var state RateLimitModel
+	if section != nil {
+		r.mapToModel(section, &state)
+	}
+	state.ID = types.StringValue("rate_limit")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+func (r *RateLimitResource) modelToMap(m RateLimitModel) map[string]any {
+	d := make(map[string]any)
+
+	perPeer := make(map[string]any)
+	setIfInt64(perPeer, "messagesPerMinute", m.PeerMessagesPerMinute)
+	setIfInt64(perPeer, "burst", m.PeerBurst)
+	if len(perPeer) > 0 {
+		d["perPeer"] = perPeer
+	}
+
+	perChannel := make(map[string]any)
+	setIfInt64(perChannel, "concurrentRuns", m.ChannelConcurrentRuns)
+	if len(perChannel) > 0 {
+		d["perChannel"] = perChannel
+	}
+
+	setIfString(d, "cooldownMessage", m.CooldownMessage)
+
+	return d
+}
+
+func (r *RateLimitResource) mapToModel(s map[string]any, m *RateLimitModel) {
+	if perPeer, ok := s["perPeer"].(map[string]any); ok {
+		readFloat64AsInt64(perPeer, "messagesPerMinute", &m.PeerMessagesPerMinute)
+		readFloat64AsInt64(perPeer, "burst", &m.PeerBurst)
+	}
+	if perChannel, ok := s["perChannel"].(map[string]any); ok {
+		readFloat64AsInt64(perChannel, "concurrentRuns", &m.ChannelConcurrentRuns)
+	}
+	readString(s, "cooldownMessage", &m.CooldownMessage)
+}
@@ -0,0 +1,245 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &SandboxResource{}
+var _ resource.ResourceWithImportState = &SandboxResource{}
+var _ resource.ResourceWithValidateConfig = &SandboxResource{}
+
+type SandboxResource struct {
+	gatewayTarget
+}
+
+type SandboxModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Gateway        types.String  `tfsdk:"gateway"`
+	Image          types.String  `tfsdk:"image"`
+	CPUs           types.Float64 `tfsdk:"cpus"`
+	Memory         types.String  `tfsdk:"memory"`
+	Network        types.String  `tfsdk:"network"`
+	MountAllowlist types.List    `tfsdk:"mount_allowlist"`
+	IdleTeardown   types.String  `tfsdk:"idle_teardown"`
+}
+
+func NewSandboxResource() resource.Resource {
+	return &SandboxResource{}
+}
+
+func (r *SandboxResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sandbox"
+}
+
+func (r *SandboxResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw sandbox runtime configuration (container image, resource limits, network, mounts).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"image": schema.StringAttribute{
+				Description: "Container image sandboxes run in.",
+				Optional:    true,
+			},
+			"cpus": schema.Float64Attribute{
+				Description: "CPU limit per sandbox, in cores (e.g. 1.5).",
+				Optional:    true,
+			},
+			"memory": schema.StringAttribute{
+				Description: "Memory limit per sandbox (e.g. 512m, 2g).",
+				Optional:    true,
+			},
+			"network": schema.StringAttribute{
+				Description: "Network access for sandboxes: none|bridge|host.",
+				Optional:    true,
+			},
+			"mount_allowlist": schema.ListAttribute{
+				Description: "Host paths that may be mounted into sandboxes.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"idle_teardown": schema.StringAttribute{
+				Description: "Idle time after which a sandbox is torn down, as a duration string (e.g. 30m).",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *SandboxResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig rejects a CPU limit no container runtime accepts.
+func (r *SandboxResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SandboxModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.CPUs; !v.IsNull() && !v.IsUnknown() && v.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("cpus"), "Invalid CPU limit",
+			fmt.Sprintf("cpus must be greater than 0, got %g", v.ValueFloat64()))
+	}
+}
+
+func (r *SandboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SandboxModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "sandbox", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write sandbox config", err, "sandbox")
+		return
+	}
+
+	plan.ID = types.StringValue("sandbox")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SandboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SandboxModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "sandbox")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read sandbox config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("sandbox")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SandboxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SandboxModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "sandbox", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write sandbox config", err, "sandbox")
+		return
+	}
+
+	plan.ID = types.StringValue("sandbox")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SandboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "sandbox", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete sandbox config", err.Error())
+		return
+	}
+}
+
+func (r *SandboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "sandbox")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import sandbox config", err.Error())
+		return
+	}
+
+	state := SandboxModel{MountAllowlist: types.ListNull(types.StringType)}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("sandbox")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+func (r *SandboxResource) modelToMap(ctx context.Context, m SandboxModel) map[string]any {
+	d := make(map[string]any)
+
+	setIfString(d, "image", m.Image)
+	setIfString(d, "network", m.Network)
+	setIfStringList(ctx, d, "mountAllowlist", m.MountAllowlist)
+	setIfString(d, "idleTeardown", m.IdleTeardown)
+
+	limits := make(map[string]any)
+	setIfFloat64(limits, "cpus", m.CPUs)
+	setIfString(limits, "memory", m.Memory)
+	if len(limits) > 0 {
+		d["limits"] = limits
+	}
+
+	return d
+}
+
+func (r *SandboxResource) mapToModel(ctx context.Context, s map[string]any, m *SandboxModel) {
+	readString(s, "image", &m.Image)
+	readString(s, "network", &m.Network)
+	readStringList(ctx, s, "mountAllowlist", &m.MountAllowlist)
+	readString(s, "idleTeardown", &m.IdleTeardown)
+
+	if limits, ok := s["limits"].(map[string]any); ok {
+		readFloat64(limits, "cpus", &m.CPUs)
+		readString(limits, "memory", &m.Memory)
+	}
+}
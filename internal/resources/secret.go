@@ -0,0 +1,229 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &SecretResource{}
+var _ resource.ResourceWithImportState = &SecretResource{}
+var _ resource.ResourceWithValidateConfig = &SecretResource{}
+
+type SecretResource struct {
+	gatewayTarget
+}
+
+type SecretModel struct {
+	ID             types.String `tfsdk:"id"`
+	Gateway        types.String `tfsdk:"gateway"`
+	Name           types.String `tfsdk:"name"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+}
+
+func NewSecretResource() resource.Resource {
+	return &SecretResource{}
+}
+
+func (r *SecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (r *SecretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a secret under env.vars, injected into skills and tools as an environment variable. The value is never read back from the gateway.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Environment variable name (e.g. OPENAI_API_KEY). Used as the key under env.vars.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Secret value. Kept in Terraform state; prefer value_wo on Terraform 1.11 and later. Exactly one of value or value_wo must be set.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"value_wo": schema.StringAttribute{
+				Description: "Secret value, write-only: it is never stored in plan or state. Requires Terraform 1.11 or later. Change value_wo_version to write a new value. Exactly one of value or value_wo must be set.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Description: "Version of value_wo. Terraform cannot see changes to a write-only value, so change this to rotate the secret.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig requires exactly one source for the value.
+func (r *SecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !config.Value.IsNull() && !config.ValueWO.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("value_wo"), "Conflicting secret values",
+			"Set either value or value_wo, not both.")
+	case config.Value.IsNull() && config.ValueWO.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Missing secret value",
+			"Set value or value_wo.")
+	case !config.ValueWOVersion.IsNull() && config.ValueWO.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("value_wo_version"), "Unused value_wo_version",
+			"value_wo_version only applies together with value_wo.")
+	}
+}
+
+func (r *SecretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeSecret(ctx, req.Config, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vars, _, err := client.GetNestedSection(ctx, r.client, "env", "vars")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read secrets", err.Error())
+		return
+	}
+	// Only the presence of the secret is checked; the value stays as planned.
+	if _, ok := vars[state.Name.ValueString()]; !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.ID = state.Name
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeSecret(ctx, req.Config, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "env", "vars", state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete secret", err.Error())
+		return
+	}
+}
+
+// ImportState imports the secret by name. The value is not imported: set
+// value or value_wo in the configuration, and the next apply writes it.
+func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	vars, _, err := client.GetNestedSection(ctx, r.client, "env", "vars")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import secret", err.Error())
+		return
+	}
+	if _, ok := vars[name]; !ok {
+		resp.Diagnostics.AddError("Secret not found", fmt.Sprintf("No secret %q in env.vars", name))
+		return
+	}
+	state := SecretModel{
+		ID:      types.StringValue(name),
+		Gateway: gatewayValue(gw),
+		Name:    types.StringValue(name),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// writeSecret writes the secret's value. A write-only value is never part of
+// the plan, so it is taken from the configuration.
+func (r *SecretResource) writeSecret(ctx context.Context, config tfsdk.Config, plan tfsdk.Plan, m *SecretModel, diags *diag.Diagnostics) bool {
+	value := m.Value
+	if value.IsNull() {
+		diags.Append(config.GetAttribute(ctx, path.Root("value_wo"), &value)...)
+		if diags.HasError() {
+			return false
+		}
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		diags.AddError("Failed to read config", err.Error())
+		return false
+	}
+	name := m.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, value.ValueString(), cfg.Hash, "env", "vars", name); err != nil {
+		addWriteError(ctx, diags, plan, "Failed to write secret", err, "env", "vars", name)
+		return false
+	}
+	m.ID = types.StringValue(name)
+	return true
+}
@@ -0,0 +1,300 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &SecurityResource{}
+var _ resource.ResourceWithImportState = &SecurityResource{}
+var _ resource.ResourceWithValidateConfig = &SecurityResource{}
+
+type SecurityResource struct {
+	gatewayTarget
+}
+
+type SecurityModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Gateway                 types.String `tfsdk:"gateway"`
+	ElevatedRequireApproval types.Bool   `tfsdk:"elevated_require_approval"`
+	ElevatedApprovers       types.List   `tfsdk:"elevated_approvers"`
+	ShellAllow              types.List   `tfsdk:"shell_allow"`
+	ShellDeny               types.List   `tfsdk:"shell_deny"`
+	FileDenyPaths           types.List   `tfsdk:"file_deny_paths"`
+	AuditEnabled            types.Bool   `tfsdk:"audit_enabled"`
+	AuditPath               types.String `tfsdk:"audit_path"`
+	AuditIncludeToolCalls   types.Bool   `tfsdk:"audit_include_tool_calls"`
+}
+
+func NewSecurityResource() resource.Resource {
+	return &SecurityResource{}
+}
+
+func (r *SecurityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security"
+}
+
+func (r *SecurityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OpenClaw global security policy (elevated approvals, shell and file guardrails, audit logging).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"elevated_require_approval": schema.BoolAttribute{
+				Description: "Require explicit approval before any elevated command runs.",
+				Optional:    true,
+			},
+			"elevated_approvers": schema.ListAttribute{
+				Description: "Sender identifiers allowed to approve elevated commands.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"shell_allow": schema.ListAttribute{
+				Description: "Regular expressions for shell commands that are always allowed.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"shell_deny": schema.ListAttribute{
+				Description: "Regular expressions for shell commands that are always denied. Deny wins over allow.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"file_deny_paths": schema.ListAttribute{
+				Description: "File paths or globs agents may never read or write.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"audit_enabled": schema.BoolAttribute{
+				Description: "Enable the security audit log.",
+				Optional:    true,
+			},
+			"audit_path": schema.StringAttribute{
+				Description: "Path of the audit log file.",
+				Optional:    true,
+			},
+			"audit_include_tool_calls": schema.BoolAttribute{
+				Description: "Record every tool call in the audit log, not just elevated ones.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *SecurityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig rejects shell patterns that the gateway would fail to compile.
+func (r *SecurityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SecurityModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attr, list := range map[string]types.List{"shell_allow": config.ShellAllow, "shell_deny": config.ShellDeny} {
+		if list.IsNull() || list.IsUnknown() {
+			continue
+		}
+		var patterns []types.String
+		resp.Diagnostics.Append(list.ElementsAs(ctx, &patterns, false)...)
+		for i, p := range patterns {
+			if p.IsNull() || p.IsUnknown() {
+				continue
+			}
+			if _, err := regexp.Compile(p.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr).AtListIndex(i),
+					"Invalid regular expression",
+					fmt.Sprintf("%q is not a valid regular expression: %s", p.ValueString(), err),
+				)
+			}
+		}
+	}
+}
+
+func (r *SecurityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SecurityModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "security", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write security config", err, "security")
+		return
+	}
+
+	plan.ID = types.StringValue("security")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecurityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SecurityModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "security")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read security config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("security")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SecurityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SecurityModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "security", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write security config", err, "security")
+		return
+	}
+
+	plan.ID = types.StringValue("security")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecurityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "security", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete security config", err.Error())
+		return
+	}
+}
+
+func (r *SecurityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "security")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import security config", err.Error())
+		return
+	}
+
+	var state SecurityModel
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("security")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+func (r *SecurityResource) modelToMap(ctx context.Context, m SecurityModel) map[string]any {
+	d := make(map[string]any)
+
+	elevated := make(map[string]any)
+	setIfBool(elevated, "requireApproval", m.ElevatedRequireApproval)
+	setIfStringList(ctx, elevated, "approvers", m.ElevatedApprovers)
+	if len(elevated) > 0 {
+		d["elevated"] = elevated
+	}
+
+	shell := make(map[string]any)
+	setIfStringList(ctx, shell, "allow", m.ShellAllow)
+	setIfStringList(ctx, shell, "deny", m.ShellDeny)
+	if len(shell) > 0 {
+		d["shell"] = shell
+	}
+
+	files := make(map[string]any)
+	setIfStringList(ctx, files, "denyPaths", m.FileDenyPaths)
+	if len(files) > 0 {
+		d["files"] = files
+	}
+
+	audit := make(map[string]any)
+	setIfBool(audit, "enabled", m.AuditEnabled)
+	setIfString(audit, "path", m.AuditPath)
+	setIfBool(audit, "includeToolCalls", m.AuditIncludeToolCalls)
+	if len(audit) > 0 {
+		d["audit"] = audit
+	}
+
+	return d
+}
+
+func (r *SecurityResource) mapToModel(ctx context.Context, s map[string]any, m *SecurityModel) {
+	if elevated, ok := s["elevated"].(map[string]any); ok {
+		readBool(elevated, "requireApproval", &m.ElevatedRequireApproval)
+		readStringList(ctx, elevated, "approvers", &m.ElevatedApprovers)
+	}
+
+	if shell, ok := s["shell"].(map[string]any); ok {
+		readStringList(ctx, shell, "allow", &m.ShellAllow)
+		readStringList(ctx, shell, "deny", &m.ShellDeny)
+	}
+
+	if files, ok := s["files"].(map[string]any); ok {
+		readStringList(ctx, files, "denyPaths", &m.FileDenyPaths)
+	}
+
+	if audit, ok := s["audit"].(map[string]any); ok {
+		readBool(audit, "enabled", &m.AuditEnabled)
+		readString(audit, "path", &m.AuditPath)
+		readBool(audit, "includeToolCalls", &m.AuditIncludeToolCalls)
+	}
+}
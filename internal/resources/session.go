@@ -16,11 +16,12 @@ var _ resource.Resource = &SessionResource{}
 var _ resource.ResourceWithImportState = &SessionResource{}
 
 type SessionResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type SessionModel struct {
 	ID               types.String `tfsdk:"id"`
+	Gateway          types.String `tfsdk:"gateway"`
 	DmScope          types.String `tfsdk:"dm_scope"`
 	ResetMode        types.String `tfsdk:"reset_mode"`
 	ResetAtHour      types.Int64  `tfsdk:"reset_at_hour"`
@@ -28,6 +29,10 @@ type SessionModel struct {
 	ResetTriggers    types.List   `tfsdk:"reset_triggers"`
 }
 
+// sessionKeys are the session settings this resource owns. Overrides under
+// session.overrides belong to openclaw_session_override and survive a destroy.
+var sessionKeys = []string{"dmScope", "resetTriggers", "reset"}
+
 func NewSessionResource() resource.Resource {
 	return &SessionResource{}
 }
@@ -40,7 +45,8 @@ func (r *SessionResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw session configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"dm_scope": schema.StringAttribute{
 				Description: "DM session scope: main|per-peer|per-channel-peer|per-account-channel-peer.",
 				Optional:    true,
@@ -75,10 +81,13 @@ func (r *SessionResource) Configure(_ context.Context, req resource.ConfigureReq
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *SessionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan SessionModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -92,7 +101,7 @@ func (r *SessionResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if err := client.PatchSection(ctx, r.client, "session", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write session config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write session config", err, "session")
 		return
 	}
 
@@ -101,6 +110,9 @@ func (r *SessionResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *SessionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state SessionModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -123,6 +135,9 @@ func (r *SessionResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *SessionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan SessionModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -136,7 +151,7 @@ func (r *SessionResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if err := client.PatchSection(ctx, r.client, "session", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
-		resp.Diagnostics.AddError("Failed to write session config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write session config", err, "session")
 		return
 	}
 
@@ -144,20 +159,31 @@ func (r *SessionResource) Update(ctx context.Context, req resource.UpdateRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *SessionResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *SessionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
 		return
 	}
 
-	if err := client.DeleteSection(ctx, r.client, "session", cfg.Hash); err != nil {
+	unset := make(map[string]any, len(sessionKeys))
+	for _, k := range sessionKeys {
+		unset[k] = nil
+	}
+	if err := client.PatchSection(ctx, r.client, "session", unset, cfg.Hash); err != nil {
 		resp.Diagnostics.AddError("Failed to delete session config", err.Error())
 		return
 	}
 }
 
-func (r *SessionResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *SessionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetSection(ctx, r.client, "session")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import session config", err.Error())
@@ -169,6 +195,7 @@ func (r *SessionResource) ImportState(ctx context.Context, _ resource.ImportStat
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("session")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -0,0 +1,304 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &SessionOverrideResource{}
+var _ resource.ResourceWithImportState = &SessionOverrideResource{}
+var _ resource.ResourceWithValidateConfig = &SessionOverrideResource{}
+
+type SessionOverrideResource struct {
+	gatewayTarget
+}
+
+type SessionOverrideModel struct {
+	ID               types.String `tfsdk:"id"`
+	Gateway          types.String `tfsdk:"gateway"`
+	Channel          types.String `tfsdk:"channel"`
+	AgentID          types.String `tfsdk:"agent_id"`
+	DmScope          types.String `tfsdk:"dm_scope"`
+	ResetMode        types.String `tfsdk:"reset_mode"`
+	ResetAtHour      types.Int64  `tfsdk:"reset_at_hour"`
+	ResetIdleMinutes types.Int64  `tfsdk:"reset_idle_minutes"`
+}
+
+func NewSessionOverrideResource() resource.Resource {
+	return &SessionOverrideResource{}
+}
+
+func (r *SessionOverrideResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_override"
+}
+
+func (r *SessionOverrideResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages session settings for one channel or agent, overriding openclaw_session (session.overrides).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Key under session.overrides: channel:<channel> or agent:<agent_id>.",
+				Computed:    true,
+			},
+			"gateway": gatewayAttribute(),
+			"channel": schema.StringAttribute{
+				Description: "Channel the override applies to (e.g. whatsapp). Set this or agent_id.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agent_id": schema.StringAttribute{
+				Description: "Agent the override applies to. Set this or channel.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dm_scope": schema.StringAttribute{
+				Description: "DM session scope: main|per-peer|per-channel-peer|per-account-channel-peer.",
+				Optional:    true,
+			},
+			"reset_mode": schema.StringAttribute{
+				Description: "Session reset mode: daily|idle.",
+				Optional:    true,
+			},
+			"reset_at_hour": schema.Int64Attribute{
+				Description: "Hour of day to reset (for daily mode).",
+				Optional:    true,
+			},
+			"reset_idle_minutes": schema.Int64Attribute{
+				Description: "Minutes of inactivity before reset (for idle mode).",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *SessionOverrideResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig requires exactly one of channel and agent_id, and checks the
+// session settings against the values openclaw_session accepts.
+func (r *SessionOverrideResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SessionOverrideModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch channel, agent := !config.Channel.IsNull(), !config.AgentID.IsNull(); {
+	case channel && agent:
+		resp.Diagnostics.AddAttributeError(path.Root("agent_id"), "Conflicting scope",
+			"Set either channel or agent_id, not both.")
+	case !channel && !agent:
+		resp.Diagnostics.AddError("Missing scope", "Set channel or agent_id.")
+	}
+
+	if v := config.DmScope; !v.IsNull() && !v.IsUnknown() {
+		switch v.ValueString() {
+		case "main", "per-peer", "per-channel-peer", "per-account-channel-peer":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("dm_scope"), "Invalid DM scope",
+				fmt.Sprintf("dm_scope must be main, per-peer, per-channel-peer or per-account-channel-peer, got %q", v.ValueString()))
+		}
+	}
+	if v := config.ResetMode; !v.IsNull() && !v.IsUnknown() {
+		switch v.ValueString() {
+		case "daily", "idle":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("reset_mode"), "Invalid reset mode",
+				fmt.Sprintf("reset_mode must be daily or idle, got %q", v.ValueString()))
+		}
+	}
+	if v := config.ResetAtHour; !v.IsNull() && !v.IsUnknown() && (v.ValueInt64() < 0 || v.ValueInt64() > 23) {
+		resp.Diagnostics.AddAttributeError(path.Root("reset_at_hour"), "Invalid hour",
+			fmt.Sprintf("reset_at_hour must be between 0 and 23, got %d", v.ValueInt64()))
+	}
+	if v := config.ResetIdleMinutes; !v.IsNull() && !v.IsUnknown() && v.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("reset_idle_minutes"), "Invalid limit",
+			fmt.Sprintf("reset_idle_minutes must be positive, got %d", v.ValueInt64()))
+	}
+}
+
+// overrideKey returns the session.overrides key of the channel or agent m
+// applies to.
+func overrideKey(m SessionOverrideModel) string {
+	if !m.AgentID.IsNull() {
+		return "agent:" + m.AgentID.ValueString()
+	}
+	return "channel:" + m.Channel.ValueString()
+}
+
+func (r *SessionOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SessionOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	key := overrideKey(plan)
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "session", "overrides", key); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write session override", err, "session", "overrides", key)
+		return
+	}
+	plan.ID = types.StringValue(key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SessionOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SessionOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	key := overrideKey(state)
+	section, _, err := client.GetNestedSection(ctx, r.client, "session", "overrides", key)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read session override", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SessionOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SessionOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	key := overrideKey(plan)
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "session", "overrides", key); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write session override", err, "session", "overrides", key)
+		return
+	}
+	plan.ID = types.StringValue(key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SessionOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SessionOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "session", "overrides", overrideKey(state)); err != nil {
+		resp.Diagnostics.AddError("Failed to delete session override", err.Error())
+		return
+	}
+}
+
+// ImportState takes the override key, channel:<channel> or agent:<agent_id>.
+func (r *SessionOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, key, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	state := SessionOverrideModel{Channel: types.StringNull(), AgentID: types.StringNull()}
+	switch kind, name, _ := strings.Cut(key, ":"); {
+	case name == "":
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Expected channel:<channel> or agent:<agent_id>, got %q", key))
+		return
+	case kind == "channel":
+		state.Channel = types.StringValue(name)
+	case kind == "agent":
+		state.AgentID = types.StringValue(name)
+	default:
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Expected channel:<channel> or agent:<agent_id>, got %q", key))
+		return
+	}
+
+	section, _, err := client.GetNestedSection(ctx, r.client, "session", "overrides", key)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import session override", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Session override not found", fmt.Sprintf("No override %q in session.overrides", key))
+		return
+	}
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(key)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// modelToMap writes the override in the layout of the session section. Unset
+// attributes are patched to null, so that removing one from the configuration
+// falls back to the global session setting.
+func (r *SessionOverrideResource) modelToMap(m SessionOverrideModel) map[string]any {
+	d := map[string]any{"reset": nil}
+	setIfString(d, "dmScope", m.DmScope)
+
+	reset := make(map[string]any)
+	setIfString(reset, "mode", m.ResetMode)
+	setIfInt64(reset, "atHour", m.ResetAtHour)
+	setIfInt64(reset, "idleMinutes", m.ResetIdleMinutes)
+	if len(reset) > 0 {
+		d["reset"] = withNullKeys(reset, "mode", "atHour", "idleMinutes")
+	}
+	return withNullKeys(d, "dmScope")
+}
+
+func (r *SessionOverrideResource) mapToModel(s map[string]any, m *SessionOverrideModel) {
+	readString(s, "dmScope", &m.DmScope)
+	if reset, ok := s["reset"].(map[string]any); ok {
+		readString(reset, "mode", &m.ResetMode)
+		readFloat64AsInt64(reset, "atHour", &m.ResetAtHour)
+		readFloat64AsInt64(reset, "idleMinutes", &m.ResetIdleMinutes)
+	}
+}
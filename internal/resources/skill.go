@@ -19,11 +19,12 @@ var _ resource.Resource = &SkillResource{}
 var _ resource.ResourceWithImportState = &SkillResource{}
 
 type SkillResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type SkillModel struct {
 	ID        types.String `tfsdk:"id"`
+	Gateway   types.String `tfsdk:"gateway"`
 	SkillName types.String `tfsdk:"skill_name"`
 	Enabled   types.Bool   `tfsdk:"enabled"`
 	APIKey    types.String `tfsdk:"api_key"`
@@ -42,7 +43,8 @@ func (r *SkillResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 		Description: "Manages an OpenClaw skill entry.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"skill_name": schema.StringAttribute{
 				Description: "Unique skill name. Used as the key under skills.entries.",
 				Required:    true,
@@ -76,10 +78,13 @@ func (r *SkillResource) Configure(_ context.Context, req resource.ConfigureReque
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *SkillResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan SkillModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -97,7 +102,7 @@ func (r *SkillResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	skillName := plan.SkillName.ValueString()
 	if err := client.PatchNestedSection(ctx, r.client, m, cfg.Hash, "skills", "entries", skillName); err != nil {
-		resp.Diagnostics.AddError("Failed to write skill config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write skill config", err, "skills", "entries", skillName)
 		return
 	}
 	plan.ID = types.StringValue(skillName)
@@ -105,6 +110,9 @@ func (r *SkillResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *SkillResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state SkillModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -126,6 +134,9 @@ func (r *SkillResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *SkillResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan SkillModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -143,7 +154,7 @@ func (r *SkillResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 	skillName := plan.SkillName.ValueString()
 	if err := client.PatchNestedSection(ctx, r.client, m, cfg.Hash, "skills", "entries", skillName); err != nil {
-		resp.Diagnostics.AddError("Failed to write skill config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write skill config", err, "skills", "entries", skillName)
 		return
 	}
 	plan.ID = types.StringValue(skillName)
@@ -151,6 +162,9 @@ func (r *SkillResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *SkillResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state SkillModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -169,7 +183,10 @@ func (r *SkillResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SkillResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	skillName := req.ID
+	gw, skillName, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "skills", "entries", skillName)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import skill config", err.Error())
@@ -181,6 +198,7 @@ func (r *SkillResource) ImportState(ctx context.Context, req resource.ImportStat
 		r.mapToModel(section, &state)
 	}
 	state.ID = types.StringValue(skillName)
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -0,0 +1,269 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &SkillRepositoryResource{}
+var _ resource.ResourceWithImportState = &SkillRepositoryResource{}
+var _ resource.ResourceWithValidateConfig = &SkillRepositoryResource{}
+
+type SkillRepositoryResource struct {
+	gatewayTarget
+}
+
+type SkillRepositoryModel struct {
+	ID         types.String `tfsdk:"id"`
+	Gateway    types.String `tfsdk:"gateway"`
+	Name       types.String `tfsdk:"name"`
+	URL        types.String `tfsdk:"url"`
+	AuthToken  types.String `tfsdk:"auth_token"`
+	AutoUpdate types.Bool   `tfsdk:"auto_update"`
+	Ref        types.String `tfsdk:"ref"`
+}
+
+// skillRepositoryKeys are the keys of a skills.repositories entry.
+var skillRepositoryKeys = []string{"url", "token", "autoUpdate", "ref"}
+
+// scpLikeURL matches the user@host:path form git accepts for SSH remotes.
+var scpLikeURL = regexp.MustCompile(`^[A-Za-z0-9._-]+@[A-Za-z0-9.-]+:[^/].*$`)
+
+func NewSkillRepositoryResource() resource.Resource {
+	return &SkillRepositoryResource{}
+}
+
+func (r *SkillRepositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_skill_repository"
+}
+
+func (r *SkillRepositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a skill source repository under skills.repositories, from which skills can be installed.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Unique repository name. Used as the key under skills.repositories.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "Repository or marketplace URL: https://, http://, ssh:// or git:// URL, or an SSH remote such as git@github.com:org/skills.git.",
+				Required:    true,
+			},
+			"auth_token": schema.StringAttribute{
+				Description: "Token sent when fetching over http or https, for private repositories. Sensitive.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"auto_update": schema.BoolAttribute{
+				Description: "Pull new versions of installed skills automatically. Default: false.",
+				Optional:    true,
+			},
+			"ref": schema.StringAttribute{
+				Description: "Branch, tag or commit to pin the repository to. Default branch when unset.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *SkillRepositoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the URL, and that a token is only set for a URL it is
+// sent to.
+func (r *SkillRepositoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SkillRepositoryModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	u := config.URL
+	if u.IsNull() || u.IsUnknown() {
+		return
+	}
+	scheme, ok := repositoryURLScheme(u.ValueString())
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("url"), "Invalid URL",
+			fmt.Sprintf("url must be an https, http, ssh or git URL, or an SSH remote such as git@github.com:org/skills.git, got %q", u.ValueString()))
+		return
+	}
+	if !config.AuthToken.IsNull() && scheme != "http" && scheme != "https" {
+		resp.Diagnostics.AddAttributeError(path.Root("auth_token"), "Unused auth token",
+			"auth_token is only sent over http and https. SSH remotes authenticate with the gateway's SSH keys.")
+	}
+}
+
+// repositoryURLScheme returns the scheme of a repository URL, "ssh" for an
+// SSH remote, and whether the URL is usable at all.
+func repositoryURLScheme(s string) (string, bool) {
+	if scpLikeURL.MatchString(s) {
+		return "ssh", true
+	}
+	parsed, err := url.Parse(s)
+	if err != nil || parsed.Host == "" {
+		return "", false
+	}
+	switch parsed.Scheme {
+	case "https", "http", "ssh", "git":
+		return parsed.Scheme, true
+	}
+	return "", false
+}
+
+func (r *SkillRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SkillRepositoryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "skills", "repositories", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write skill repository", err, "skills", "repositories", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SkillRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SkillRepositoryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := state.Name.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "skills", "repositories", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read skill repository", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SkillRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SkillRepositoryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "skills", "repositories", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write skill repository", err, "skills", "repositories", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SkillRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SkillRepositoryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := state.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "skills", "repositories", name); err != nil {
+		resp.Diagnostics.AddError("Failed to delete skill repository", err.Error())
+		return
+	}
+}
+
+func (r *SkillRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "skills", "repositories", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import skill repository", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Skill repository not found", fmt.Sprintf("No repository named %q in skills.repositories", name))
+		return
+	}
+	var state SkillRepositoryModel
+	state.Name = types.StringValue(name)
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue(name)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// modelToMap patches unset attributes to null, so that removing ref from the
+// configuration unpins the repository.
+func (r *SkillRepositoryResource) modelToMap(m SkillRepositoryModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "url", m.URL)
+	setIfString(d, "token", m.AuthToken)
+	setIfBool(d, "autoUpdate", m.AutoUpdate)
+	setIfString(d, "ref", m.Ref)
+	return withNullKeys(d, skillRepositoryKeys...)
+}
+
+func (r *SkillRepositoryResource) mapToModel(s map[string]any, m *SkillRepositoryModel) {
+	readString(s, "url", &m.URL)
+	readString(s, "token", &m.AuthToken)
+	readBool(s, "autoUpdate", &m.AutoUpdate)
+	readString(s, "ref", &m.Ref)
+}
@@ -0,0 +1,252 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &SlashCommandResource{}
+var _ resource.ResourceWithImportState = &SlashCommandResource{}
+var _ resource.ResourceWithValidateConfig = &SlashCommandResource{}
+
+type SlashCommandResource struct {
+	gatewayTarget
+}
+
+type SlashCommandModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	PromptTemplate  types.String `tfsdk:"prompt_template"`
+	AllowedChannels types.List   `tfsdk:"allowed_channels"`
+	RequiredRole    types.String `tfsdk:"required_role"`
+}
+
+// slashCommandKeys are the keys of a commands entry.
+var slashCommandKeys = []string{"description", "prompt", "channels", "role"}
+
+// commandNamePattern matches names chat platforms accept for commands:
+// lowercase, at most 32 characters, typed without the leading slash.
+var commandNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+func NewSlashCommandResource() resource.Resource {
+	return &SlashCommandResource{}
+}
+
+func (r *SlashCommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slash_command"
+}
+
+func (r *SlashCommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom slash command under commands: /name runs the agent with a prompt template.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Command name without the slash (e.g. standup). Lowercase letters, digits, - and _, at most 32 characters. Used as the key under commands.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Short description shown in command menus on channels that have them (Telegram, Discord, Slack).",
+				Optional:    true,
+			},
+			"prompt_template": schema.StringAttribute{
+				Description: "Prompt the agent runs. {{args}} is replaced with the text after the command, {{peerName}} with the sender's name.",
+				Required:    true,
+			},
+			"allowed_channels": schema.ListAttribute{
+				Description: "Channels the command is available on (e.g. slack, telegram). All channels when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"required_role": schema.StringAttribute{
+				Description: "Who may run the command: anyone, allowlisted (senders on the channel's allow_from) or approver (security elevated_approvers). Default: anyone.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *SlashCommandResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the command name, the channel list and the role.
+func (r *SlashCommandResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SlashCommandModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if n := config.Name; !n.IsNull() && !n.IsUnknown() && !commandNamePattern.MatchString(n.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid command name",
+			fmt.Sprintf("Command names are up to 32 lowercase letters, digits, - and _, without the leading slash, got %q", n.ValueString()))
+	}
+	validateListElements(ctx, &resp.Diagnostics, "allowed_channels", config.AllowedChannels, strings.ToLower, nil)
+
+	if role := config.RequiredRole; !role.IsNull() && !role.IsUnknown() {
+		switch role.ValueString() {
+		case "anyone", "allowlisted", "approver":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("required_role"), "Invalid role",
+				fmt.Sprintf("required_role must be anyone, allowlisted or approver, got %q", role.ValueString()))
+		}
+	}
+}
+
+func (r *SlashCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SlashCommandModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "commands", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write slash command", err, "commands", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SlashCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SlashCommandModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := state.Name.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "commands", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read slash command", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SlashCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SlashCommandModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "commands", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write slash command", err, "commands", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SlashCommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SlashCommandModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := state.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "commands", name); err != nil {
+		resp.Diagnostics.AddError("Failed to delete slash command", err.Error())
+		return
+	}
+}
+
+func (r *SlashCommandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "commands", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import slash command", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Slash command not found", fmt.Sprintf("No command named %q in commands", name))
+		return
+	}
+	state := SlashCommandModel{AllowedChannels: types.ListNull(types.StringType)}
+	state.Name = types.StringValue(name)
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// modelToMap patches unset attributes to null, so that removing
+// allowed_channels from the configuration opens the command to every channel.
+func (r *SlashCommandResource) modelToMap(ctx context.Context, m SlashCommandModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "description", m.Description)
+	setIfString(d, "prompt", m.PromptTemplate)
+	setIfStringList(ctx, d, "channels", m.AllowedChannels)
+	setIfString(d, "role", m.RequiredRole)
+	return withNullKeys(d, slashCommandKeys...)
+}
+
+func (r *SlashCommandResource) mapToModel(ctx context.Context, s map[string]any, m *SlashCommandModel) {
+	readString(s, "description", &m.Description)
+	readString(s, "prompt", &m.PromptTemplate)
+	readStringList(ctx, s, "channels", &m.AllowedChannels)
+	readString(s, "role", &m.RequiredRole)
+}
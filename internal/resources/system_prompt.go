@@ -0,0 +1,299 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &SystemPromptResource{}
+var _ resource.ResourceWithImportState = &SystemPromptResource{}
+var _ resource.ResourceWithValidateConfig = &SystemPromptResource{}
+var _ resource.ResourceWithModifyPlan = &SystemPromptResource{}
+
+type SystemPromptResource struct {
+	gatewayTarget
+}
+
+type SystemPromptModel struct {
+	ID            types.String `tfsdk:"id"`
+	Gateway       types.String `tfsdk:"gateway"`
+	Key           types.String `tfsdk:"key"`
+	Content       types.String `tfsdk:"content"`
+	ContentFile   types.String `tfsdk:"content_file"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	Agents        types.List   `tfsdk:"agents"`
+	Channels      types.List   `tfsdk:"channels"`
+}
+
+func NewSystemPromptResource() resource.Resource {
+	return &SystemPromptResource{}
+}
+
+func (r *SystemPromptResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_prompt"
+}
+
+func (r *SystemPromptResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a named system prompt template under prompts.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"key": schema.StringAttribute{
+				Description: "Template name. Used as the key under prompts.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Prompt text. Exactly one of content or content_file must be set.",
+				Optional:    true,
+			},
+			"content_file": schema.StringAttribute{
+				Description: "Path of a file holding the prompt text, read at plan and apply time. Exactly one of content or content_file must be set.",
+				Optional:    true,
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA-256 of the prompt text. Changes when the text or the file it is read from changes.",
+				Computed:    true,
+			},
+			"agents": schema.ListAttribute{
+				Description: "Agent IDs the prompt applies to. All agents when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"channels": schema.ListAttribute{
+				Description: "Channels the prompt applies to. All channels when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// ValidateConfig requires exactly one source for the prompt text.
+func (r *SystemPromptResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SystemPromptModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !config.Content.IsNull() && !config.ContentFile.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("content_file"), "Conflicting prompt sources",
+			"Set either content or content_file, not both.")
+	case config.Content.IsNull() && config.ContentFile.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Missing prompt content",
+			"Set content or content_file.")
+	}
+}
+
+// ModifyPlan hashes the prompt text into content_sha256, so that a change to
+// the file behind content_file shows up as an update.
+func (r *SystemPromptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan SystemPromptModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Content.IsUnknown() || plan.ContentFile.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringUnknown())...)
+		return
+	}
+	content, err := contentOrFile(plan.Content, plan.ContentFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content_file"), "Failed to read content_file", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringValue(contentSHA256(content)))...)
+}
+
+func (r *SystemPromptResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *SystemPromptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SystemPromptModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writePrompt(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SystemPromptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SystemPromptModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	key := state.Key.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "prompts", key)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read system prompt", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SystemPromptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan SystemPromptModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writePrompt(ctx, req.Plan, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SystemPromptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state SystemPromptModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "prompts", state.Key.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete system prompt", err.Error())
+		return
+	}
+}
+
+// ImportState imports the prompt with its text in content. Switch to
+// content_file afterwards if the text should come from a file.
+func (r *SystemPromptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, key, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "prompts", key)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import system prompt", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("System prompt not found", fmt.Sprintf("No prompt %q in prompts", key))
+		return
+	}
+	state := SystemPromptModel{
+		Agents:   types.ListNull(types.StringType),
+		Channels: types.ListNull(types.StringType),
+	}
+	state.Key = types.StringValue(key)
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(key)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// writePrompt stores the planned prompt and records the hash of the text
+// written.
+func (r *SystemPromptResource) writePrompt(ctx context.Context, plan tfsdk.Plan, m *SystemPromptModel, diags *diag.Diagnostics) bool {
+	content, err := contentOrFile(m.Content, m.ContentFile)
+	if err != nil {
+		diags.AddAttributeError(path.Root("content_file"), "Failed to read content_file", err.Error())
+		return false
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		diags.AddError("Failed to read config", err.Error())
+		return false
+	}
+	d := map[string]any{"content": content}
+	setIfStringList(ctx, d, "agents", m.Agents)
+	setIfStringList(ctx, d, "channels", m.Channels)
+	key := m.Key.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, d, cfg.Hash, "prompts", key); err != nil {
+		addWriteError(ctx, diags, plan, "Failed to write system prompt", err, "prompts", key)
+		return false
+	}
+	m.ID = types.StringValue(key)
+	m.ContentSHA256 = types.StringValue(contentSHA256(content))
+	return true
+}
+
+// mapToModel reads the prompt back. The text is only copied into content
+// when content is in use; with content_file, changes show up through
+// content_sha256 instead.
+func (r *SystemPromptResource) mapToModel(ctx context.Context, s map[string]any, m *SystemPromptModel) {
+	content, _ := s["content"].(string)
+	m.ContentSHA256 = types.StringValue(contentSHA256(content))
+	if m.ContentFile.IsNull() {
+		m.Content = types.StringValue(content)
+	}
+	readStringList(ctx, s, "agents", &m.Agents)
+	readStringList(ctx, s, "channels", &m.Channels)
+}
+
+// contentOrFile returns content, or the contents of the file named by
+// contentFile when content is not in use.
+func contentOrFile(content, contentFile types.String) (string, error) {
+	if contentFile.IsNull() {
+		return content.ValueString(), nil
+	}
+	data, err := os.ReadFile(contentFile.ValueString())
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func contentSHA256(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,284 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &TailscaleResource{}
+var _ resource.ResourceWithImportState = &TailscaleResource{}
+var _ resource.ResourceWithValidateConfig = &TailscaleResource{}
+
+type TailscaleResource struct {
+	gatewayTarget
+}
+
+type TailscaleModel struct {
+	ID            types.String `tfsdk:"id"`
+	Gateway       types.String `tfsdk:"gateway"`
+	Hostname      types.String `tfsdk:"hostname"`
+	AuthKey       types.String `tfsdk:"auth_key"`
+	ServePath     types.String `tfsdk:"serve_path"`
+	Funnel        types.Bool   `tfsdk:"funnel"`
+	ACLTags       types.List   `tfsdk:"acl_tags"`
+	HTTPSCertMode types.String `tfsdk:"https_cert_mode"`
+}
+
+func NewTailscaleResource() resource.Resource {
+	return &TailscaleResource{}
+}
+
+func (r *TailscaleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tailscale"
+}
+
+func (r *TailscaleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages how the gateway is exposed over Tailscale (gateway.tailscale). The gateway is served on the tailnet while this resource exists, and on the public internet with funnel.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"hostname": schema.StringAttribute{
+				Description: "Tailscale machine name for the gateway (e.g. openclaw). Defaults to the host's name.",
+				Optional:    true,
+			},
+			"auth_key": schema.StringAttribute{
+				Description: "Tailscale auth key the gateway uses to join the tailnet. Sensitive. Not needed when the host is already logged in.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"serve_path": schema.StringAttribute{
+				Description: "URL path the gateway is served under (e.g. /openclaw). Default: /.",
+				Optional:    true,
+			},
+			"funnel": schema.BoolAttribute{
+				Description: "Expose the gateway on the public internet with Tailscale Funnel, rather than on the tailnet only.",
+				Optional:    true,
+			},
+			"acl_tags": schema.ListAttribute{
+				Description: "ACL tags to advertise (e.g. tag:openclaw). Each must be allowed for the auth key in the tailnet policy.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"https_cert_mode": schema.StringAttribute{
+				Description: "HTTPS certificates: auto (issued by Tailscale) or off (plain HTTP on the tailnet). Default: auto.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *TailscaleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the path, tags and cert mode. Funnel only serves
+// HTTPS, so it cannot be combined with https_cert_mode off.
+func (r *TailscaleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TailscaleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if p := config.ServePath; !p.IsNull() && !p.IsUnknown() && !strings.HasPrefix(p.ValueString(), "/") {
+		resp.Diagnostics.AddAttributeError(path.Root("serve_path"), "Invalid serve path",
+			fmt.Sprintf("serve_path must start with /, got %q", p.ValueString()))
+	}
+	if !config.ACLTags.IsNull() && !config.ACLTags.IsUnknown() {
+		var tags []types.String
+		resp.Diagnostics.Append(config.ACLTags.ElementsAs(ctx, &tags, false)...)
+		for i, tag := range tags {
+			if tag.IsUnknown() || strings.HasPrefix(tag.ValueString(), "tag:") && len(tag.ValueString()) > len("tag:") {
+				continue
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("acl_tags").AtListIndex(i), "Invalid ACL tag",
+				fmt.Sprintf("ACL tags look like tag:name, got %q", tag.ValueString()))
+		}
+	}
+
+	m := config.HTTPSCertMode
+	if m.IsNull() || m.IsUnknown() {
+		return
+	}
+	switch m.ValueString() {
+	case "auto":
+	case "off":
+		if config.Funnel.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("https_cert_mode"), "Funnel requires HTTPS",
+				"Tailscale Funnel only serves HTTPS. Set https_cert_mode to auto, or funnel to false.")
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("https_cert_mode"), "Invalid cert mode",
+			fmt.Sprintf("https_cert_mode must be auto or off, got %q", m.ValueString()))
+	}
+}
+
+func (r *TailscaleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan TailscaleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "gateway", "tailscale"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Tailscale config", err, "gateway", "tailscale")
+		return
+	}
+
+	plan.ID = types.StringValue("tailscale")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the resource when Tailscale exposure was turned off outside
+// Terraform, so that the next apply turns it back on.
+func (r *TailscaleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state TailscaleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetNestedSection(ctx, r.client, "gateway", "tailscale")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Tailscale config", err.Error())
+		return
+	}
+	if mode, _ := section["mode"].(string); section == nil || mode == "" || mode == "off" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("tailscale")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TailscaleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan TailscaleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "gateway", "tailscale"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write Tailscale config", err, "gateway", "tailscale")
+		return
+	}
+
+	plan.ID = types.StringValue("tailscale")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TailscaleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "gateway", "tailscale"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Tailscale config", err.Error())
+		return
+	}
+}
+
+// ImportState imports the Tailscale settings. The auth key is not imported.
+func (r *TailscaleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "gateway", "tailscale")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import Tailscale config", err.Error())
+		return
+	}
+
+	state := TailscaleModel{ACLTags: types.ListNull(types.StringType)}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("tailscale")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+// tailscaleKeys are the keys of gateway.tailscale this resource manages,
+// besides mode, which it always sets.
+var tailscaleKeys = []string{"hostname", "authKey", "path", "tags", "httpsCertMode"}
+
+// modelToMap patches unset attributes to null, so that removing one from the
+// configuration returns the setting to the gateway's default.
+func (r *TailscaleResource) modelToMap(ctx context.Context, m TailscaleModel) map[string]any {
+	d := map[string]any{"mode": "serve"}
+	if m.Funnel.ValueBool() {
+		d["mode"] = "funnel"
+	}
+	setIfString(d, "hostname", m.Hostname)
+	setIfString(d, "authKey", m.AuthKey)
+	setIfString(d, "path", m.ServePath)
+	setIfStringList(ctx, d, "tags", m.ACLTags)
+	setIfString(d, "httpsCertMode", m.HTTPSCertMode)
+	for _, k := range tailscaleKeys {
+		if _, ok := d[k]; !ok {
+			d[k] = nil
+		}
+	}
+	return d
+}
+
+func (r *TailscaleResource) mapToModel(ctx context.Context, s map[string]any, m *TailscaleModel) {
+	// funnel stays null while unset and the gateway is only on the tailnet.
+	if mode, _ := s["mode"].(string); mode == "funnel" || !m.Funnel.IsNull() {
+		m.Funnel = types.BoolValue(mode == "funnel")
+	}
+	readString(s, "hostname", &m.Hostname)
+	// Don't read back the auth key from config for security.
+	readString(s, "path", &m.ServePath)
+	readStringList(ctx, s, "tags", &m.ACLTags)
+	readString(s, "httpsCertMode", &m.HTTPSCertMode)
+}
@@ -0,0 +1,231 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &ToolProfileResource{}
+var _ resource.ResourceWithImportState = &ToolProfileResource{}
+
+type ToolProfileResource struct {
+	gatewayTarget
+}
+
+type ToolProfileModel struct {
+	ID          types.String `tfsdk:"id"`
+	Gateway     types.String `tfsdk:"gateway"`
+	Name        types.String `tfsdk:"name"`
+	Allow       types.List   `tfsdk:"allow"`
+	Deny        types.List   `tfsdk:"deny"`
+	OptionsJSON types.String `tfsdk:"options_json"`
+}
+
+func NewToolProfileResource() resource.Resource {
+	return &ToolProfileResource{}
+}
+
+func (r *ToolProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool_profile"
+}
+
+func (r *ToolProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a named custom tool profile under tools.profiles.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Unique profile name. Used as the key under tools.profiles, and referenced by tools_profile on agents.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow": schema.ListAttribute{
+				Description: "Tool names the profile allows.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"deny": schema.ListAttribute{
+				Description: "Tool names the profile denies.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"options_json": schema.StringAttribute{
+				Description: "JSON object of per-tool options, keyed by tool name.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ToolProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *ToolProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ToolProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	m, err := r.modelToMap(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid options_json", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, m, cfg.Hash, "tools", "profiles", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write tool profile config", err, "tools", "profiles", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ToolProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ToolProfileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := state.Name.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "tools", "profiles", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read tool profile config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ToolProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan ToolProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	m, err := r.modelToMap(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid options_json", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, m, cfg.Hash, "tools", "profiles", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write tool profile config", err, "tools", "profiles", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ToolProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state ToolProfileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "tools", "profiles", state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete tool profile config", err.Error())
+		return
+	}
+}
+
+func (r *ToolProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "tools", "profiles", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import tool profile config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Tool profile not found", fmt.Sprintf("No tool profile named %q in tools.profiles", name))
+		return
+	}
+	state := ToolProfileModel{
+		Allow: types.ListNull(types.StringType),
+		Deny:  types.ListNull(types.StringType),
+	}
+	state.Name = types.StringValue(name)
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ToolProfileResource) modelToMap(ctx context.Context, m ToolProfileModel) (map[string]any, error) {
+	d := make(map[string]any)
+	setIfStringList(ctx, d, "allow", m.Allow)
+	setIfStringList(ctx, d, "deny", m.Deny)
+	if !m.OptionsJSON.IsNull() && !m.OptionsJSON.IsUnknown() {
+		var parsed map[string]any
+		if err := json.Unmarshal([]byte(m.OptionsJSON.ValueString()), &parsed); err != nil {
+			return nil, fmt.Errorf("options_json must be a valid JSON object: %w", err)
+		}
+		d["options"] = parsed
+	}
+	return d, nil
+}
+
+func (r *ToolProfileResource) mapToModel(ctx context.Context, s map[string]any, m *ToolProfileModel) {
+	readStringList(ctx, s, "allow", &m.Allow)
+	readStringList(ctx, s, "deny", &m.Deny)
+	if v, ok := s["options"].(map[string]any); ok && len(v) > 0 {
+		b, _ := json.Marshal(v)
+		m.OptionsJSON = types.StringValue(string(b))
+	}
+}
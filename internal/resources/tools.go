@@ -16,11 +16,12 @@ var _ resource.Resource = &ToolsResource{}
 var _ resource.ResourceWithImportState = &ToolsResource{}
 
 type ToolsResource struct {
-	client client.Client
+	gatewayTarget
 }
 
 type ToolsModel struct {
 	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
 	Profile         types.String `tfsdk:"profile"`
 	Allow           types.List   `tfsdk:"allow"`
 	Deny            types.List   `tfsdk:"deny"`
@@ -40,9 +41,10 @@ func (r *ToolsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 		Description: "Manages the OpenClaw tools configuration.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
 			"profile": schema.StringAttribute{
-				Description: "Tools profile: minimal, coding, messaging, or full.",
+				Description: "Tools profile: minimal, coding, messaging, full, or a custom profile defined with openclaw_tool_profile.",
 				Optional:    true,
 			},
 			"allow": schema.ListAttribute{
@@ -76,10 +78,13 @@ func (r *ToolsResource) Configure(_ context.Context, req resource.ConfigureReque
 		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
 		return
 	}
-	r.client = pd.Client
+	r.setProviderData(pd)
 }
 
 func (r *ToolsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ToolsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -91,7 +96,7 @@ func (r *ToolsResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "tools"); err != nil {
-		resp.Diagnostics.AddError("Failed to write tools config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write tools config", err, "tools")
 		return
 	}
 	plan.ID = types.StringValue("tools")
@@ -99,6 +104,9 @@ func (r *ToolsResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *ToolsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	var state ToolsModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -119,6 +127,9 @@ func (r *ToolsResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *ToolsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
 	var plan ToolsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -130,26 +141,42 @@ func (r *ToolsResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "tools"); err != nil {
-		resp.Diagnostics.AddError("Failed to write tools config", err.Error())
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write tools config", err, "tools")
 		return
 	}
 	plan.ID = types.StringValue("tools")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *ToolsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *ToolsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 	cfg, err := r.client.GetConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read config", err.Error())
 		return
 	}
-	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "tools"); err != nil {
+	// Clear only the settings this resource manages: the rest of
+	// tools.browser belongs to openclaw_browser.
+	unset := map[string]any{
+		"profile":  nil,
+		"allow":    nil,
+		"deny":     nil,
+		"elevated": nil,
+		"browser":  map[string]any{"enabled": nil},
+	}
+	if err := client.PatchNestedSection(ctx, r.client, unset, cfg.Hash, "tools"); err != nil {
 		resp.Diagnostics.AddError("Failed to delete tools config", err.Error())
 		return
 	}
 }
 
-func (r *ToolsResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *ToolsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 	section, _, err := client.GetNestedSection(ctx, r.client, "tools")
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import tools config", err.Error())
@@ -160,6 +187,7 @@ func (r *ToolsResource) ImportState(ctx context.Context, _ resource.ImportStateR
 		r.mapToModel(ctx, section, &state)
 	}
 	state.ID = types.StringValue("tools")
+	state.Gateway = gatewayValue(gw)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
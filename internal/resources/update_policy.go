@@ -0,0 +1,271 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &UpdatePolicyResource{}
+var _ resource.ResourceWithImportState = &UpdatePolicyResource{}
+var _ resource.ResourceWithValidateConfig = &UpdatePolicyResource{}
+
+type UpdatePolicyResource struct {
+	gatewayTarget
+}
+
+type UpdatePolicyModel struct {
+	ID              types.String `tfsdk:"id"`
+	Gateway         types.String `tfsdk:"gateway"`
+	Channel         types.String `tfsdk:"channel"`
+	CheckInterval   types.String `tfsdk:"check_interval"`
+	AutoApply       types.Bool   `tfsdk:"auto_apply"`
+	AutoApplyWindow types.String `tfsdk:"auto_apply_window"`
+	PinnedVersion   types.String `tfsdk:"pinned_version"`
+}
+
+func NewUpdatePolicyResource() resource.Resource {
+	return &UpdatePolicyResource{}
+}
+
+func (r *UpdatePolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_update_policy"
+}
+
+func (r *UpdatePolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages how OpenClaw updates itself (update section).",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"channel": schema.StringAttribute{
+				Description: "Release channel to follow: stable|beta|nightly.",
+				Optional:    true,
+			},
+			"check_interval": schema.StringAttribute{
+				Description: "How often to check for a new release, as a duration string (e.g. 6h).",
+				Optional:    true,
+			},
+			"auto_apply": schema.BoolAttribute{
+				Description: "Install new releases without asking. When false, updates are only announced.",
+				Optional:    true,
+			},
+			"auto_apply_window": schema.StringAttribute{
+				Description: "Time of day updates may be installed, as HH:MM-HH:MM in the gateway host's time zone (e.g. 02:00-05:00). May wrap past midnight. Any time when unset.",
+				Optional:    true,
+			},
+			"pinned_version": schema.StringAttribute{
+				Description: "Version to stay on (e.g. 2026.10.2). No other version is installed while set.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *UpdatePolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig rejects a channel the gateway does not know and a malformed
+// apply window, and requires auto_apply for the window to matter.
+func (r *UpdatePolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config UpdatePolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if c := config.Channel; !c.IsNull() && !c.IsUnknown() {
+		switch c.ValueString() {
+		case "stable", "beta", "nightly":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("channel"), "Invalid channel",
+				fmt.Sprintf("channel must be stable, beta or nightly, got %q", c.ValueString()))
+		}
+	}
+
+	w := config.AutoApplyWindow
+	if w.IsNull() || w.IsUnknown() {
+		return
+	}
+	if !validApplyWindow(w.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("auto_apply_window"), "Invalid window",
+			fmt.Sprintf("auto_apply_window must be HH:MM-HH:MM (e.g. 02:00-05:00), got %q", w.ValueString()))
+	}
+	if !config.AutoApply.IsUnknown() && !config.AutoApply.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("auto_apply_window"), "Unused apply window",
+			"auto_apply_window only applies when auto_apply is true.")
+	}
+}
+
+func (r *UpdatePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan UpdatePolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "update", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write update policy", err, "update")
+		return
+	}
+
+	plan.ID = types.StringValue("update_policy")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UpdatePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state UpdatePolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "update")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read update policy", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue("update_policy")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *UpdatePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan UpdatePolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "update", r.modelToMap(plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write update policy", err, "update")
+		return
+	}
+
+	plan.ID = types.StringValue("update_policy")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UpdatePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "update", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete update policy", err.Error())
+		return
+	}
+}
+
+func (r *UpdatePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "update")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import update policy", err.Error())
+		return
+	}
+
+	var state UpdatePolicyModel
+	if section != nil {
+		r.mapToModel(section, &state)
+	}
+	state.ID = types.StringValue("update_policy")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+// updatePolicyKeys are the keys of the update section this resource manages.
+var updatePolicyKeys = []string{"channel", "checkInterval", "autoApply", "applyWindow", "pinnedVersion"}
+
+// modelToMap patches unset attributes to null, so that removing
+// pinned_version from the configuration unpins the gateway.
+func (r *UpdatePolicyResource) modelToMap(m UpdatePolicyModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "channel", m.Channel)
+	setIfString(d, "checkInterval", m.CheckInterval)
+	setIfBool(d, "autoApply", m.AutoApply)
+	setIfString(d, "applyWindow", m.AutoApplyWindow)
+	setIfString(d, "pinnedVersion", m.PinnedVersion)
+	for _, k := range updatePolicyKeys {
+		if _, ok := d[k]; !ok {
+			d[k] = nil
+		}
+	}
+	return d
+}
+
+func (r *UpdatePolicyResource) mapToModel(s map[string]any, m *UpdatePolicyModel) {
+	readString(s, "channel", &m.Channel)
+	readString(s, "checkInterval", &m.CheckInterval)
+	readBool(s, "autoApply", &m.AutoApply)
+	readString(s, "applyWindow", &m.AutoApplyWindow)
+	readString(s, "pinnedVersion", &m.PinnedVersion)
+}
+
+// validApplyWindow reports whether w is two times of day, HH:MM-HH:MM.
+func validApplyWindow(w string) bool {
+	from, to, ok := strings.Cut(w, "-")
+	if !ok {
+		return false
+	}
+	for _, t := range []string{from, to} {
+		if _, err := time.Parse("15:04", t); err != nil || len(t) != 5 {
+			return false
+		}
+	}
+	return from != to
+}
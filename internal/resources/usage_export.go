@@ -0,0 +1,329 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &UsageExportResource{}
+var _ resource.ResourceWithImportState = &UsageExportResource{}
+var _ resource.ResourceWithValidateConfig = &UsageExportResource{}
+
+type UsageExportResource struct {
+	gatewayTarget
+}
+
+type UsageExportModel struct {
+	ID          types.String `tfsdk:"id"`
+	Gateway     types.String `tfsdk:"gateway"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Destination types.String `tfsdk:"destination"`
+	S3Bucket    types.String `tfsdk:"s3_bucket"`
+	S3Prefix    types.String `tfsdk:"s3_prefix"`
+	S3Region    types.String `tfsdk:"s3_region"`
+	WebhookURL  types.String `tfsdk:"webhook_url"`
+	FilePath    types.String `tfsdk:"file_path"`
+	Schedule    types.String `tfsdk:"schedule"`
+	Format      types.String `tfsdk:"format"`
+}
+
+// usageExportKeys are the keys of usage.export, and usageDestinationKeys
+// those of its destination object.
+var (
+	usageExportKeys      = []string{"enabled", "destination", "schedule", "format"}
+	usageDestinationKeys = []string{"type", "bucket", "prefix", "region", "url", "path"}
+)
+
+// s3BucketPattern matches S3 bucket names: 3 to 63 lowercase letters,
+// digits, dots and hyphens, starting and ending with a letter or digit.
+var s3BucketPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+func NewUsageExportResource() resource.Resource {
+	return &UsageExportResource{}
+}
+
+func (r *UsageExportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_export"
+}
+
+func (r *UsageExportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the scheduled export of usage and cost data (usage.export) to S3, a webhook or a file.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Enable or disable the export. Default: true.",
+				Optional:    true,
+			},
+			"destination": schema.StringAttribute{
+				Description: "Where usage data is sent: s3|webhook|file.",
+				Required:    true,
+			},
+			"s3_bucket": schema.StringAttribute{
+				Description: "S3 bucket to write to. Required for the s3 destination.",
+				Optional:    true,
+			},
+			"s3_prefix": schema.StringAttribute{
+				Description: "Key prefix for exported objects (e.g. openclaw/usage/).",
+				Optional:    true,
+			},
+			"s3_region": schema.StringAttribute{
+				Description: "Region of the bucket. The gateway's AWS default when unset.",
+				Optional:    true,
+			},
+			"webhook_url": schema.StringAttribute{
+				Description: "URL each export is POSTed to. Required for the webhook destination.",
+				Optional:    true,
+			},
+			"file_path": schema.StringAttribute{
+				Description: "Absolute path on the gateway host of the directory exports are written to. Required for the file destination.",
+				Optional:    true,
+			},
+			"schedule": schema.StringAttribute{
+				Description: "How often usage is exported: hourly|daily|weekly|monthly. Default: daily.",
+				Optional:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Format of exported data: csv|json. Default: json.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *UsageExportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the schedule and format, and that exactly the
+// settings of the chosen destination are set.
+func (r *UsageExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config UsageExportModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if s := config.Schedule; !s.IsNull() && !s.IsUnknown() {
+		switch s.ValueString() {
+		case "hourly", "daily", "weekly", "monthly":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("schedule"), "Invalid schedule",
+				fmt.Sprintf("schedule must be hourly, daily, weekly or monthly, got %q", s.ValueString()))
+		}
+	}
+	if f := config.Format; !f.IsNull() && !f.IsUnknown() {
+		switch f.ValueString() {
+		case "csv", "json":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("format"), "Invalid format",
+				fmt.Sprintf("format must be csv or json, got %q", f.ValueString()))
+		}
+	}
+
+	if b := config.S3Bucket; !b.IsNull() && !b.IsUnknown() && !s3BucketPattern.MatchString(b.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("s3_bucket"), "Invalid bucket",
+			fmt.Sprintf("s3_bucket must be a bucket name, without s3:// or a path, got %q", b.ValueString()))
+	}
+	if u := config.WebhookURL; !u.IsNull() && !u.IsUnknown() {
+		if parsed, err := url.Parse(u.ValueString()); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("webhook_url"), "Invalid URL",
+				fmt.Sprintf("webhook_url must be an absolute http or https URL, got %q", u.ValueString()))
+		}
+	}
+	if p := config.FilePath; !p.IsNull() && !p.IsUnknown() && !filepath.IsAbs(p.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Invalid path",
+			fmt.Sprintf("file_path must be an absolute path, got %q", p.ValueString()))
+	}
+
+	dest := config.Destination
+	if dest.IsNull() || dest.IsUnknown() {
+		return
+	}
+	settings := []struct {
+		name        string
+		destination string
+		set         bool
+		required    bool
+	}{
+		{"s3_bucket", "s3", !config.S3Bucket.IsNull(), true},
+		{"s3_prefix", "s3", !config.S3Prefix.IsNull(), false},
+		{"s3_region", "s3", !config.S3Region.IsNull(), false},
+		{"webhook_url", "webhook", !config.WebhookURL.IsNull(), true},
+		{"file_path", "file", !config.FilePath.IsNull(), true},
+	}
+	switch dest.ValueString() {
+	case "s3", "webhook", "file":
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("destination"), "Invalid destination",
+			fmt.Sprintf("destination must be s3, webhook or file, got %q", dest.ValueString()))
+		return
+	}
+	for _, s := range settings {
+		switch {
+		case s.destination == dest.ValueString() && s.required && !s.set:
+			resp.Diagnostics.AddAttributeError(path.Root(s.name), "Missing destination setting",
+				fmt.Sprintf("%s is required for the %s destination.", s.name, s.destination))
+		case s.destination != dest.ValueString() && s.set:
+			resp.Diagnostics.AddAttributeError(path.Root(s.name), "Unused destination setting",
+				fmt.Sprintf("%s only applies to the %s destination, not %s.", s.name, s.destination, dest.ValueString()))
+		}
+	}
+}
+
+func (r *UsageExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan UsageExportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "usage", "export"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write usage export config", err, "usage", "export")
+		return
+	}
+	plan.ID = types.StringValue("export")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UsageExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state UsageExportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "usage", "export")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read usage export config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue("export")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *UsageExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan UsageExportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(plan), cfg.Hash, "usage", "export"); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write usage export config", err, "usage", "export")
+		return
+	}
+	plan.ID = types.StringValue("export")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UsageExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "usage", "export"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete usage export config", err.Error())
+		return
+	}
+}
+
+func (r *UsageExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "usage", "export")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import usage export config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Usage export not found", "No usage.export in the config")
+		return
+	}
+	var state UsageExportModel
+	r.mapToModel(section, &state)
+	state.ID = types.StringValue("export")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// modelToMap patches unset attributes to null. The destination keys of
+// other destination types are nulled too, so that switching from s3 to a
+// webhook leaves no bucket behind.
+func (r *UsageExportResource) modelToMap(m UsageExportModel) map[string]any {
+	d := make(map[string]any)
+	setIfBool(d, "enabled", m.Enabled)
+	setIfString(d, "schedule", m.Schedule)
+	setIfString(d, "format", m.Format)
+
+	dest := make(map[string]any)
+	setIfString(dest, "type", m.Destination)
+	setIfString(dest, "bucket", m.S3Bucket)
+	setIfString(dest, "prefix", m.S3Prefix)
+	setIfString(dest, "region", m.S3Region)
+	setIfString(dest, "url", m.WebhookURL)
+	setIfString(dest, "path", m.FilePath)
+	d["destination"] = withNullKeys(dest, usageDestinationKeys...)
+	return withNullKeys(d, usageExportKeys...)
+}
+
+func (r *UsageExportResource) mapToModel(s map[string]any, m *UsageExportModel) {
+	readBool(s, "enabled", &m.Enabled)
+	readString(s, "schedule", &m.Schedule)
+	readString(s, "format", &m.Format)
+	if dest, ok := s["destination"].(map[string]any); ok {
+		readString(dest, "type", &m.Destination)
+		readString(dest, "bucket", &m.S3Bucket)
+		readString(dest, "prefix", &m.S3Prefix)
+		readString(dest, "region", &m.S3Region)
+		readString(dest, "url", &m.WebhookURL)
+		readString(dest, "path", &m.FilePath)
+	}
+}
@@ -0,0 +1,289 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &VoiceResource{}
+var _ resource.ResourceWithImportState = &VoiceResource{}
+var _ resource.ResourceWithValidateConfig = &VoiceResource{}
+
+type VoiceResource struct {
+	gatewayTarget
+}
+
+type VoiceModel struct {
+	ID               types.String  `tfsdk:"id"`
+	Gateway          types.String  `tfsdk:"gateway"`
+	STTProvider      types.String  `tfsdk:"stt_provider"`
+	STTModel         types.String  `tfsdk:"stt_model"`
+	TTSProvider      types.String  `tfsdk:"tts_provider"`
+	TTSVoice         types.String  `tfsdk:"tts_voice"`
+	TTSSpeed         types.Float64 `tfsdk:"tts_speed"`
+	AutoTranscribe   types.Map     `tfsdk:"auto_transcribe"`
+	MaxAudioDuration types.Int64   `tfsdk:"max_audio_duration_seconds"`
+}
+
+func NewVoiceResource() resource.Resource {
+	return &VoiceResource{}
+}
+
+func (r *VoiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_voice"
+}
+
+func (r *VoiceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages speech-to-text and text-to-speech (voice section): how voice notes are transcribed and how spoken replies are generated.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"stt_provider": schema.StringAttribute{
+				Description: "Speech-to-text provider (e.g. openai, deepgram, local).",
+				Optional:    true,
+			},
+			"stt_model": schema.StringAttribute{
+				Description: "Speech-to-text model (e.g. whisper-1).",
+				Optional:    true,
+			},
+			"tts_provider": schema.StringAttribute{
+				Description: "Text-to-speech provider (e.g. openai, elevenlabs).",
+				Optional:    true,
+			},
+			"tts_voice": schema.StringAttribute{
+				Description: "Text-to-speech voice name or ID.",
+				Optional:    true,
+			},
+			"tts_speed": schema.Float64Attribute{
+				Description: "Speaking rate, from 0.25 to 4. Default: 1.",
+				Optional:    true,
+			},
+			"auto_transcribe": schema.MapAttribute{
+				Description: "Whether voice notes are transcribed automatically, keyed by channel name (e.g. telegram = true). Channels left out use the gateway default.",
+				Optional:    true,
+				ElementType: types.BoolType,
+			},
+			"max_audio_duration_seconds": schema.Int64Attribute{
+				Description: "Longest audio, in seconds, that is transcribed. Longer voice notes are rejected.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *VoiceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the ranges of the speed and duration.
+func (r *VoiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config VoiceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.TTSSpeed; !v.IsNull() && !v.IsUnknown() && (v.ValueFloat64() < 0.25 || v.ValueFloat64() > 4) {
+		resp.Diagnostics.AddAttributeError(path.Root("tts_speed"), "Invalid speed",
+			fmt.Sprintf("tts_speed must be between 0.25 and 4, got %g", v.ValueFloat64()))
+	}
+	if v := config.MaxAudioDuration; !v.IsNull() && !v.IsUnknown() && v.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_audio_duration_seconds"), "Invalid duration",
+			fmt.Sprintf("max_audio_duration_seconds must be positive, got %d", v.ValueInt64()))
+	}
+}
+
+func (r *VoiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan VoiceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.PatchSection(ctx, r.client, "voice", r.modelToMap(ctx, plan), cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write voice config", err, "voice")
+		return
+	}
+
+	plan.ID = types.StringValue("voice")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VoiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state VoiceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, _, err := client.GetSection(ctx, r.client, "voice")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read voice config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue("voice")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VoiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan, state VoiceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	m := r.modelToMap(ctx, plan)
+	// A merge patch keeps keys it doesn't mention, so channels dropped from
+	// auto_transcribe are removed explicitly.
+	if cur, ok := m["autoTranscribe"].(map[string]bool); ok {
+		channels := make(map[string]any)
+		for k, v := range cur {
+			channels[k] = v
+		}
+		for _, k := range removedMapKeys(ctx, state.AutoTranscribe, plan.AutoTranscribe) {
+			channels[k] = nil
+		}
+		m["autoTranscribe"] = channels
+	}
+
+	if err := client.PatchSection(ctx, r.client, "voice", m, cfg.Hash); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write voice config", err, "voice")
+		return
+	}
+
+	plan.ID = types.StringValue("voice")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VoiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+
+	if err := client.DeleteSection(ctx, r.client, "voice", cfg.Hash); err != nil {
+		resp.Diagnostics.AddError("Failed to delete voice config", err.Error())
+		return
+	}
+}
+
+func (r *VoiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, _, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetSection(ctx, r.client, "voice")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import voice config", err.Error())
+		return
+	}
+
+	state := VoiceModel{AutoTranscribe: types.MapNull(types.BoolType)}
+	if section != nil {
+		r.mapToModel(ctx, section, &state)
+	}
+	state.ID = types.StringValue("voice")
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ── model ↔ map conversion ──────────────────────────────────
+
+// modelToMap patches unset attributes to null, so that removing one from the
+// configuration returns the setting to the gateway's default. A group left
+// empty is removed whole.
+func (r *VoiceResource) modelToMap(ctx context.Context, m VoiceModel) map[string]any {
+	d := map[string]any{"stt": nil, "tts": nil, "autoTranscribe": nil, "maxAudioSeconds": nil}
+
+	stt := make(map[string]any)
+	setIfString(stt, "provider", m.STTProvider)
+	setIfString(stt, "model", m.STTModel)
+	if len(stt) > 0 {
+		d["stt"] = withNullKeys(stt, "provider", "model")
+	}
+
+	tts := make(map[string]any)
+	setIfString(tts, "provider", m.TTSProvider)
+	setIfString(tts, "voice", m.TTSVoice)
+	setIfFloat64(tts, "speed", m.TTSSpeed)
+	if len(tts) > 0 {
+		d["tts"] = withNullKeys(tts, "provider", "voice", "speed")
+	}
+
+	setIfBoolMap(ctx, d, "autoTranscribe", m.AutoTranscribe)
+	setIfInt64(d, "maxAudioSeconds", m.MaxAudioDuration)
+	return d
+}
+
+// withNullKeys sets each of keys missing from m to null.
+func withNullKeys(m map[string]any, keys ...string) map[string]any {
+	for _, k := range keys {
+		if _, ok := m[k]; !ok {
+			m[k] = nil
+		}
+	}
+	return m
+}
+
+func (r *VoiceResource) mapToModel(ctx context.Context, s map[string]any, m *VoiceModel) {
+	if stt, ok := s["stt"].(map[string]any); ok {
+		readString(stt, "provider", &m.STTProvider)
+		readString(stt, "model", &m.STTModel)
+	}
+	if tts, ok := s["tts"].(map[string]any); ok {
+		readString(tts, "provider", &m.TTSProvider)
+		readString(tts, "voice", &m.TTSVoice)
+		readFloat64(tts, "speed", &m.TTSSpeed)
+	}
+	readBoolMap(ctx, s, "autoTranscribe", &m.AutoTranscribe)
+	readFloat64AsInt64(s, "maxAudioSeconds", &m.MaxAudioDuration)
+}
@@ -0,0 +1,266 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &WebhookSubscriptionResource{}
+var _ resource.ResourceWithImportState = &WebhookSubscriptionResource{}
+var _ resource.ResourceWithValidateConfig = &WebhookSubscriptionResource{}
+
+type WebhookSubscriptionResource struct {
+	gatewayTarget
+}
+
+type WebhookSubscriptionModel struct {
+	ID      types.String `tfsdk:"id"`
+	Gateway types.String `tfsdk:"gateway"`
+	Name    types.String `tfsdk:"name"`
+	URL     types.String `tfsdk:"url"`
+	Secret  types.String `tfsdk:"secret"`
+	Events  types.List   `tfsdk:"events"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+// webhookSubscriptionKeys are the keys of a notifications.webhooks entry.
+var webhookSubscriptionKeys = []string{"url", "secret", "events", "enabled"}
+
+// eventPattern matches gateway event names such as run.error, and the
+// wildcards * and run.*.
+var eventPattern = regexp.MustCompile(`^(\*|[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*(\.\*)?)$`)
+
+func NewWebhookSubscriptionResource() resource.Resource {
+	return &WebhookSubscriptionResource{}
+}
+
+func (r *WebhookSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_subscription"
+}
+
+func (r *WebhookSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an outbound webhook under notifications.webhooks: gateway events are POSTed as JSON to its URL.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Unique webhook name. Used as the key under notifications.webhooks.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "http or https URL events are delivered to.",
+				Required:    true,
+			},
+			"secret": schema.StringAttribute{
+				Description: "Secret used to sign each delivery (HMAC-SHA256, in the X-OpenClaw-Signature header). Sensitive.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"events": schema.ListAttribute{
+				Description: "Events delivered, e.g. session.start, run.error, heartbeat.missed. A trailing .* matches a group (run.*). All events when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Deliver events to this webhook. Set to false to pause deliveries without removing it.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *WebhookSubscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+// ValidateConfig checks the URL and the event names, which must not repeat.
+func (r *WebhookSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WebhookSubscriptionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if u := config.URL; !u.IsNull() && !u.IsUnknown() {
+		if parsed, err := url.Parse(u.ValueString()); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "Invalid URL",
+				fmt.Sprintf("url must be an absolute http or https URL, got %q", u.ValueString()))
+		}
+	}
+
+	if config.Events.IsNull() || config.Events.IsUnknown() {
+		return
+	}
+	var events []types.String
+	resp.Diagnostics.Append(config.Events.ElementsAs(ctx, &events, false)...)
+	seen := make(map[string]bool, len(events))
+	for i, e := range events {
+		if e.IsUnknown() {
+			continue
+		}
+		switch name := e.ValueString(); {
+		case !eventPattern.MatchString(name):
+			resp.Diagnostics.AddAttributeError(path.Root("events").AtListIndex(i), "Invalid event",
+				fmt.Sprintf("Event names are dotted lowercase words such as run.error, got %q", name))
+		case seen[name]:
+			resp.Diagnostics.AddAttributeError(path.Root("events").AtListIndex(i), "Duplicate event",
+				fmt.Sprintf("%q is listed more than once.", name))
+		}
+		seen[e.ValueString()] = true
+	}
+}
+
+func (r *WebhookSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan WebhookSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "notifications", "webhooks", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write webhook config", err, "notifications", "webhooks", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *WebhookSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state WebhookSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := state.Name.ValueString()
+	section, _, err := client.GetNestedSection(ctx, r.client, "notifications", "webhooks", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read webhook config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *WebhookSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan WebhookSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := plan.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, r.modelToMap(ctx, plan), cfg.Hash, "notifications", "webhooks", name); err != nil {
+		addWriteError(ctx, &resp.Diagnostics, req.Plan, "Failed to write webhook config", err, "notifications", "webhooks", name)
+		return
+	}
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *WebhookSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state WebhookSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read config", err.Error())
+		return
+	}
+	name := state.Name.ValueString()
+	if err := client.PatchNestedSection(ctx, r.client, nil, cfg.Hash, "notifications", "webhooks", name); err != nil {
+		resp.Diagnostics.AddError("Failed to delete webhook config", err.Error())
+		return
+	}
+}
+
+func (r *WebhookSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, name, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	section, _, err := client.GetNestedSection(ctx, r.client, "notifications", "webhooks", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import webhook config", err.Error())
+		return
+	}
+	if section == nil {
+		resp.Diagnostics.AddError("Webhook not found", fmt.Sprintf("No webhook named %q in notifications.webhooks", name))
+		return
+	}
+	state := WebhookSubscriptionModel{Events: types.ListNull(types.StringType)}
+	state.Name = types.StringValue(name)
+	r.mapToModel(ctx, section, &state)
+	state.ID = types.StringValue(name)
+	state.Gateway = gatewayValue(gw)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// modelToMap patches unset attributes to null, so that removing events from
+// the configuration subscribes the webhook to all events again.
+func (r *WebhookSubscriptionResource) modelToMap(ctx context.Context, m WebhookSubscriptionModel) map[string]any {
+	d := make(map[string]any)
+	setIfString(d, "url", m.URL)
+	setIfString(d, "secret", m.Secret)
+	setIfStringList(ctx, d, "events", m.Events)
+	setIfBool(d, "enabled", m.Enabled)
+	return withNullKeys(d, webhookSubscriptionKeys...)
+}
+
+func (r *WebhookSubscriptionResource) mapToModel(ctx context.Context, s map[string]any, m *WebhookSubscriptionModel) {
+	readString(s, "url", &m.URL)
+	readString(s, "secret", &m.Secret)
+	readStringList(ctx, s, "events", &m.Events)
+	readBool(s, "enabled", &m.Enabled)
+}
@@ -0,0 +1,290 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/shared"
+)
+
+var _ resource.Resource = &WorkspaceFileResource{}
+var _ resource.ResourceWithImportState = &WorkspaceFileResource{}
+var _ resource.ResourceWithValidateConfig = &WorkspaceFileResource{}
+var _ resource.ResourceWithModifyPlan = &WorkspaceFileResource{}
+
+type WorkspaceFileResource struct {
+	gatewayTarget
+}
+
+type WorkspaceFileModel struct {
+	ID            types.String `tfsdk:"id"`
+	Gateway       types.String `tfsdk:"gateway"`
+	AgentID       types.String `tfsdk:"agent_id"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`
+	ContentFile   types.String `tfsdk:"content_file"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	Mode          types.String `tfsdk:"mode"`
+}
+
+func NewWorkspaceFileResource() resource.Resource {
+	return &WorkspaceFileResource{}
+}
+
+func (r *WorkspaceFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_file"
+}
+
+func (r *WorkspaceFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a file in an agent workspace (e.g. AGENTS.md or TOOLS.md). Written through the gateway in WebSocket mode, and directly into the workspace directory in file mode.",
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"gateway": gatewayAttribute(),
+			"agent_id": schema.StringAttribute{
+				Description: "Agent whose workspace holds the file. Defaults to main.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("main"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the file, relative to the workspace directory (e.g. AGENTS.md or docs/style.md).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "File contents. Exactly one of content or content_file must be set.",
+				Optional:    true,
+			},
+			"content_file": schema.StringAttribute{
+				Description: "Path of a local file to copy into the workspace, read at plan and apply time. Exactly one of content or content_file must be set.",
+				Optional:    true,
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA-256 of the file contents. Changes when the contents, the local file, or the file in the workspace change.",
+				Computed:    true,
+			},
+			"mode": schema.StringAttribute{
+				Description: "Permission bits in octal (e.g. 0644). Left to the gateway when unset.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig keeps the file inside the workspace, requires exactly one
+// source for the contents, and checks the mode.
+func (r *WorkspaceFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WorkspaceFileModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if p := config.Path; !p.IsNull() && !p.IsUnknown() && !filepath.IsLocal(p.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid path",
+			fmt.Sprintf("path must be relative and stay inside the workspace, got %q", p.ValueString()))
+	}
+
+	switch {
+	case !config.Content.IsNull() && !config.ContentFile.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("content_file"), "Conflicting content sources",
+			"Set either content or content_file, not both.")
+	case config.Content.IsNull() && config.ContentFile.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Missing content",
+			"Set content or content_file.")
+	}
+
+	if m := config.Mode; !m.IsNull() && !m.IsUnknown() {
+		if _, err := client.ParseFileMode(m.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("mode"), "Invalid mode", err.Error())
+		}
+	}
+}
+
+// ModifyPlan hashes the contents into content_sha256, so that a change to
+// the file behind content_file shows up as an update.
+func (r *WorkspaceFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan WorkspaceFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Content.IsUnknown() || plan.ContentFile.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringUnknown())...)
+		return
+	}
+	content, err := contentOrFile(plan.Content, plan.ContentFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content_file"), "Failed to read content_file", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringValue(contentSHA256(content)))...)
+}
+
+func (r *WorkspaceFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pd, ok := req.ProviderData.(*shared.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", fmt.Sprintf("Expected *shared.ProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.setProviderData(pd)
+}
+
+func (r *WorkspaceFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan WorkspaceFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeFile(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *WorkspaceFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.selectGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state WorkspaceFileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	file, err := client.GetWorkspaceFile(ctx, r.client, state.AgentID.ValueString(), state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read workspace file", err.Error())
+		return
+	}
+	if file == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.fileToModel(file, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *WorkspaceFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.selectWritableGateway(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	var plan WorkspaceFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !r.writeFile(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *WorkspaceFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.selectWritableGateway(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	var state WorkspaceFileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := client.DeleteWorkspaceFile(ctx, r.client, state.AgentID.ValueString(), state.Path.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete workspace file", err.Error())
+		return
+	}
+}
+
+// ImportState imports a file by "<agent_id>/<path>", with its contents in
+// content. Switch to content_file afterwards if the contents should come
+// from a local file.
+func (r *WorkspaceFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	gw, id, ok := r.importGateway(ctx, req.ID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	agentID, name, ok := strings.Cut(id, "/")
+	if !ok || agentID == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected <agent_id>/<path>, got %q", id))
+		return
+	}
+	file, err := client.GetWorkspaceFile(ctx, r.client, agentID, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import workspace file", err.Error())
+		return
+	}
+	if file == nil {
+		resp.Diagnostics.AddError("Workspace file not found", fmt.Sprintf("No file %q in the workspace of agent %q", name, agentID))
+		return
+	}
+	state := WorkspaceFileModel{
+		AgentID: types.StringValue(agentID),
+		Path:    types.StringValue(name),
+		Gateway: gatewayValue(gw),
+	}
+	r.fileToModel(file, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// writeFile writes the planned contents and records the hash of what was
+// written.
+func (r *WorkspaceFileResource) writeFile(ctx context.Context, m *WorkspaceFileModel, diags *diag.Diagnostics) bool {
+	content, err := contentOrFile(m.Content, m.ContentFile)
+	if err != nil {
+		diags.AddAttributeError(path.Root("content_file"), "Failed to read content_file", err.Error())
+		return false
+	}
+	if err := client.SetWorkspaceFile(ctx, r.client, m.AgentID.ValueString(), m.Path.ValueString(), content, m.Mode.ValueString()); err != nil {
+		diags.AddError("Failed to write workspace file", err.Error())
+		return false
+	}
+	m.ID = types.StringValue(m.AgentID.ValueString() + "/" + m.Path.ValueString())
+	m.ContentSHA256 = types.StringValue(contentSHA256(content))
+	return true
+}
+
+// fileToModel reads the file back. The contents are only copied into
+// content when content is in use; with content_file, changes show up
+// through content_sha256 instead. The mode is only tracked once set, and
+// kept as written when it means the same bits.
+func (r *WorkspaceFileResource) fileToModel(f *client.WorkspaceFile, m *WorkspaceFileModel) {
+	m.ID = types.StringValue(m.AgentID.ValueString() + "/" + m.Path.ValueString())
+	m.ContentSHA256 = types.StringValue(contentSHA256(f.Content))
+	if m.ContentFile.IsNull() {
+		m.Content = types.StringValue(f.Content)
+	}
+	if m.Mode.IsNull() || f.Mode == "" {
+		return
+	}
+	want, err1 := client.ParseFileMode(m.Mode.ValueString())
+	got, err2 := client.ParseFileMode(f.Mode)
+	if err1 != nil || err2 != nil || want != got {
+		m.Mode = types.StringValue(f.Mode)
+	}
+}
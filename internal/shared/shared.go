@@ -2,9 +2,83 @@
 // to avoid import cycles.
 package shared
 
-import "github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kylemclaren/terraform-provider-openclaw/internal/client"
+)
 
 // ProviderData is passed from Configure to all resources and data sources.
 type ProviderData struct {
 	Client client.Client
+
+	// Gateways are the named connections declared in the provider's gateways
+	// blocks, keyed by name. Resources select one with their gateway attribute.
+	Gateways map[string]*Gateway
+
+	// ReadOnly makes every resource Create, Update and Delete fail before it
+	// reaches a gateway. Reads and data sources are unaffected.
+	ReadOnly bool
+}
+
+// Gateway is a named connection that is established on first use, so an
+// unreachable gateway only fails the resources that target it.
+type Gateway struct {
+	connect func(ctx context.Context) (client.Client, error)
+
+	mu     sync.Mutex
+	client client.Client
+}
+
+// NewGateway returns a Gateway that calls connect the first time it is used.
+// A failed connect is retried on the next use.
+func NewGateway(connect func(ctx context.Context) (client.Client, error)) *Gateway {
+	return &Gateway{connect: connect}
+}
+
+// Client returns the gateway's connection, connecting if necessary.
+func (g *Gateway) Client(ctx context.Context) (client.Client, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.client == nil {
+		c, err := g.connect(ctx)
+		if err != nil {
+			return nil, err
+		}
+		g.client = c
+	}
+	return g.client, nil
+}
+
+// ClientFor returns the client for the named gateway, or the default
+// connection when name is empty.
+func (pd *ProviderData) ClientFor(ctx context.Context, name string) (client.Client, error) {
+	if name == "" {
+		return pd.Client, nil
+	}
+	g, ok := pd.Gateways[name]
+	if !ok {
+		return nil, fmt.Errorf("no gateway named %q is configured in the provider (known: %s)", name, pd.gatewayNames())
+	}
+	c, err := g.Client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to gateway %q: %w", name, err)
+	}
+	return c, nil
+}
+
+func (pd *ProviderData) gatewayNames() string {
+	if len(pd.Gateways) == 0 {
+		return "none"
+	}
+	names := make([]string, 0, len(pd.Gateways))
+	for name := range pd.Gateways {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
 }